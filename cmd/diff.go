@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/spf13/cobra"
+)
+
+// executeDiff compares the content of two configuration directories and prints the differences.
+// It reads local files only and never contacts GitHub.
+func executeDiff(ctx context.Context, fromDir, toDir string, out io.Writer) error {
+	diff, err := hydrate.DiffConfigurations(ctx, config.NewConfiguration(ctx, fromDir), config.NewConfiguration(ctx, toDir))
+	if err != nil {
+		return err
+	}
+	printContentDiff(out, fromDir, toDir, diff)
+	return nil
+}
+
+// executeDiffManifests compares the manifests written by two runs and prints the items that are new,
+// that disappeared, and whose type or URL changed. It reads local files only.
+func executeDiffManifests(ctx context.Context, fromPath, toPath string, out io.Writer) error {
+	from, err := hydrate.LoadManifest(ctx, fromPath)
+	if err != nil {
+		return err
+	}
+	to, err := hydrate.LoadManifest(ctx, toPath)
+	if err != nil {
+		return err
+	}
+
+	diff, err := hydrate.DiffManifests(from, to)
+	if err != nil {
+		return err
+	}
+	printContentDiff(out, fromPath, toPath, diff)
+	return nil
+}
+
+// printContentDiff prints the added (+), removed (-) and changed (~) items of each content type
+// that differs, with the fields that changed
+func printContentDiff(out io.Writer, fromDir, toDir string, diff *hydrate.ContentDiff) {
+	if diff.Empty() {
+		fmt.Fprintf(out, "No differences between %s and %s\n", fromDir, toDir)
+		return
+	}
+
+	fmt.Fprintf(out, "Differences from %s to %s:\n", fromDir, toDir)
+	for _, section := range diff.Sections {
+		if len(section.Added) == 0 && len(section.Removed) == 0 && len(section.Changed) == 0 {
+			continue
+		}
+		fmt.Fprintf(out, "\n%s:\n", strings.ToUpper(section.Type[:1])+section.Type[1:])
+		for _, title := range section.Added {
+			fmt.Fprintf(out, "  + %s\n", title)
+		}
+		for _, title := range section.Removed {
+			fmt.Fprintf(out, "  - %s\n", title)
+		}
+		for _, item := range section.Changed {
+			fmt.Fprintf(out, "  ~ %s (%s)\n", item.Title, strings.Join(item.Fields, ", "))
+		}
+	}
+}
+
+// NewDiffCmd returns the Cobra command that compares two configuration directories.
+func NewDiffCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff <dir-a> <dir-b>",
+		Short: "Show the content that differs between two configuration directories",
+		Long: `Load the content files and labels.json from two configuration directories and print the
+issues, discussions, pull requests, and labels that were added, removed, or changed going from the
+first to the second. Items are matched by title, and labels by name. Changed items list the fields
+that differ. This command works offline and never modifies a repository.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if err := executeDiff(ctx, args[0], args[1], os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+}
+
+// NewDiffManifestsCmd returns the Cobra command that compares the manifests of two runs.
+func NewDiffManifestsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "diff-manifests <manifest-a> <manifest-b>",
+		Short: "Show what changed between the manifests of two hydration runs",
+		Long: `Load two manifests written with hydrate --manifest and print the issues, discussions, pull
+requests, and labels that are new (+) in the second, that disappeared (-) from it, or that changed (~).
+Items are matched by title. A changed item lists the fields that differ, such as url or number, or
+type when the title was created as a different kind of item. This command works offline.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if err := executeDiffManifests(ctx, args[0], args[1], os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+}
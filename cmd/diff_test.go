@@ -0,0 +1,81 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+)
+
+// TestExecuteDiff tests the printed differences between two configuration directories
+func TestExecuteDiff(t *testing.T) {
+	from := writeDoctorConfig(t, map[string]string{
+		config.IssuesFilename: `[{"title": "Bug", "body": "Old"}, {"title": "Removed", "body": "Body"}]`,
+	})
+	to := writeDoctorConfig(t, map[string]string{
+		config.IssuesFilename: `[{"title": "Bug", "body": "New"}, {"title": "Added", "body": "Body"}]`,
+	})
+
+	var out bytes.Buffer
+	if err := executeDiff(context.Background(), from.BasePath, to.BasePath, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, expected := range []string{"Issues:", "  + Added", "  - Removed", "  ~ Bug (body)"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected output to contain %q, got: %q", expected, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "Discussions:") {
+		t.Errorf("Expected unchanged content types to be omitted, got: %q", out.String())
+	}
+}
+
+// TestExecuteDiff_NoDifferences tests the output for identical directories
+func TestExecuteDiff_NoDifferences(t *testing.T) {
+	from := writeDoctorConfig(t, nil)
+	to := writeDoctorConfig(t, nil)
+
+	var out bytes.Buffer
+	if err := executeDiff(context.Background(), from.BasePath, to.BasePath, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !strings.Contains(out.String(), "No differences") {
+		t.Errorf("Expected no differences, got: %q", out.String())
+	}
+}
+
+// TestExecuteDiffManifests tests the printed differences between the manifests of two runs
+func TestExecuteDiffManifests(t *testing.T) {
+	dir := t.TempDir()
+	fromPath := filepath.Join(dir, "first.json")
+	toPath := filepath.Join(dir, "second.json")
+	files := map[string]string{
+		fromPath: `{"issues": [{"title": "Bug", "number": 1, "url": "https://github.com/o/r/issues/1"}, {"title": "Removed", "number": 2}], "labels": []}`,
+		toPath:   `{"issues": [{"title": "Bug", "number": 5, "url": "https://github.com/o/r/issues/5"}, {"title": "Added", "number": 6}], "labels": ["demo"]}`,
+	}
+	for path, content := range files {
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write manifest: %v", err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := executeDiffManifests(context.Background(), fromPath, toPath, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, expected := range []string{"Issues:", "  + Added", "  - Removed", "  ~ Bug (number, url)", "Labels:", "  + demo"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected output to contain %q, got: %q", expected, out.String())
+		}
+	}
+
+	if err := executeDiffManifests(context.Background(), fromPath, filepath.Join(dir, "missing.json"), &out); err == nil {
+		t.Error("Expected an error for a missing manifest")
+	}
+}
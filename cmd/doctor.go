@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/spf13/cobra"
+)
+
+// writePermissions are the repository permissions that allow hydration to create content
+var writePermissions = map[string]bool{
+	"WRITE":    true,
+	"MAINTAIN": true,
+	"ADMIN":    true,
+}
+
+// doctorCheck is the outcome of a single prerequisite check
+type doctorCheck struct {
+	Name   string
+	Passed bool
+	Detail string
+	Hint   string // Remediation shown when the check fails
+}
+
+// executeDoctor resolves the target repository, creates a GitHub client and prints a checklist
+// of hydration prerequisites. It performs read-only queries only.
+func executeDoctor(ctx context.Context, owner, repo, remote, configPath string, debug bool, out io.Writer) error {
+	logger := common.NewLogger(debug)
+
+	repoInfo, err := resolveRepositoryInfo(ctx, owner, repo, remote)
+	if err != nil {
+		return err
+	}
+
+	root, err := hydrate.FindProjectRoot(ctx)
+	if err != nil {
+		return errors.FileError("find_project_root", "could not find project root", err)
+	}
+	cfg := config.NewConfigurationWithRoot(ctx, root, configPath)
+
+	client, err := createGitHubClient(ctx, repoInfo, logger)
+	if err != nil {
+		return err
+	}
+
+	checks := runDoctorChecks(ctx, client, cfg)
+	if !printDoctorReport(out, repoInfo, checks) {
+		return errors.ValidationError("doctor", "one or more prerequisite checks failed")
+	}
+	return nil
+}
+
+// runDoctorChecks checks authentication, repository permission, enabled features and that every
+// configuration file parses. A failed check does not stop the others so the checklist is complete.
+func runDoctorChecks(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration) []doctorCheck {
+	var checks []doctorCheck
+
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		checks = append(checks,
+			doctorCheck{Name: "Authentication", Detail: err.Error(), Hint: "Run `gh auth login`, or check `gh auth status`"},
+			doctorCheck{Name: "Repository permission", Detail: "unknown without authentication", Hint: "Fix authentication first"},
+		)
+	} else {
+		checks = append(checks, doctorCheck{Name: "Authentication", Passed: true, Detail: "logged in as " + viewer.Login})
+
+		permission := viewer.RepositoryPermission
+		if permission == "" {
+			permission = "NONE"
+		}
+		checks = append(checks, doctorCheck{
+			Name:   "Repository permission",
+			Passed: writePermissions[permission],
+			Detail: permission,
+			Hint:   "Hydration needs WRITE access or higher; ask a repository admin to grant it",
+		})
+	}
+
+	checks = append(checks, featureChecks(ctx, client)...)
+	checks = append(checks, configChecks(ctx, cfg)...)
+	return checks
+}
+
+// featureChecks reports whether issues, discussions and projects are enabled on the repository
+func featureChecks(ctx context.Context, client githubapi.GitHubClient) []doctorCheck {
+	features, err := client.GetRepositoryFeatures(ctx)
+	if err != nil {
+		return []doctorCheck{{Name: "Repository features", Detail: err.Error(), Hint: "Check that the repository exists and that you can access it"}}
+	}
+
+	feature := func(name string, enabled bool) doctorCheck {
+		check := doctorCheck{Name: name, Passed: enabled, Detail: "enabled"}
+		if !enabled {
+			check.Detail = "disabled"
+			check.Hint = fmt.Sprintf("Enable %s in the repository settings (Settings > General > Features)", name)
+		}
+		return check
+	}
+	return []doctorCheck{
+		feature("Issues", features.HasIssuesEnabled),
+		feature("Discussions", features.HasDiscussionsEnabled),
+		feature("Projects", features.HasProjectsEnabled),
+	}
+}
+
+// configChecks reports whether each configuration file can be read and parsed, and whether the
+// entries of each content file and labels.json are valid
+func configChecks(ctx context.Context, cfg *config.Configuration) []doctorCheck {
+	// Only the included content type is read, so each content file is checked on its own
+	parseContent := func(includeIssues, includeDiscussions, includePullRequests bool) error {
+		issues, discussions, pullRequests, err := hydrate.HydrateFromConfiguration(ctx, cfg, includeIssues, includeDiscussions, includePullRequests)
+		if err != nil {
+			return err
+		}
+		return hydrate.ValidateContent(issues, discussions, pullRequests, nil, "")
+	}
+	parseLabels := func() error {
+		labels, err := hydrate.ReadLabelsJSON(ctx, cfg.LabelsPath)
+		if err != nil {
+			return err
+		}
+		return hydrate.ValidateContent(nil, nil, nil, labels, cfg.LabelsPath)
+	}
+
+	files := []struct {
+		path  string
+		parse func() error
+	}{
+		{cfg.IssuesPath, func() error { return parseContent(true, false, false) }},
+		{cfg.DiscussionsPath, func() error { return parseContent(false, true, false) }},
+		{cfg.PullRequestsPath, func() error { return parseContent(false, false, true) }},
+		{cfg.LabelsPath, parseLabels},
+		{cfg.PreservePath, func() error { _, err := config.LoadPreserveConfig(ctx, cfg.PreservePath); return err }},
+		{cfg.ProjectConfigPath, func() error { _, err := config.LoadProjectConfiguration(ctx, cfg.ProjectConfigPath); return err }},
+		{cfg.LabelDescriptionsPath, func() error { _, err := config.LoadLabelDescriptions(ctx, cfg.LabelDescriptionsPath); return err }},
+		{cfg.MetadataPath, func() error { _, err := config.LoadMetadataConfiguration(ctx, cfg.MetadataPath); return err }},
+		{cfg.CrossReferencesPath, func() error { _, err := config.LoadCrossReferences(ctx, cfg.CrossReferencesPath); return err }},
+		{cfg.FilesPath, func() error { _, err := config.LoadSeedFiles(ctx, cfg.FilesPath); return err }},
+	}
+
+	checks := make([]doctorCheck, 0, len(files))
+	for _, file := range files {
+		name := "Config " + filepath.Base(file.path)
+		check := doctorCheck{Name: name, Passed: true, Detail: "valid"}
+		if err := file.parse(); err != nil {
+			check = doctorCheck{Name: name, Detail: err.Error(), Hint: "Fix the file; see the README for its schema"}
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// printDoctorReport writes the checklist to out and reports whether every check passed
+func printDoctorReport(out io.Writer, repoInfo *repositoryInfo, checks []doctorCheck) bool {
+	fmt.Fprintf(out, "Checking prerequisites for %s/%s\n", repoInfo.Owner, repoInfo.Repo)
+
+	allPassed := true
+	for _, check := range checks {
+		status := "PASS"
+		if !check.Passed {
+			status = "FAIL"
+			allPassed = false
+		}
+		fmt.Fprintf(out, "[%s] %s: %s\n", status, check.Name, check.Detail)
+		if !check.Passed && check.Hint != "" {
+			fmt.Fprintf(out, "       Hint: %s\n", check.Hint)
+		}
+	}
+
+	if allPassed {
+		fmt.Fprintln(out, "All checks passed")
+	}
+	return allPassed
+}
+
+// NewDoctorCmd returns the Cobra command that checks the prerequisites for hydrating a repository.
+func NewDoctorCmd() *cobra.Command {
+	var owner, repo, remote, configPath string
+	var debug bool
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check authentication, permissions, repository features, and configuration files",
+		Long: `Check the prerequisites for hydrating a repository: that you are authenticated, have
+write access, that issues, discussions and projects are enabled, and that every configuration
+file parses. Each check is reported as PASS or FAIL with a hint for fixing failures.
+This command only reads data and never modifies the repository.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if err := executeDoctor(ctx, owner, repo, remote, configPath, debug, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&owner, "owner", "", "GitHub repository owner (defaults to the current repository)")
+	cmd.Flags().StringVar(&repo, "repo", "", "GitHub repository name (defaults to the current repository)")
+	cmd.Flags().StringVar(&remote, "remote", "", "Git remote used to detect the repository (default: gh's remote selection)")
+	cmd.Flags().StringVar(&configPath, "config-path", config.DefaultConfigPath, "Path to configuration files relative to project root")
+	cmd.Flags().BoolVar(&debug, "debug", false, "Enable debug mode for detailed logging")
+
+	return cmd
+}
@@ -0,0 +1,160 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// writeDoctorConfig writes valid content files to a temporary config directory
+func writeDoctorConfig(t *testing.T, files map[string]string) *config.Configuration {
+	t.Helper()
+	dir := t.TempDir()
+	contents := map[string]string{
+		config.IssuesFilename:       `[]`,
+		config.DiscussionsFilename:  `[]`,
+		config.PullRequestsFilename: `[]`,
+	}
+	for name, content := range files {
+		contents[name] = content
+	}
+	for name, content := range contents {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return config.NewConfiguration(context.Background(), dir)
+}
+
+// TestRunDoctorChecks tests the pass/fail rendering of each prerequisite check
+func TestRunDoctorChecks(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockConfig     hydrate.MockConfig
+		files          map[string]string
+		expectPass     bool
+		expectedOutput []string
+	}{
+		{
+			name:       "all checks pass",
+			mockConfig: hydrate.MockConfig{ViewerLogin: "octocat", ViewerPermission: "WRITE"},
+			expectPass: true,
+			expectedOutput: []string{
+				"[PASS] Authentication: logged in as octocat",
+				"[PASS] Repository permission: WRITE",
+				"[PASS] Issues: enabled",
+				"[PASS] Discussions: enabled",
+				"[PASS] Projects: enabled",
+				"[PASS] Config issues.json: valid",
+				"[PASS] Config labels.json: valid",
+				"All checks passed",
+			},
+		},
+		{
+			name:       "authentication failure",
+			mockConfig: hydrate.MockConfig{Viewer: testutil.ErrorConfig{ShouldError: true, ErrorMessage: "HTTP 401: Bad credentials"}},
+			expectedOutput: []string{
+				"[FAIL] Authentication: HTTP 401: Bad credentials",
+				"Hint: Run `gh auth login`",
+				"[FAIL] Repository permission: unknown without authentication",
+			},
+		},
+		{
+			name:       "read permission is insufficient",
+			mockConfig: hydrate.MockConfig{ViewerPermission: "READ"},
+			expectedOutput: []string{
+				"[FAIL] Repository permission: READ",
+				"Hint: Hydration needs WRITE access or higher",
+			},
+		},
+		{
+			name: "disabled features",
+			mockConfig: hydrate.MockConfig{
+				RepositoryFeatures: &types.RepositoryFeatures{HasIssuesEnabled: true},
+			},
+			expectedOutput: []string{
+				"[PASS] Issues: enabled",
+				"[FAIL] Discussions: disabled",
+				"Hint: Enable Discussions in the repository settings",
+				"[FAIL] Projects: disabled",
+			},
+		},
+		{
+			name:       "feature lookup failure",
+			mockConfig: hydrate.MockConfig{Features: testutil.ErrorConfig{ShouldError: true, ErrorMessage: "repository not found"}},
+			expectedOutput: []string{
+				"[FAIL] Repository features: repository not found",
+			},
+		},
+		{
+			name:       "invalid config file",
+			mockConfig: hydrate.MockConfig{},
+			files:      map[string]string{config.LabelsFilename: `{not json`},
+			expectedOutput: []string{
+				"[PASS] Config issues.json: valid",
+				"[FAIL] Config labels.json: ",
+				"Hint: Fix the file",
+			},
+		},
+		{
+			name:       "invalid entries fail validation",
+			mockConfig: hydrate.MockConfig{},
+			files: map[string]string{
+				config.PullRequestsFilename: `[{"title": "No head", "base": "main"}]`,
+				config.LabelsFilename:       `[{"name": "bug", "color": "#d73a4a"}]`,
+			},
+			expectedOutput: []string{
+				"[FAIL] Config prs.json: ",
+				"head branch cannot be empty",
+				"[FAIL] Config labels.json: ",
+				"invalid color '#d73a4a'",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := writeDoctorConfig(t, tt.files)
+			client := hydrate.NewFailingMockGitHubClient(tt.mockConfig)
+			var out bytes.Buffer
+
+			checks := runDoctorChecks(context.Background(), client, cfg)
+			passed := printDoctorReport(&out, &repositoryInfo{Owner: "test-owner", Repo: "test-repo"}, checks)
+
+			if passed != tt.expectPass {
+				t.Errorf("Expected all checks passed to be %v, got %v\n%s", tt.expectPass, passed, out.String())
+			}
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(out.String(), expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, out.String())
+				}
+			}
+			if !tt.expectPass && strings.Contains(out.String(), "All checks passed") {
+				t.Errorf("Expected no success line when a check fails, got:\n%s", out.String())
+			}
+		})
+	}
+}
+
+// TestNewDoctorCmd tests the doctor command configuration
+func TestNewDoctorCmd(t *testing.T) {
+	cmd := NewDoctorCmd()
+
+	if cmd.Use != "doctor" {
+		t.Errorf("Expected Use to be 'doctor', got %q", cmd.Use)
+	}
+
+	for _, flagName := range []string{"owner", "repo", "remote", "config-path", "debug"} {
+		if cmd.Flags().Lookup(flagName) == nil {
+			t.Errorf("Expected flag %q to be defined", flagName)
+		}
+	}
+}
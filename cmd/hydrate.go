@@ -2,30 +2,62 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"os"
+	"os/exec"
 	"os/signal"
+	"path/filepath"
 	"strings"
 	"syscall"
+	"text/template"
+	"time"
 
 	"github.com/chrisreddington/gh-demo/internal/common"
 	"github.com/chrisreddington/gh-demo/internal/config"
 	"github.com/chrisreddington/gh-demo/internal/errors"
 	"github.com/chrisreddington/gh-demo/internal/githubapi"
 	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/chrisreddington/gh-demo/internal/types"
 	"github.com/cli/go-gh/v2/pkg/repository"
+	"github.com/cli/go-gh/v2/pkg/term"
 	"github.com/spf13/cobra"
 )
 
+// Values of --report-format
+const (
+	reportFormatMarkdown = "markdown" // renders the run results as Markdown
+	reportFormatTemplate = "template" // renders the run results with the Go template in --report-template
+)
+
 // repositoryInfo holds the resolved owner and repository information
 type repositoryInfo struct {
 	Owner string
 	Repo  string
 }
 
+// currentRepository detects the repository from the default git remote.
+// It is a variable so tests can inject the git context.
+var currentRepository = repository.Current
+
+// remoteRepository detects the repository from a named git remote.
+// It is a variable so tests can inject the git context.
+var remoteRepository = repositoryFromRemote
+
+// repositoryFromRemote resolves the repository from the URL of the named git remote.
+func repositoryFromRemote(ctx context.Context, remote string) (repository.Repository, error) {
+	output, err := exec.CommandContext(ctx, "git", "remote", "get-url", remote).Output()
+	if err != nil {
+		return repository.Repository{}, err
+	}
+	return repository.Parse(strings.TrimSpace(string(output)))
+}
+
 // resolveRepositoryInfo validates and resolves the repository owner and name.
-// It tries to get missing values from the current git context if available.
-func resolveRepositoryInfo(ctx context.Context, owner, repo string) (*repositoryInfo, error) {
+// It tries to get missing values from the git context if available, using the named
+// remote when one is given and the library's default remote selection otherwise.
+func resolveRepositoryInfo(ctx context.Context, owner, repo, remote string) (*repositoryInfo, error) {
 	// Check if context is cancelled before operations
 	if err := ctx.Err(); err != nil {
 		return nil, err
@@ -33,10 +65,38 @@ func resolveRepositoryInfo(ctx context.Context, owner, repo string) (*repository
 
 	resolvedOwner := strings.TrimSpace(owner)
 	resolvedRepo := strings.TrimSpace(repo)
+	remote = strings.TrimSpace(remote)
+
+	if (resolvedOwner == "" || resolvedRepo == "") && remote != "" {
+		// An explicitly requested remote must resolve; falling back silently could target the wrong repository
+		repoCtx, err := remoteRepository(ctx, remote)
+		if err != nil {
+			err = errors.WrapWithOperation(err, "validation", "resolve_remote", "could not resolve repository from git remote")
+			return nil, errors.WithContextSafe(err, "remote", remote)
+		}
+		if resolvedOwner == "" {
+			resolvedOwner = repoCtx.Owner
+		}
+		if resolvedRepo == "" {
+			resolvedRepo = repoCtx.Name
+		}
+	}
+
+	if resolvedOwner == "" || resolvedRepo == "" {
+		// GitHub Actions sets GITHUB_REPOSITORY to the repository the workflow runs in
+		if envOwner, envRepo, ok := repositoryFromEnv(); ok {
+			if resolvedOwner == "" {
+				resolvedOwner = envOwner
+			}
+			if resolvedRepo == "" {
+				resolvedRepo = envRepo
+			}
+		}
+	}
 
 	if resolvedOwner == "" || resolvedRepo == "" {
 		// Try to get from current git context
-		repoCtx, err := repository.Current()
+		repoCtx, err := currentRepository()
 		if err == nil {
 			if resolvedOwner == "" {
 				resolvedOwner = repoCtx.Owner
@@ -57,6 +117,17 @@ func resolveRepositoryInfo(ctx context.Context, owner, repo string) (*repository
 	}, nil
 }
 
+// repositoryFromEnv parses the "owner/repo" value of GITHUB_REPOSITORY. It reports false when the
+// variable is unset or malformed, so that detection falls back to the git context.
+func repositoryFromEnv() (string, string, bool) {
+	owner, repo, found := strings.Cut(strings.TrimSpace(os.Getenv("GITHUB_REPOSITORY")), "/")
+	owner, repo = strings.TrimSpace(owner), strings.TrimSpace(repo)
+	if !found || owner == "" || repo == "" || strings.Contains(repo, "/") {
+		return "", "", false
+	}
+	return owner, repo, true
+}
+
 // createGitHubClient creates and configures a GitHub API client.
 func createGitHubClient(ctx context.Context, repoInfo *repositoryInfo, logger common.Logger) (githubapi.GitHubClient, error) {
 	client, err := githubapi.NewGHClient(ctx, repoInfo.Owner, repoInfo.Repo)
@@ -70,6 +141,34 @@ func createGitHubClient(ctx context.Context, repoInfo *repositoryInfo, logger co
 	return client, nil
 }
 
+// applyContentFileOverrides replaces the paths of the content files given with --issues-file,
+// --discussions-file and --prs-file; the other types keep the files in the config path
+func applyContentFileOverrides(cfg *config.Configuration, contentFlags ContentFlags) {
+	if contentFlags.IssuesFile != "" {
+		cfg.IssuesPath = contentFlags.IssuesFile
+	}
+	if contentFlags.DiscussionsFile != "" {
+		cfg.DiscussionsPath = contentFlags.DiscussionsFile
+	}
+	if contentFlags.PRsFile != "" {
+		cfg.PullRequestsPath = contentFlags.PRsFile
+	}
+}
+
+// rateLimitWait returns the longest wait for a rate limit reset, or zero when --resume-on-rate-limit is not set
+func rateLimitWait(contentFlags ContentFlags) time.Duration {
+	if !contentFlags.ResumeOnRateLimit {
+		return 0
+	}
+	return contentFlags.MaxRateLimitWait
+}
+
+// colorEnabled reports whether the summary is styled with ANSI colors: only when stdout is a terminal,
+// or color is forced with CLICOLOR_FORCE, and neither --no-color nor the NO_COLOR variable turns it off.
+func colorEnabled(noColor bool) bool {
+	return !noColor && term.FromEnv().IsColorEnabled()
+}
+
 // handleHydrationResult processes the result of the hydration operation.
 // It handles both complete failures and partial failures with appropriate user feedback.
 func handleHydrationResult(ctx context.Context, err error, logger common.Logger) error {
@@ -81,7 +180,8 @@ func handleHydrationResult(ctx context.Context, err error, logger common.Logger)
 			if ctxErr := ctx.Err(); ctxErr != nil {
 				return ctxErr
 			}
-			fmt.Fprintf(os.Stderr, "Hydration completed with some failures:\n%v\n", err)
+			fmt.Fprintln(os.Stderr, "Hydration completed with some failures:")
+			errors.FormatPartialFailure(os.Stderr, err)
 			return nil // Partial failures are considered success for CLI purposes
 		} else {
 			// Complete failure
@@ -102,6 +202,34 @@ type CleanupFlags struct {
 	CleanLabels      bool
 	DryRun           bool
 	PreserveConfig   string
+
+	// DryRunSummaryOnly replaces the per-item lines of a dry run with counts per type
+	DryRunSummaryOnly bool
+
+	// CleanCreatedLabels is the path of a manifest; only the labels it records as created are cleaned
+	CleanCreatedLabels string
+
+	// SummaryFile, when set, is where the cleanup summary is written as JSON
+	SummaryFile string
+
+	DeleteProjectItemsOnly bool
+	MaxPages               int
+	Concurrency            int
+	CloseDiscussions       bool
+	CloseComment           string
+	PruneBranches          bool
+	BranchPrefix           string
+
+	// Teardown removes the marked content, project and labels of demo runs and ends the run without
+	// hydrating; TeardownManifest, when set, is the manifest of the run that is torn down
+	Teardown         bool
+	TeardownManifest string
+
+	// Delay is waited between creates, and by each cleanup worker between deletions
+	Delay time.Duration
+
+	// AllowRepos, when set, lists the only repositories ("owner/repo") that may be modified
+	AllowRepos []string
 }
 
 // ProjectFlags holds all project-related command line flags
@@ -109,77 +237,814 @@ type ProjectFlags struct {
 	CreateProject      bool
 	ProjectConfig      string
 	FailOnProjectError bool
+	Strict             bool
+	ProjectOwner       string
 }
 
-// executeHydrate contains the core hydration logic separated from CLI concerns
-// executeHydrate performs the hydration operation with the given parameters.
-// It validates required parameters, resolves git context if needed, and orchestrates the hydration process.
-func executeHydrate(ctx context.Context, owner, repo, configPath string, issues, discussions, pullRequests, debug bool, cleanupFlags CleanupFlags, projectFlags ProjectFlags) error {
-	// Create logger for operations
-	logger := common.NewLogger(debug) // Use debug flag for logger
+// ContentFlags holds command line flags that control how content items are created
+type ContentFlags struct {
+	Idempotent                bool
+	SkipExisting              bool
+	Upsert                    bool
+	DefaultDiscussionCategory string
+	DefaultBase               string
+	BaseDefaultBranch         bool
+	TruncateBodies            bool
+	ContinueFrom              string
+	TitlePrefix               string
+	RenderEmoji               bool
+	UseIssueTemplates         bool
+	AppendMetadata            bool
+	BlockedByLines            bool
+	UseCodeowners             bool
+	MaxItemsPerType           int
+	Force                     bool
 
-	// Resolve repository information
-	repoInfo, err := resolveRepositoryInfo(ctx, owner, repo)
+	// ResumeOnRateLimit makes a create rejected by the exhausted rate limit wait for the reset, up to MaxRateLimitWait
+	ResumeOnRateLimit bool
+	MaxRateLimitWait  time.Duration
+
+	// CheckEmpty stops before cleanup or hydration when the repository has more than CheckEmptyMax existing items
+	CheckEmpty    bool
+	CheckEmptyMax int
+
+	// ValidateOnly loads, validates and preflights the configuration offline and exits without creating anything
+	ValidateOnly bool
+
+	// SeedFiles writes the files listed in files.json to the default branch before content is created
+	SeedFiles bool
+
+	// LinkExisting lets duplicate links and cross-references fall back to open items the run did not create
+	LinkExisting bool
+
+	// IssuesFile, DiscussionsFile and PRsFile, when set, replace the content files derived from the config path
+	IssuesFile      string
+	DiscussionsFile string
+	PRsFile         string
+}
+
+// OutputFlags holds command line flags that control run reporting
+type OutputFlags struct {
+	APIStatsFile      string
+	ManifestFile      string
+	ShowBodies        bool
+	BodyPreviewLength int
+	StepSummary       string
+	PlanFile          string
+	ReportFormat      string
+	ReportFile        string
+	ReportTemplate    string
+	NoColor           bool
+
+	// ExplainPermissions prints the token permissions the enabled features need and checks them instead of hydrating
+	ExplainPermissions bool
+
+	// Verify re-fetches every item the run created and fails when one is missing or its title differs
+	Verify bool
+}
+
+// NetworkFlags holds command line flags that control API timeouts and retries. Flags that are not
+// set explicitly take their values from the selected profile.
+type NetworkFlags struct {
+	Profile      string
+	APITimeout   time.Duration
+	Retries      int
+	RetryBackoff time.Duration
+
+	// MaxRequestsPerMinute, when positive, paces every API request of the run; it is not part of the profiles
+	MaxRequestsPerMinute int
+}
+
+// LabelFlags holds all label-related command line flags
+type LabelFlags struct {
+	Prune          bool
+	NoAutoLabels   bool
+	DefaultLabels  bool
+	LabelsFailOpen bool
+	LabelsOnly     bool
+	Normalize      bool
+	ClearDefaults  bool
+
+	// LabelsFile, when set, replaces labels.json with a local file or an https:// URL of a shared labels file
+	LabelsFile string
+}
+
+// applyNetworkProfile fills the network flags and the cleanup concurrency that were not set
+// explicitly on cmd with the values of the selected profile.
+func applyNetworkProfile(cmd *cobra.Command, networkFlags *NetworkFlags, cleanupFlags *CleanupFlags) error {
+	profile, err := config.LookupNetworkProfile(networkFlags.Profile)
+	if err != nil {
+		return err
+	}
+
+	flags := cmd.Flags()
+	if !flags.Changed("api-timeout") {
+		networkFlags.APITimeout = profile.APITimeout
+	}
+	if !flags.Changed("retries") {
+		networkFlags.Retries = profile.Retries
+	}
+	if !flags.Changed("retry-backoff") {
+		networkFlags.RetryBackoff = profile.RetryBackoff
+	}
+	if !flags.Changed("cleanup-concurrency") {
+		cleanupFlags.Concurrency = profile.Concurrency
+	}
+	return nil
+}
+
+// hydrateOptions holds the command line flags of the hydrate command
+type hydrateOptions struct {
+	Owner        string
+	Repo         string
+	Remote       string
+	ConfigPath   string
+	Issues       bool
+	Discussions  bool
+	PullRequests bool
+	Debug        bool
+
+	Cleanup CleanupFlags
+	Project ProjectFlags
+	Labels  LabelFlags
+	Content ContentFlags
+	Output  OutputFlags
+	Network NetworkFlags
+}
+
+// hydrateRun holds the state executeHydrate builds up as it moves from loading the configuration
+// through cleanup and hydration to reporting the results
+type hydrateRun struct {
+	flags          hydrateOptions
+	logger         *common.StandardLogger
+	repoInfo       *repositoryInfo
+	cfg            *config.Configuration
+	markerLabel    string
+	metadata       *hydrate.RunMetadata
+	reportTemplate *template.Template
+	client         githubapi.GitHubClient
+
+	options         hydrate.HydrateOptions
+	manifestPath    string
+	stepSummaryPath string
+}
+
+// executeHydrate contains the core hydration logic separated from CLI concerns.
+// It validates the flags, loads the configuration, runs the preflight checks and any cleanup, then
+// hydrates the repository and reports the results.
+func executeHydrate(ctx context.Context, flags hydrateOptions) error {
+	logger := common.NewLogger(flags.Debug)
+
+	if err := validateHydrateFlags(flags); err != nil {
+		return err
+	}
+	run, err := loadHydrateRun(ctx, flags, logger)
 	if err != nil {
 		return err
 	}
 
+	// Validation is offline, so it ends the run before a client is created or any API call is made
+	if flags.Content.ValidateOnly {
+		return run.validateOffline(ctx)
+	}
+
+	if err := run.connect(ctx); err != nil {
+		return err
+	}
+
+	// Explaining permissions only reads, so it stops before any check that could lead to a write
+	if flags.Output.ExplainPermissions {
+		return explainPermissions(ctx, run.client, run.repoInfo, flags.permissionFeatures(ctx), os.Stdout)
+	}
+
+	if err := run.preflight(ctx); err != nil {
+		return err
+	}
+	if stop, err := run.cleanup(ctx); stop {
+		return err
+	}
+	if err := run.prepareHydration(ctx); err != nil {
+		return err
+	}
+
+	err = run.hydrate(ctx)
+	return run.report(ctx, err)
+}
+
+// validateHydrateFlags rejects flag combinations that cannot work before anything is loaded
+func validateHydrateFlags(flags hydrateOptions) error {
+	if flags.Output.ShowBodies && flags.Output.BodyPreviewLength <= 0 {
+		return errors.ValidationError("validate_flags", "--body-preview-length must be greater than zero")
+	}
+	if flags.Output.PlanFile != "" && !flags.Cleanup.DryRun {
+		return errors.ValidationError("validate_flags", "--plan-file requires --dry-run")
+	}
+	if flags.Content.ResumeOnRateLimit && flags.Content.MaxRateLimitWait <= 0 {
+		return errors.ValidationError("validate_flags", "--max-rate-limit-wait must be greater than zero")
+	}
+	if flags.Cleanup.DryRunSummaryOnly && !flags.Cleanup.DryRun {
+		return errors.ValidationError("validate_flags", "--dry-run-summary-only requires --dry-run")
+	}
+	if flags.Output.ReportFormat != "" && flags.Output.ReportFormat != reportFormatMarkdown && flags.Output.ReportFormat != reportFormatTemplate {
+		return errors.ValidationError("validate_flags", fmt.Sprintf("unsupported --report-format '%s': must be %s or %s", flags.Output.ReportFormat, reportFormatMarkdown, reportFormatTemplate))
+	}
+	return nil
+}
+
+// loadHydrateRun resolves the repository and loads the configuration of the run. Everything it
+// reads is local, so a broken template or configuration is reported before any API call.
+func loadHydrateRun(ctx context.Context, flags hydrateOptions, logger *common.StandardLogger) (*hydrateRun, error) {
+	// A broken template is reported before anything is created
+	reportTemplate, err := loadReportTemplate(flags.Output.ReportFormat, flags.Output.ReportTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	// Resolve repository information
+	repoInfo, err := resolveRepositoryInfo(ctx, flags.Owner, flags.Repo, flags.Remote)
+	if err != nil {
+		return nil, err
+	}
+
+	// Refuse to touch a repository outside the allowlist before anything is read or written
+	if err := checkAllowedRepository(repoInfo, flags.Cleanup.AllowRepos); err != nil {
+		return nil, err
+	}
+
 	// Find project root
 	root, err := hydrate.FindProjectRoot(ctx)
 	if err != nil {
-		return errors.FileError("find_project_root", "could not find project root", err)
+		return nil, errors.FileError("find_project_root", "could not find project root", err)
 	}
 
 	// Create configuration object
-	cfg := config.NewConfigurationWithRoot(ctx, root, configPath)
+	cfg := config.NewConfigurationWithRoot(ctx, root, flags.ConfigPath)
+	if flags.Labels.LabelsFile != "" {
+		cfg.LabelsPath = flags.Labels.LabelsFile
+	}
+	applyContentFileOverrides(cfg, flags.Content)
+
+	// A bad marker label or metadata template is reported before anything is changed
+	metadataConfig, err := config.LoadMetadataConfiguration(ctx, cfg.MetadataPath)
+	if err != nil {
+		return nil, errors.WithContextSafe(err, "path", cfg.MetadataPath)
+	}
+	var metadata *hydrate.RunMetadata
+	if flags.Content.AppendMetadata {
+		metadata = hydrate.NewRunMetadata(runID(logger), time.Now())
+		if err := metadata.SetTemplate(metadataConfig.MetadataTemplate); err != nil {
+			return nil, errors.WithContextSafe(err, "path", cfg.MetadataPath)
+		}
+	}
 
-	// Create and configure GitHub client
-	client, err := createGitHubClient(ctx, repoInfo, logger)
+	return &hydrateRun{
+		flags:          flags,
+		logger:         logger,
+		repoInfo:       repoInfo,
+		cfg:            cfg,
+		markerLabel:    metadataConfig.MarkerLabel,
+		metadata:       metadata,
+		reportTemplate: reportTemplate,
+	}, nil
+}
+
+// validateOffline validates and preflights the configuration without creating a client
+func (run *hydrateRun) validateOffline(ctx context.Context) error {
+	return hydrate.ValidateWithOptions(ctx, run.cfg, hydrate.HydrateOptions{
+		IncludeIssues:       run.flags.Issues,
+		IncludeDiscussions:  run.flags.Discussions,
+		IncludePullRequests: run.flags.PullRequests,
+		APITimeout:          run.flags.Network.APITimeout,
+		TruncateBodies:      run.flags.Content.TruncateBodies,
+		TitlePrefix:         run.flags.Content.TitlePrefix,
+		MaxItemsPerType:     run.flags.Content.MaxItemsPerType,
+		Force:               run.flags.Content.Force,
+		Strict:              run.flags.Project.Strict,
+		Metadata:            run.metadata,
+	}, run.logger)
+}
+
+// connect creates the GitHub client and configures its paging, network profile, pacing and project owner
+func (run *hydrateRun) connect(ctx context.Context) error {
+	client, err := createGitHubClient(ctx, run.repoInfo, run.logger)
 	if err != nil {
 		return err
 	}
+	run.client = client
 
-	// Perform cleanup if requested
-	if shouldPerformCleanup(ctx, cleanupFlags) {
-		err := performCleanup(ctx, client, cleanupFlags, cfg, logger)
-		if err != nil {
-			// Log cleanup error but continue with hydration unless it's a critical failure
-			logger.Info("Cleanup encountered errors but continuing with hydration: %v", err)
+	flags := run.flags
+	client.SetMaxPages(flags.Cleanup.MaxPages)
+	client.SetNetworkProfile(config.NetworkProfile{
+		Name:         flags.Network.Profile,
+		APITimeout:   flags.Network.APITimeout,
+		Retries:      flags.Network.Retries,
+		RetryBackoff: flags.Network.RetryBackoff,
+		Concurrency:  flags.Cleanup.Concurrency,
+	})
+	if flags.Network.MaxRequestsPerMinute > 0 {
+		client.SetScheduler(githubapi.NewRequestScheduler(flags.Network.MaxRequestsPerMinute, config.RequestBurst))
+	}
+
+	// Projects may belong to another user or organization than the repository, which must exist
+	if flags.Project.ProjectOwner != "" {
+		client.SetProjectOwner(flags.Project.ProjectOwner)
+		if _, err := client.GetProjectOwnerID(ctx); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	// Perform hydration with project support
-	if projectFlags.CreateProject {
-		err = hydrate.HydrateWithProject(ctx, client, cfg, issues, discussions, pullRequests, logger, cleanupFlags.DryRun, true, projectFlags.ProjectConfig)
-	} else {
-		err = hydrate.HydrateWithLabels(ctx, client, cfg, issues, discussions, pullRequests, logger, cleanupFlags.DryRun)
+// permissionFeatures returns the features --explain-permissions checks the token against
+func (flags hydrateOptions) permissionFeatures(ctx context.Context) permissionFeatures {
+	return permissionFeatures{
+		Issues:        flags.Issues,
+		Discussions:   flags.Discussions,
+		PullRequests:  flags.PullRequests,
+		CreateProject: flags.Project.CreateProject || flags.Cleanup.Teardown,
+		Clean:         shouldPerformCleanup(ctx, flags.Cleanup),
+		PruneBranches: flags.Cleanup.PruneBranches,
+		UseCodeowners: flags.Content.UseCodeowners,
+		SeedFiles:     flags.Content.SeedFiles,
+	}
+}
+
+// preflight stops the run before cleanup or hydration changes anything when the repository cannot
+// be written, already has content with --check-empty, or the token lacks a project scope
+func (run *hydrateRun) preflight(ctx context.Context) error {
+	// An archived or locked repository rejects every write, so stop before cleanup or hydration tries one
+	if err := checkRepositoryWritable(ctx, run.client, run.logger); err != nil {
+		return err
+	}
+
+	// Existing content in what should be a fresh repository suggests the wrong target
+	flags := run.flags
+	if flags.Content.CheckEmpty {
+		if err := checkRepositoryEmpty(ctx, run.client, run.repoInfo, flags.Issues, flags.Discussions, flags.PullRequests, flags.Content.CheckEmptyMax, run.logger); err != nil {
+			return err
+		}
+	}
+
+	// Find a missing project scope before cleanup or hydration changes anything
+	if flags.Project.CreateProject {
+		if err := checkProjectScopes(ctx, run.client, flags.Project.Strict, run.logger); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanup performs the requested cleanup. It reports stop when the run ends there, after a teardown
+// or a cancellation; other cleanup errors are logged and hydration continues.
+func (run *hydrateRun) cleanup(ctx context.Context) (stop bool, err error) {
+	if !shouldPerformCleanup(ctx, run.flags.Cleanup) {
+		return false, nil
+	}
+
+	err = performCleanup(ctx, run.client, run.flags.Cleanup, run.flags.Project, run.flags.Content.TitlePrefix, run.cfg, run.logger)
+	// A teardown ends the demo, so nothing is hydrated after it
+	if run.flags.Cleanup.Teardown || errors.IsContextError(err) {
+		return true, err
+	}
+	if err != nil {
+		// Log cleanup error but continue with hydration unless it's a critical failure
+		run.logger.Info("Cleanup encountered errors but continuing with hydration: %v", err)
+	}
+	return false, nil
+}
+
+// buildOptions maps the flags onto the options of the hydrate package
+func (run *hydrateRun) buildOptions() hydrate.HydrateOptions {
+	flags := run.flags
+	return hydrate.HydrateOptions{
+		IncludeIssues:             flags.Issues,
+		IncludeDiscussions:        flags.Discussions,
+		IncludePullRequests:       flags.PullRequests,
+		DryRun:                    flags.Cleanup.DryRun,
+		CreateProject:             flags.Project.CreateProject,
+		ProjectConfigPath:         flags.Project.ProjectConfig,
+		PruneLabels:               flags.Labels.Prune,
+		NoAutoLabels:              flags.Labels.NoAutoLabels,
+		DefaultLabels:             flags.Labels.DefaultLabels,
+		LabelsOnly:                flags.Labels.LabelsOnly,
+		NormalizeLabels:           flags.Labels.Normalize,
+		ClearDefaultLabels:        flags.Labels.ClearDefaults,
+		LabelsFailOpen:            flags.Labels.LabelsFailOpen,
+		APITimeout:                flags.Network.APITimeout,
+		Color:                     colorEnabled(flags.Output.NoColor),
+		Strict:                    flags.Project.Strict,
+		RateLimitWait:             rateLimitWait(flags.Content),
+		Idempotent:                flags.Content.Idempotent,
+		SkipExisting:              flags.Content.SkipExisting,
+		Upsert:                    flags.Content.Upsert,
+		DefaultDiscussionCategory: flags.Content.DefaultDiscussionCategory,
+		DefaultBase:               flags.Content.DefaultBase,
+		DetectDefaultBase:         flags.Content.BaseDefaultBranch,
+		TruncateBodies:            flags.Content.TruncateBodies,
+		TitlePrefix:               flags.Content.TitlePrefix,
+		RenderEmoji:               flags.Content.RenderEmoji,
+		UseIssueTemplates:         flags.Content.UseIssueTemplates,
+		BlockedByLines:            flags.Content.BlockedByLines,
+		UseCodeowners:             flags.Content.UseCodeowners,
+		SeedFiles:                 flags.Content.SeedFiles,
+		LinkExisting:              flags.Content.LinkExisting,
+		Delay:                     flags.Cleanup.Delay,
+		MaxItemsPerType:           flags.Content.MaxItemsPerType,
+		Force:                     flags.Content.Force,
+		Metadata:                  run.metadata,
+		MarkerLabel:               run.markerLabel,
+	}
+}
+
+// prepareHydration builds the hydrate options, with the manifest, report, plan and dry-run summary
+// the output flags ask for and the preserve rules label pruning honors
+func (run *hydrateRun) prepareHydration(ctx context.Context) error {
+	flags := run.flags
+	run.options = run.buildOptions()
+	if flags.Output.ShowBodies {
+		run.options.BodyPreviewLength = flags.Output.BodyPreviewLength
+	}
+
+	manifestPath, err := prepareManifest(ctx, &run.options, flags.Content.ContinueFrom, flags.Output.ManifestFile, run.logger)
+	if err != nil {
+		return err
+	}
+	run.manifestPath = manifestPath
+
+	// In GitHub Actions the results are also written to the job summary
+	run.stepSummaryPath = flags.Output.StepSummary
+	if run.stepSummaryPath == "" {
+		run.stepSummaryPath = os.Getenv("GITHUB_STEP_SUMMARY")
+	}
+	// Verification reads the created items back from the report
+	if run.stepSummaryPath != "" || flags.Output.ReportFormat != "" || flags.Output.Verify {
+		run.options.Report = hydrate.NewRunReport()
+	}
+	if flags.Output.PlanFile != "" {
+		run.options.Plan = hydrate.NewPlan()
+	}
+	if flags.Cleanup.DryRunSummaryOnly {
+		run.options.DryRunSummary = hydrate.NewDryRunSummary()
 	}
 
-	// Handle project-specific errors
-	if err != nil && projectFlags.CreateProject {
-		// Check if this is a project-related error
-		if errors.IsLayer(err, "project") && projectFlags.FailOnProjectError {
+	// Label pruning and clearing the default labels honor the same preserve rules as cleanup
+	if flags.Labels.Prune || flags.Labels.ClearDefaults {
+		run.options.PreserveConfig, err = loadPreserveConfig(ctx, flags.Cleanup, run.cfg)
+		if err != nil {
 			return err
-		} else if errors.IsLayer(err, "project") {
-			// Log project error but continue if FailOnProjectError is false
-			logger.Info("Project creation failed but continuing with standard hydration: %v", err)
-			// Retry with standard hydration
-			err = hydrate.HydrateWithLabels(ctx, client, cfg, issues, discussions, pullRequests, logger, cleanupFlags.DryRun)
 		}
 	}
+	return nil
+}
+
+// hydrate performs the hydration. When the project cannot be created and --fail-on-project-error
+// is not set, the run is retried without a project.
+func (run *hydrateRun) hydrate(ctx context.Context) error {
+	err := hydrate.HydrateWithOptions(ctx, run.client, run.cfg, run.options, run.logger)
+	if err == nil || !run.flags.Project.CreateProject || !errors.IsLayer(err, "project") || run.flags.Project.FailOnProjectError {
+		return err
+	}
+
+	run.logger.Info("Project creation failed but continuing with standard hydration: %v", err)
+	run.options.CreateProject = false
+	if run.options.Report != nil {
+		// The retry repeats every section, so only its results are reported
+		run.options.Report = hydrate.NewRunReport()
+	}
+	return hydrate.HydrateWithOptions(ctx, run.client, run.cfg, run.options, run.logger)
+}
+
+// report writes the outputs of the run, verifies the created items when requested and turns the
+// hydration error into the result of the command
+func (run *hydrateRun) report(ctx context.Context, err error) error {
+	if outputErr := run.writeOutputs(err); outputErr != nil {
+		return outputErr
+	}
+
+	// Items created before a partial failure are verified too
+	var verifyErr error
+	if run.flags.Output.Verify && !run.options.DryRun && (err == nil || errors.IsPartialFailure(err)) {
+		verifyErr = hydrate.VerifyCreated(ctx, run.client, run.options.Report, run.logger)
+	}
 
 	// Handle the result
-	return handleHydrationResult(ctx, err, logger)
+	result := handleHydrationResult(ctx, err, run.logger)
+	if result == nil {
+		result = verifyErr
+	}
+
+	// Report API usage for the whole run, including cleanup
+	if reportErr := reportAPICalls(ctx, run.client, run.flags.Output.APIStatsFile, run.logger); reportErr != nil && result == nil {
+		return reportErr
+	}
+
+	return result
+}
+
+// writeOutputs saves the manifest and writes the dry-run summary, plan, job summary and report of
+// a run that ended with err. Only a plan that cannot be written is returned as an error.
+func (run *hydrateRun) writeOutputs(err error) error {
+	output := run.flags.Output
+	logger := run.logger
+
+	// Save the manifest even when hydration failed so that the run can be resumed
+	if run.manifestPath != "" && !run.options.DryRun {
+		if saveErr := run.options.Manifest.Save(run.manifestPath); saveErr != nil {
+			logger.Info("Warning: failed to write manifest: %v", saveErr)
+		} else {
+			logger.Info("Wrote manifest of created items to %s", run.manifestPath)
+		}
+	}
+
+	if run.options.DryRunSummary != nil && err == nil {
+		logger.Info("%s", common.Colorizer{Enabled: run.options.Color}.Bold("Dry-run summary:"))
+		for _, line := range run.options.DryRunSummary.Lines() {
+			logger.Info("  %s", line)
+		}
+	}
+
+	if output.PlanFile != "" && err == nil {
+		if planErr := writePlan(output.PlanFile, run.options.Plan); planErr != nil {
+			return planErr
+		}
+		logger.Info("Wrote dry-run plan to %s", output.PlanFile)
+	}
+
+	if run.stepSummaryPath != "" {
+		if summaryErr := writeStepSummary(run.stepSummaryPath, run.options.Report, run.repoInfo, run.options.DryRun); summaryErr != nil {
+			logger.Info("Warning: failed to write job summary: %v", summaryErr)
+		}
+	}
+
+	if output.ReportFormat != "" {
+		if reportErr := writeReport(output.ReportFile, run.options.Report, run.reportTemplate, run.repoInfo, run.options.DryRun); reportErr != nil {
+			logger.Info("Warning: failed to write report: %v", reportErr)
+		} else if output.ReportFile != "" {
+			logger.Info("Wrote report to %s", output.ReportFile)
+		}
+	}
+	return nil
+}
+
+// runID identifies the run in metadata footers: the GitHub Actions run ID when there is one, so the
+// footer links back to the workflow run, or otherwise the request ID that prefixes the run's logs
+func runID(logger *common.StandardLogger) string {
+	if id := strings.TrimSpace(os.Getenv("GITHUB_RUN_ID")); id != "" {
+		return id
+	}
+	return logger.RequestID()
+}
+
+// checkProjectScopes probes whether the token can use projects before --create-project needs them.
+// A missing scope is warned about with the command that grants it, or returned as an error when
+// strict is set. Any other failure of the probe is only logged, since project creation reports it again.
+func checkProjectScopes(ctx context.Context, client githubapi.GitHubClient, strict bool, logger common.Logger) error {
+	err := client.CheckProjectAccess(ctx)
+	if err == nil {
+		logger.Debug("Token can access projects")
+		return nil
+	}
+	if errors.IsContextError(err) {
+		return err
+	}
+
+	layeredErr := errors.AsLayeredError(err)
+	if layeredErr == nil || layeredErr.Context["type"] != "permission" {
+		logger.Debug("Could not check project access: %v", err)
+		return nil
+	}
+	if strict {
+		return err
+	}
+	logger.Info("Warning: %s; project creation is likely to fail (use --strict to stop before hydrating)", layeredErr.Message)
+	return nil
+}
+
+// checkRepositoryWritable returns an error when the repository is archived or locked, since GitHub
+// rejects every create and delete with an error that does not say why. A failed lookup is not
+// treated as a reason to stop, since hydration reports any real access problem itself.
+func checkRepositoryWritable(ctx context.Context, client githubapi.GitHubClient, logger common.Logger) error {
+	features, err := client.GetRepositoryFeatures(ctx)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return err
+		}
+		logger.Debug("Could not check whether the repository is archived or locked: %v", err)
+		return nil
+	}
+
+	switch {
+	case features.IsArchived:
+		return errors.ValidationError("check_repository", "repository is archived; unarchive it or choose another target")
+	case features.IsLocked:
+		return errors.ValidationError("check_repository", "repository is locked; wait for the lock to be released or choose another target")
+	}
+	return nil
+}
+
+// errTooManyExisting stops listing once checkRepositoryEmpty has counted more items than it allows.
+// It is matched with errors.Is, so it is recognized even when a List*Pages method wraps it.
+var errTooManyExisting = errors.ValidationError("check_empty", "too many existing items")
+
+// checkRepositoryEmpty returns an error when the repository already has more than maxExisting issues,
+// discussions and pull requests of the included types. Demo content is usually seeded into a fresh
+// repository, so existing content suggests the wrong target. Listing stops as soon as the limit is passed.
+func checkRepositoryEmpty(ctx context.Context, client githubapi.GitHubClient, repoInfo *repositoryInfo, issues, discussions, pullRequests bool, maxExisting int, logger common.Logger) error {
+	existing := 0
+	count := func(items int) error {
+		existing += items
+		if existing > maxExisting {
+			return errTooManyExisting
+		}
+		return nil
+	}
+
+	var err error
+	if issues {
+		err = client.ListIssuePages(ctx, func(page []types.Issue) error { return count(len(page)) })
+	}
+	if err == nil && discussions {
+		err = client.ListDiscussionPages(ctx, func(page []types.Discussion) error { return count(len(page)) })
+	}
+	if err == nil && pullRequests {
+		err = client.ListPRPages(ctx, func(page []types.PullRequest) error { return count(len(page)) })
+	}
+	if err != nil && !stderrors.Is(err, errTooManyExisting) {
+		return err
+	}
+
+	if existing > maxExisting {
+		target := repoInfo.Owner + "/" + repoInfo.Repo
+		err := errors.ValidationError("check_empty", fmt.Sprintf("%s already has at least %d issues, discussions, or pull requests, more than --check-empty-max %d; check that it is the intended target", target, existing, maxExisting))
+		return errors.WithContextSafe(err, "repository", target)
+	}
+	logger.Debug("Repository has %d existing issues, discussions, and pull requests", existing)
+	return nil
+}
+
+// checkAllowedRepository returns an error when allowRepos is not empty and does not contain the
+// resolved repository. Entries are "owner/repo" and compared case-insensitively, as GitHub does.
+func checkAllowedRepository(repoInfo *repositoryInfo, allowRepos []string) error {
+	if len(allowRepos) == 0 {
+		return nil
+	}
+
+	target := repoInfo.Owner + "/" + repoInfo.Repo
+	for _, allowed := range allowRepos {
+		if strings.EqualFold(strings.TrimSpace(allowed), target) {
+			return nil
+		}
+	}
+
+	err := errors.ValidationError("validate_repository", fmt.Sprintf("refusing to modify %s: it is not in --allow-repos (%s)", target, strings.Join(allowRepos, ", ")))
+	return errors.WithContextSafe(err, "repository", target)
+}
+
+// prepareManifest sets up the manifest of created items. With continueFrom, the manifest of a previous
+// run is loaded so that its items are skipped. It returns the path the manifest should be written to:
+// manifestFile when set, otherwise continueFrom so that a resumed run keeps its manifest up to date.
+func prepareManifest(ctx context.Context, options *hydrate.HydrateOptions, continueFrom, manifestFile string, logger common.Logger) (string, error) {
+	if continueFrom == "" && manifestFile == "" {
+		return "", nil
+	}
+
+	if continueFrom == "" {
+		options.Manifest = hydrate.NewManifest()
+		return manifestFile, nil
+	}
+
+	manifest, err := hydrate.LoadManifest(ctx, continueFrom)
+	if err != nil {
+		return "", err
+	}
+	logger.Info("Resuming from %s: %d issues, %d discussions and %d pull requests already created",
+		continueFrom, len(manifest.Issues), len(manifest.Discussions), len(manifest.PullRequests))
+	options.Manifest = manifest
+
+	if manifestFile != "" {
+		return manifestFile, nil
+	}
+	return continueFrom, nil
+}
+
+// writeStepSummary appends the results of the run to path as Markdown. GitHub Actions renders the
+// file named by $GITHUB_STEP_SUMMARY on the workflow run page; other steps may also write to it,
+// so the file is appended to rather than replaced.
+func writeStepSummary(path string, report *hydrate.RunReport, repoInfo *repositoryInfo, dryRun bool) error {
+	markdown := report.Markdown(fmt.Sprintf("gh-demo hydration: %s/%s", repoInfo.Owner, repoInfo.Repo), dryRun)
+
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		err = errors.FileError("write_step_summary", "failed to open job summary file", err)
+		return errors.WithContextSafe(err, "path", path)
+	}
+	defer file.Close()
+
+	if _, err := file.WriteString(markdown + "\n"); err != nil {
+		err = errors.FileError("write_step_summary", "failed to write job summary", err)
+		return errors.WithContextSafe(err, "path", path)
+	}
+	return nil
+}
+
+// writeReport renders the results of the run as a Markdown document and writes it to path,
+// replacing any previous report, or to standard output when path is empty
+func writeReport(path string, report *hydrate.RunReport, tmpl *template.Template, repoInfo *repositoryInfo, dryRun bool) error {
+	output := report.MarkdownDocument(fmt.Sprintf("gh-demo hydration: %s/%s", repoInfo.Owner, repoInfo.Repo), dryRun)
+	if tmpl != nil {
+		var err error
+		if output, err = report.RenderTemplate(tmpl, repoInfo.Owner, repoInfo.Repo, dryRun); err != nil {
+			return err
+		}
+	}
+	if path == "" {
+		_, err := fmt.Fprint(os.Stdout, output)
+		return err
+	}
+	if err := os.WriteFile(path, []byte(output), 0o644); err != nil {
+		err = errors.FileError("write_report", "failed to write report", err)
+		return errors.WithContextSafe(err, "path", path)
+	}
+	return nil
+}
+
+// loadReportTemplate reads and parses the --report-template file. It returns nil when the report
+// format is not template, and an error when the two flags are not used together.
+func loadReportTemplate(format, path string) (*template.Template, error) {
+	if format != reportFormatTemplate {
+		if path != "" {
+			return nil, errors.ValidationError("validate_flags", "--report-template requires --report-format template")
+		}
+		return nil, nil
+	}
+	if path == "" {
+		return nil, errors.ValidationError("validate_flags", "--report-format template requires --report-template")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = errors.FileError("read_report_template", "failed to read report template", err)
+		return nil, errors.WithContextSafe(err, "path", path)
+	}
+	tmpl, err := hydrate.ParseReportTemplate(filepath.Base(path), string(data))
+	if err != nil {
+		return nil, errors.WithContextSafe(err, "path", path)
+	}
+	return tmpl, nil
+}
+
+// writePlan writes the dry-run plan to path as JSON, replacing any previous plan
+func writePlan(path string, plan *hydrate.Plan) error {
+	data, err := plan.JSON()
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		err = errors.FileError("write_plan", "failed to write dry-run plan", err)
+		return errors.WithContextSafe(err, "path", path)
+	}
+	return nil
+}
+
+// reportAPICalls logs the number of GraphQL calls made per operation and the remaining rate limit
+// budget and, when path is set, writes the same summary to path as JSON. The rate limit is
+// informational, so failing to fetch it is only logged at debug level.
+func reportAPICalls(ctx context.Context, client githubapi.GitHubClient, path string, logger common.Logger) error {
+	// Snapshot the counts first so the rate limit query is not included
+	summary := githubapi.NewAPICallSummary(client.APICallCounts())
+	logger.Info("%s", summary.String())
+
+	rateLimit, err := client.GetRateLimit(ctx)
+	if err != nil {
+		logger.Debug("Could not fetch rate limit: %v", err)
+	} else {
+		summary.RateLimit = rateLimit
+		logger.Info("%s", summary.RateLimitString())
+	}
+
+	if path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(summary, "", "  ")
+	if err != nil {
+		return errors.FileError("marshal_api_stats", "failed to encode API call summary", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		err = errors.FileError("write_api_stats", "failed to write API call summary", err)
+		return errors.WithContextSafe(err, "path", path)
+	}
+	return nil
 }
 
 // shouldPerformCleanup determines if any cleanup operations should be performed
 func shouldPerformCleanup(ctx context.Context, flags CleanupFlags) bool {
-	return flags.Clean || flags.CleanIssues || flags.CleanDiscussions || flags.CleanPRs || flags.CleanLabels
+	return flags.Clean || flags.CleanIssues || flags.CleanDiscussions || flags.CleanPRs || flags.CleanLabels || flags.DeleteProjectItemsOnly || flags.PruneBranches || flags.CleanCreatedLabels != "" || flags.Teardown
 }
 
-// performCleanup executes cleanup operations based on flags
-func performCleanup(ctx context.Context, client githubapi.GitHubClient, flags CleanupFlags, cfg *config.Configuration, logger common.Logger) error {
-	// Load preserve configuration
+// loadPreserveConfig loads the preserve configuration from the --preserve-config flag,
+// falling back to the default path within the configuration directory.
+func loadPreserveConfig(ctx context.Context, flags CleanupFlags, cfg *config.Configuration) (*config.PreserveConfig, error) {
 	preserveConfigPath := flags.PreserveConfig
 	if preserveConfigPath == "" {
 		preserveConfigPath = cfg.PreservePath
@@ -187,7 +1052,18 @@ func performCleanup(ctx context.Context, client githubapi.GitHubClient, flags Cl
 
 	preserveConfig, err := config.LoadPreserveConfig(ctx, preserveConfigPath)
 	if err != nil {
-		return errors.FileError("load_preserve_config", "failed to load preserve configuration", err)
+		return nil, errors.FileError("load_preserve_config", "failed to load preserve configuration", err)
+	}
+	return preserveConfig, nil
+}
+
+// performCleanup executes cleanup operations based on flags. A non-empty titlePrefix limits cleanup
+// to the issues, discussions and pull requests whose title carries it.
+func performCleanup(ctx context.Context, client githubapi.GitHubClient, flags CleanupFlags, projectFlags ProjectFlags, titlePrefix string, cfg *config.Configuration, logger common.Logger) error {
+	// Load preserve configuration
+	preserveConfig, err := loadPreserveConfig(ctx, flags, cfg)
+	if err != nil {
+		return err
 	}
 
 	// Create cleanup options
@@ -198,55 +1074,243 @@ func performCleanup(ctx context.Context, client githubapi.GitHubClient, flags Cl
 		CleanLabels:      flags.Clean || flags.CleanLabels,
 		DryRun:           flags.DryRun,
 		PreserveConfig:   preserveConfig,
+		TitlePrefix:      titlePrefix,
+		Concurrency:      flags.Concurrency,
+		Delay:            flags.Delay,
+		CloseDiscussions: flags.CloseDiscussions,
+		CloseComment:     flags.CloseComment,
+		SummaryOnly:      flags.DryRunSummaryOnly,
+	}
+
+	// An empty prefix would match every branch, so pruning requires one
+	if flags.PruneBranches {
+		if strings.TrimSpace(flags.BranchPrefix) == "" {
+			return errors.ValidationError("validate_branch_prefix", "--prune-branches requires a non-empty --branch-prefix")
+		}
+		cleanupOptions.PruneBranchPrefix = flags.BranchPrefix
+	}
+
+	// Labels created by a recorded run are the only ones cleaned, whatever else was requested
+	if flags.CleanCreatedLabels != "" {
+		manifest, err := hydrate.LoadManifest(ctx, flags.CleanCreatedLabels)
+		if err != nil {
+			return err
+		}
+		cleanupOptions.CleanLabels = true
+		cleanupOptions.LabelManifest = manifest
+		logger.Debug("Label cleanup limited to the %d labels recorded in %s", len(manifest.Labels), flags.CleanCreatedLabels)
+	}
+
+	// The project board to clear is the one described by the project configuration
+	if flags.DeleteProjectItemsOnly {
+		projectConfig, err := config.LoadProjectConfiguration(ctx, projectConfigPath(projectFlags, cfg))
+		if err != nil {
+			return errors.ProjectConfigurationError("load_project_config", "failed to load project configuration", err)
+		}
+		cleanupOptions.ClearProjectItems = true
+		cleanupOptions.ProjectTitle = projectConfig.Title
 	}
 
 	// Perform cleanup
-	summary, err := hydrate.CleanupBeforeHydration(ctx, client, cleanupOptions, logger)
+	var summary *hydrate.CleanupSummary
+	if flags.Teardown {
+		summary, err = performTeardown(ctx, client, flags, projectFlags, cleanupOptions, cfg, logger)
+	} else {
+		summary, err = hydrate.CleanupBeforeHydration(ctx, client, cleanupOptions, logger)
+	}
 	if summary != nil {
 		// Log cleanup summary
 		logger.Info("Cleanup completed: %d issues cleaned, %d discussions cleaned, %d PRs cleaned, %d labels cleaned",
 			summary.IssuesDeleted, summary.DiscussionsDeleted, summary.PRsDeleted, summary.LabelsDeleted)
+
+		if flags.SummaryFile != "" {
+			if writeErr := writeCleanupSummary(flags.SummaryFile, summary); writeErr != nil {
+				return writeErr
+			}
+		}
 	}
 
 	return err
 }
 
-// NewHydrateCmd returns the Cobra command for repository hydration
-func NewHydrateCmd() *cobra.Command {
-	var owner, repo, configPath string
-	var issues, discussions, pullRequests bool
-	var debug bool
+// projectConfigPath returns the --project-config path, or the project configuration in the config path
+func projectConfigPath(projectFlags ProjectFlags, cfg *config.Configuration) string {
+	if projectFlags.ProjectConfig != "" {
+		return projectFlags.ProjectConfig
+	}
+	return cfg.ProjectConfigPath
+}
 
-	// Cleanup flags
-	var cleanupFlags CleanupFlags
+// performTeardown removes the content marked with the marker label of metadata.json together with
+// the demo's project and labels. The project and labels are the ones recorded in --teardown-manifest.
+// Without a manifest, the project is the one titled in the project configuration, and only when that
+// file exists, so that a default title never selects an unrelated project. Only a project the manifest
+// records as created is deleted; any other project only has its items removed.
+func performTeardown(ctx context.Context, client githubapi.GitHubClient, flags CleanupFlags, projectFlags ProjectFlags, cleanupOptions hydrate.CleanupOptions, cfg *config.Configuration, logger common.Logger) (*hydrate.CleanupSummary, error) {
+	metadataConfig, err := config.LoadMetadataConfiguration(ctx, cfg.MetadataPath)
+	if err != nil {
+		return nil, errors.WithContextSafe(err, "path", cfg.MetadataPath)
+	}
 
-	// Project flags
-	var projectFlags ProjectFlags
+	var manifest *hydrate.Manifest
+	if flags.TeardownManifest != "" {
+		if manifest, err = hydrate.LoadManifest(ctx, flags.TeardownManifest); err != nil {
+			return nil, err
+		}
+	}
+
+	if cleanupOptions.ProjectTitle == "" && (manifest == nil || manifest.Project == nil) {
+		path := projectConfigPath(projectFlags, cfg)
+		if _, statErr := os.Stat(path); statErr == nil {
+			projectConfig, err := config.LoadProjectConfiguration(ctx, path)
+			if err != nil {
+				return nil, errors.ProjectConfigurationError("load_project_config", "failed to load project configuration", err)
+			}
+			cleanupOptions.ProjectTitle = projectConfig.Title
+		} else {
+			logger.Debug("No project recorded or configured; the teardown leaves projects alone")
+		}
+	}
+
+	return hydrate.Teardown(ctx, client, cleanupOptions, metadataConfig.MarkerLabel, manifest, logger)
+}
+
+// writeCleanupSummary writes the cleanup summary to path as JSON, replacing any previous summary.
+// Errors and the item lists are always written as lists, so an empty error list means the cleanup had no failures.
+func writeCleanupSummary(path string, summary *hydrate.CleanupSummary) error {
+	output := *summary
+	if output.Errors == nil {
+		output.Errors = []string{}
+	}
+	if output.DeletedItems == nil {
+		output.DeletedItems = []hydrate.CleanupItem{}
+	}
+	if output.PreservedItems == nil {
+		output.PreservedItems = []hydrate.CleanupItem{}
+	}
+
+	data, err := json.MarshalIndent(output, "", "  ")
+	if err != nil {
+		return errors.FileError("marshal_cleanup_summary", "failed to encode cleanup summary", err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		err = errors.FileError("write_cleanup_summary", "failed to write cleanup summary", err)
+		return errors.WithContextSafe(err, "path", path)
+	}
+	return nil
+}
+
+// NewHydrateCmd returns the Cobra command for repository hydration
+func NewHydrateCmd() *cobra.Command {
+	var flags hydrateOptions
 
 	cmd := &cobra.Command{
 		Use:   "hydrate",
 		Short: "Hydrate a repository with demo issues, discussions, and pull requests",
 		Long: `Hydrate a repository with demo issues, discussions, and pull requests.
 
+Repository flags select the repository and the content to hydrate:
+  --owner, --repo: Repository to hydrate (default: detected from the git remote of the current directory)
+  --remote: Git remote used to detect the repository when --owner or --repo is omitted (default: gh's remote selection)
+  --config-path: Path to the configuration files relative to the project root (default: .github/demos)
+  --issues, --discussions, --prs: Include that content type (default: true; pass --issues=false to skip it)
+  --allow-repos: Comma-separated owner/repo list; refuse to create or clean up content in any other repository
+  --debug: Enable debug mode for detailed logging
+
 Cleanup flags allow you to clean existing objects before hydrating:
   --clean: Clean all object types (issues, discussions, PRs, labels)
   --clean-issues: Clean only issues
   --clean-discussions: Clean only discussions
   --clean-prs: Clean only pull requests
   --clean-labels: Clean only labels
+  --clean-created-labels: Clean only the labels recorded as created in a manifest, never pre-existing ones
+  --delete-project-items-only: Remove every item from the configured project, keeping the project and its fields
   --dry-run: Preview what would be created and deleted without actually performing operations
+  --dry-run-summary-only: With --dry-run, print only the counts per type instead of a line per item
   --preserve-config: Path to preserve configuration file (default: .github/demos/preserve.json)
+  --max-pages: Maximum pages of 100 items fetched when listing existing content (default: 100)
+  --prune-branches: Delete branches starting with --branch-prefix (default: demo/), except the default and protected branches
+  --branch-prefix: Name prefix of the demo branches deleted by --prune-branches (default: demo/)
+  --close-discussions: Close discussions as outdated during cleanup instead of deleting them
+  --close-comment: Comment this text on each issue and pull request before cleanup closes it
+  --teardown: Remove the content carrying the marker label of metadata.json, the demo's project and its labels, then exit without hydrating; a project the run did not create only has its items removed
+  --teardown-manifest: Manifest of the run to tear down; only the labels it records, and the project it records as created, are deleted
+  --cleanup-summary-file: Write the deleted and preserved counts per type and any errors of the cleanup to this file as JSON
+  --cleanup-concurrency: Number of items deleted at once during cleanup (balanced: 1)
 
 Project flags allow you to create and organize content in a GitHub Project:
   --create-project: Create a ProjectV2 and associate all created content with it
   --project-config: Path to project configuration file (default: .github/demos/project-config.json)
-  --fail-on-project-error: Fail entire operation if project creation fails (default: continue with standard hydration)`,
+  --project-owner: User or organization whose projects are used (default: the repository owner)
+  --fail-on-project-error: Fail entire operation if project creation fails (default: continue with standard hydration)
+
+Label flags control how repository labels are managed:
+  --labels-prune: Delete repository labels not defined in labels.json (honors --preserve-config and --dry-run)
+  --no-auto-labels: Only create labels defined in labels.json; referenced labels that do not exist are skipped with a warning
+  --default-labels: Also create GitHub's default labels; labels.json entries with the same name take precedence
+  --normalize-labels: Treat a label as existing when the repository has it in a different case, instead of creating it
+  --clear-default-labels: Delete GitHub's default labels (bug, documentation, ...) that labels.json does not define and content does not use
+  --labels-only: Only reconcile the labels defined in labels.json, creating no issues, discussions, or pull requests
+  --labels-file: Read label definitions from this file or https:// URL instead of labels.json
+  --strict: Fail instead of warning when labels in labels.json share or nearly share a color
+  --labels-fail-open: Continue hydrating with a warning when existing labels cannot be listed, instead of stopping
+
+Content flags control how issues, discussions, and pull requests are created:
+  --issues-file, --discussions-file, --prs-file: Read that content type from this file instead of the config path
+  --idempotent: When a create times out, check for an item with the same title before retrying to avoid duplicates
+  --skip-existing: Skip discussions whose title already exists in the repository (within the same category when one is set)
+  --upsert: Update the body, labels, and assignees of open issues with the same title instead of creating duplicates
+  --link-existing: Let duplicate_of and cross-references name open issues and pull requests this run did not create
+  --default-discussion-category: Category used for discussions that do not specify one
+  --base: Base branch used for pull requests that do not specify one
+  --base-default-branch: Without --base, use the repository's default branch for pull requests that do not specify one
+  --truncate-bodies: Truncate bodies longer than GitHub's limit instead of failing before creation
+  --continue-from: Resume from the manifest of a previous run, skipping items it records as created
+  --max-items-per-type: Fail before creation when more issues, discussions, or pull requests would be created (default 500)
+  --force: Only warn when --max-items-per-type is exceeded
+  --check-empty: Stop before cleanup or hydration when the repository already has content, to catch the wrong target
+  --check-empty-max: Number of existing issues, discussions, and pull requests --check-empty allows (default: 0)
+  --validate-only: Validate and preflight the configuration offline, then exit before any API call
+  --seed-files: Write the files listed in files.json, such as a README, to the default branch before creating content
+  --title-prefix: Prepend this string to every created title (e.g. "[DEMO] "); cleanup then only deletes items whose title carries it
+  --render-emoji: Replace known emoji shortcodes such as :rocket: in titles and bodies with Unicode emoji
+  --use-issue-templates: Apply the title prefix and labels of the repository's issue templates to matching issues
+  --use-codeowners: Request reviews on created pull requests from the owners of the paths in CODEOWNERS
+  --blocked-by-lines: End the body of each issue with a "Blocked by #N" line for every issue it depends on
+  --append-metadata: Append a footer naming gh-demo, the run ID and the time to every created body
+
+Network flags trade latency for reliability on GitHub API calls:
+  --profile: Preset of the settings below: fast, balanced (default), or resilient; explicit flags override it
+  --api-timeout: Time allowed for each GitHub API operation, including its retries (balanced: 30s)
+  --retries: Number of times a read query is retried after a transient server error (balanced: 2)
+  --retry-backoff: Delay before the first retry, doubled for each further retry (balanced: 1s)
+  --max-requests-per-minute: Pace all GitHub API requests to this rate after an initial burst (default: 0, no pacing)
+  --resume-on-rate-limit: When the rate limit is exhausted, wait for it to reset and retry the create instead of failing
+  --max-rate-limit-wait: Longest wait for the rate limit to reset with --resume-on-rate-limit (default: 1h)
+  --delay: Time to wait between creates, and between each cleanup worker's deletions (default: 0)
+
+Output flags control run reporting:
+  --api-stats-file: Write the per-operation GraphQL call counts to this file as JSON
+  --manifest: Write a manifest of created items to this file (default with --continue-from: update that manifest)
+  --show-bodies: With --dry-run, show a preview of each body alongside its title
+  --body-preview-length: Number of body characters shown by --show-bodies (default: 200)
+  --step-summary: Append a Markdown table of results to this file (default: $GITHUB_STEP_SUMMARY when set)
+  --plan-file: With --dry-run, write a sorted JSON plan of the labels and content that would be created
+  --report-format: Render the results of the run as a report: markdown or template
+  --report-file: With --report-format, write the report to this file instead of standard output
+  --report-template: Go template file used to render the report with --report-format template
+  --no-color: Print the summary without colors (also disabled by NO_COLOR or when stdout is not a terminal)
+  --verify: After creation, fetch every created item again and fail if one is missing or has a different title
+  --explain-permissions: Print the token scopes the enabled features need and check the current token, without writing anything`,
 		Run: func(cmd *cobra.Command, args []string) {
 			// Create context with cancellation for Ctrl+C
 			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 			defer cancel()
 
-			err := executeHydrate(ctx, owner, repo, configPath, issues, discussions, pullRequests, debug, cleanupFlags, projectFlags)
+			err := applyNetworkProfile(cmd, &flags.Network, &flags.Cleanup)
+			if err == nil {
+				err = executeHydrate(ctx, flags)
+			}
 			if err != nil {
 				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
 				os.Exit(1)
@@ -255,38 +1319,114 @@ Project flags allow you to create and organize content in a GitHub Project:
 	}
 
 	// Setup command line flags
-	setupHydrateCmdFlags(cmd, &owner, &repo, &configPath, &issues, &discussions, &pullRequests, &debug, &cleanupFlags, &projectFlags)
+	setupHydrateCmdFlags(cmd, &flags)
 
 	return cmd
 }
 
 // setupHydrateCmdFlags configures all command line flags for the hydrate command.
 // This separates flag configuration from command creation for better maintainability.
-func setupHydrateCmdFlags(cmd *cobra.Command, owner, repo, configPath *string, issues, discussions, pullRequests, debug *bool, cleanupFlags *CleanupFlags, projectFlags *ProjectFlags) {
+func setupHydrateCmdFlags(cmd *cobra.Command, flags *hydrateOptions) {
 	// Repository flags
-	cmd.Flags().StringVar(owner, "owner", "", "GitHub repository owner (required)")
-	cmd.Flags().StringVar(repo, "repo", "", "GitHub repository name (required)")
-	cmd.Flags().StringVar(configPath, "config-path", config.DefaultConfigPath, "Path to configuration files relative to project root")
+	cmd.Flags().StringVar(&flags.Owner, "owner", "", "GitHub repository owner (required)")
+	cmd.Flags().StringVar(&flags.Repo, "repo", "", "GitHub repository name (required)")
+	cmd.Flags().StringVar(&flags.Remote, "remote", "", "Git remote used to detect the repository when --owner or --repo is omitted (default: gh's remote selection)")
+	cmd.Flags().StringVar(&flags.ConfigPath, "config-path", config.DefaultConfigPath, "Path to configuration files relative to project root")
 
 	// Content type flags
-	cmd.Flags().BoolVar(issues, "issues", true, "Include issues")
-	cmd.Flags().BoolVar(discussions, "discussions", true, "Include discussions")
-	cmd.Flags().BoolVar(pullRequests, "prs", true, "Include pull requests")
+	cmd.Flags().BoolVar(&flags.Issues, "issues", true, "Include issues")
+	cmd.Flags().BoolVar(&flags.Discussions, "discussions", true, "Include discussions")
+	cmd.Flags().BoolVar(&flags.PullRequests, "prs", true, "Include pull requests")
 
 	// Debug flag
-	cmd.Flags().BoolVar(debug, "debug", false, "Enable debug mode for detailed logging")
+	cmd.Flags().BoolVar(&flags.Debug, "debug", false, "Enable debug mode for detailed logging")
 
 	// Cleanup flags
-	cmd.Flags().BoolVar(&cleanupFlags.Clean, "clean", false, "Clean all existing objects before hydrating")
-	cmd.Flags().BoolVar(&cleanupFlags.CleanIssues, "clean-issues", false, "Clean existing issues before hydrating")
-	cmd.Flags().BoolVar(&cleanupFlags.CleanDiscussions, "clean-discussions", false, "Clean existing discussions before hydrating")
-	cmd.Flags().BoolVar(&cleanupFlags.CleanPRs, "clean-prs", false, "Clean existing pull requests before hydrating")
-	cmd.Flags().BoolVar(&cleanupFlags.CleanLabels, "clean-labels", false, "Clean existing labels before hydrating")
-	cmd.Flags().BoolVar(&cleanupFlags.DryRun, "dry-run", false, "Preview what would be created and deleted without actually performing operations")
-	cmd.Flags().StringVar(&cleanupFlags.PreserveConfig, "preserve-config", "", "Path to preserve configuration file (default: .github/demos/preserve.json)")
+	cmd.Flags().BoolVar(&flags.Cleanup.Clean, "clean", false, "Clean all existing objects before hydrating")
+	cmd.Flags().BoolVar(&flags.Cleanup.CleanIssues, "clean-issues", false, "Clean existing issues before hydrating")
+	cmd.Flags().BoolVar(&flags.Cleanup.CleanDiscussions, "clean-discussions", false, "Clean existing discussions before hydrating")
+	cmd.Flags().BoolVar(&flags.Cleanup.CleanPRs, "clean-prs", false, "Clean existing pull requests before hydrating")
+	cmd.Flags().BoolVar(&flags.Cleanup.CleanLabels, "clean-labels", false, "Clean existing labels before hydrating")
+	cmd.Flags().StringVar(&flags.Cleanup.CleanCreatedLabels, "clean-created-labels", "", "Clean only the labels recorded as created in this manifest file (see --manifest)")
+	cmd.Flags().StringVar(&flags.Cleanup.SummaryFile, "cleanup-summary-file", "", "Write the cleanup summary (deleted and preserved counts per type and errors) to this file as JSON")
+	cmd.Flags().BoolVar(&flags.Cleanup.DeleteProjectItemsOnly, "delete-project-items-only", false, "Remove every item from the configured project board without deleting the project")
+	cmd.Flags().BoolVar(&flags.Cleanup.DryRun, "dry-run", false, "Preview what would be created and deleted without actually performing operations")
+	cmd.Flags().BoolVar(&flags.Cleanup.DryRunSummaryOnly, "dry-run-summary-only", false, "With --dry-run, print only the counts of items that would be created, updated and skipped per type")
+	cmd.Flags().StringVar(&flags.Cleanup.PreserveConfig, "preserve-config", "", "Path to preserve configuration file (default: .github/demos/preserve.json)")
+	cmd.Flags().IntVar(&flags.Cleanup.MaxPages, "max-pages", config.DefaultMaxPages, "Maximum pages of 100 items fetched when listing existing content; a warning is shown when results are truncated")
+	cmd.Flags().IntVar(&flags.Cleanup.Concurrency, "cleanup-concurrency", config.DefaultCleanupConcurrency, "Number of items deleted at once during cleanup")
+	cmd.Flags().DurationVar(&flags.Cleanup.Delay, "delay", 0, "Time to wait between create operations, and between each cleanup worker's delete operations, to avoid secondary rate limits")
+
+	// Network flags
+	cmd.Flags().StringVar(&flags.Network.Profile, "profile", config.DefaultNetworkProfile, fmt.Sprintf("Preset of timeout, retry, and cleanup concurrency settings (%s); explicit flags override it", strings.Join(config.NetworkProfileNames(), ", ")))
+	cmd.Flags().DurationVar(&flags.Network.APITimeout, "api-timeout", config.APITimeout, "Time allowed for each GitHub API operation, including its retries")
+	cmd.Flags().IntVar(&flags.Network.Retries, "retries", config.DefaultRetries, "Number of times a read query is retried after a transient server error")
+	cmd.Flags().DurationVar(&flags.Network.RetryBackoff, "retry-backoff", config.DefaultRetryBackoff, "Delay before the first retry, doubled for each further retry")
+	cmd.Flags().IntVar(&flags.Network.MaxRequestsPerMinute, "max-requests-per-minute", 0, "Pace all GitHub API requests, including retries, to at most this many per minute (0 disables pacing)")
+	cmd.Flags().BoolVar(&flags.Content.ResumeOnRateLimit, "resume-on-rate-limit", false, "When the rate limit is exhausted, wait for it to reset and retry the create instead of failing")
+	cmd.Flags().DurationVar(&flags.Content.MaxRateLimitWait, "max-rate-limit-wait", config.DefaultMaxRateLimitWait, "Longest wait for the rate limit to reset with --resume-on-rate-limit")
+	cmd.Flags().BoolVar(&flags.Cleanup.CloseDiscussions, "close-discussions", false, "Close discussions as outdated during cleanup instead of deleting them")
+	cmd.Flags().StringVar(&flags.Cleanup.CloseComment, "close-comment", "", "Comment added to each issue and pull request before cleanup closes it, e.g. \"Closed by gh-demo cleanup\"")
+	cmd.Flags().BoolVar(&flags.Cleanup.PruneBranches, "prune-branches", false, "Delete branches starting with --branch-prefix, except the default and protected branches")
+	cmd.Flags().StringVar(&flags.Cleanup.BranchPrefix, "branch-prefix", config.DefaultBranchPrefix, "Name prefix of the demo branches deleted by --prune-branches")
+	cmd.Flags().BoolVar(&flags.Cleanup.Teardown, "teardown", false, "Delete the content carrying the marker label of metadata.json, the demo's project and the labels it created, then exit without hydrating; a project the run did not create only has its items removed")
+	cmd.Flags().StringVar(&flags.Cleanup.TeardownManifest, "teardown-manifest", "", "Manifest of the run --teardown removes; limits label deletion to the labels it records and deletes its project only when it records the project as created")
+	cmd.Flags().StringSliceVar(&flags.Cleanup.AllowRepos, "allow-repos", nil, "Comma-separated owner/repo list; refuse to create or clean up content in any other repository")
 
 	// Project flags
-	cmd.Flags().BoolVar(&projectFlags.CreateProject, "create-project", false, "Create a ProjectV2 and associate all created content with it")
-	cmd.Flags().StringVar(&projectFlags.ProjectConfig, "project-config", "", "Path to project configuration file (default: .github/demos/project-config.json)")
-	cmd.Flags().BoolVar(&projectFlags.FailOnProjectError, "fail-on-project-error", false, "Fail entire operation if project creation fails (default: continue with standard hydration)")
+	cmd.Flags().BoolVar(&flags.Project.CreateProject, "create-project", false, "Create a ProjectV2 and associate all created content with it")
+	cmd.Flags().BoolVar(&flags.Project.Strict, "strict", false, "Stop when labels in labels.json share or nearly share a color, and with --create-project, before cleanup or hydration when the token lacks the project scope")
+	cmd.Flags().StringVar(&flags.Project.ProjectOwner, "project-owner", "", "User or organization login whose projects --create-project and --delete-project-items-only use (default: the repository owner)")
+	cmd.Flags().StringVar(&flags.Project.ProjectConfig, "project-config", "", "Path to project configuration file (default: .github/demos/project-config.json)")
+	cmd.Flags().BoolVar(&flags.Project.FailOnProjectError, "fail-on-project-error", false, "Fail entire operation if project creation fails (default: continue with standard hydration)")
+
+	// Label flags
+	cmd.Flags().BoolVar(&flags.Labels.Prune, "labels-prune", false, "Delete repository labels not defined in labels.json (honors preserve config and dry-run)")
+	cmd.Flags().BoolVar(&flags.Labels.NoAutoLabels, "no-auto-labels", false, "Only create labels defined in labels.json instead of auto-creating referenced labels")
+	cmd.Flags().BoolVar(&flags.Labels.Normalize, "normalize-labels", false, "Match label names case-insensitively when checking which labels already exist")
+	cmd.Flags().BoolVar(&flags.Labels.ClearDefaults, "clear-default-labels", false, "Delete GitHub's default labels before ensuring labels.json, keeping those labels.json defines or content references")
+	cmd.Flags().BoolVar(&flags.Labels.LabelsOnly, "labels-only", false, "Only create the labels defined in labels.json (and prune with --labels-prune), without creating any content")
+	cmd.Flags().BoolVar(&flags.Labels.DefaultLabels, "default-labels", false, "Also create GitHub's default labels (bug, documentation, ...); labels.json entries with the same name take precedence")
+	cmd.Flags().StringVar(&flags.Labels.LabelsFile, "labels-file", "", "Read label definitions from this file or https:// URL of a shared labels file instead of labels.json")
+	cmd.Flags().BoolVar(&flags.Labels.LabelsFailOpen, "labels-fail-open", false, "Continue hydrating with a warning when existing labels cannot be listed, instead of stopping")
+
+	// Content flags
+	cmd.Flags().StringVar(&flags.Content.IssuesFile, "issues-file", "", "Read issues from this file instead of issues.json in the config path")
+	cmd.Flags().StringVar(&flags.Content.DiscussionsFile, "discussions-file", "", "Read discussions from this file instead of discussions.json in the config path")
+	cmd.Flags().StringVar(&flags.Content.PRsFile, "prs-file", "", "Read pull requests from this file instead of prs.json in the config path")
+	cmd.Flags().BoolVar(&flags.Content.Idempotent, "idempotent", false, "Check for an existing item by title before retrying a create that timed out")
+	cmd.Flags().BoolVar(&flags.Content.Upsert, "upsert", false, "Update the body, labels, and assignees of open issues with the same title instead of creating duplicates")
+	cmd.Flags().BoolVar(&flags.Content.LinkExisting, "link-existing", false, "Let duplicate_of and cross-references fall back to open issues and pull requests this run did not create when no created item has the title")
+	cmd.Flags().BoolVar(&flags.Content.SkipExisting, "skip-existing", false, "Skip discussions whose title already exists in the repository, within the same category when one is set")
+	cmd.Flags().StringVar(&flags.Content.DefaultDiscussionCategory, "default-discussion-category", "", "Category used for discussions that do not specify one")
+	cmd.Flags().StringVar(&flags.Content.DefaultBase, "base", "", "Base branch used for pull requests that do not specify one")
+	cmd.Flags().BoolVar(&flags.Content.BaseDefaultBranch, "base-default-branch", false, "When --base is not set, use the repository's default branch for pull requests that do not specify one")
+	cmd.Flags().BoolVar(&flags.Content.TruncateBodies, "truncate-bodies", false, "Truncate bodies longer than GitHub's limit instead of failing before creation")
+	cmd.Flags().StringVar(&flags.Content.ContinueFrom, "continue-from", "", "Resume from a previous run's manifest, skipping items it records as created")
+	cmd.Flags().StringVar(&flags.Content.TitlePrefix, "title-prefix", "", "Prepend this string to every created title (e.g. \"[DEMO] \"); cleanup then only deletes items whose title carries it")
+	cmd.Flags().BoolVar(&flags.Content.RenderEmoji, "render-emoji", false, "Replace known emoji shortcodes such as :rocket: in titles and bodies with Unicode emoji")
+	cmd.Flags().BoolVar(&flags.Content.UseIssueTemplates, "use-issue-templates", false, "Apply the title prefix and labels of the repository's issue templates to matching issues")
+	cmd.Flags().IntVar(&flags.Content.MaxItemsPerType, "max-items-per-type", config.DefaultMaxItemsPerType, "Fail before creating anything when more issues, discussions, or pull requests than this would be created (0 disables the check)")
+	cmd.Flags().BoolVar(&flags.Content.Force, "force", false, "Create content even when --max-items-per-type is exceeded")
+	cmd.Flags().BoolVar(&flags.Content.CheckEmpty, "check-empty", false, "Stop before cleanup or hydration when the repository already has more than --check-empty-max issues, discussions, or pull requests")
+	cmd.Flags().IntVar(&flags.Content.CheckEmptyMax, "check-empty-max", 0, "Number of existing issues, discussions, and pull requests --check-empty allows")
+	cmd.Flags().BoolVar(&flags.Content.ValidateOnly, "validate-only", false, "Load, validate and preflight the configuration without any API calls, then exit without creating anything")
+	cmd.Flags().BoolVar(&flags.Content.UseCodeowners, "use-codeowners", false, "Request reviews on created pull requests from the owners of every path in the repository's CODEOWNERS file")
+	cmd.Flags().BoolVar(&flags.Content.SeedFiles, "seed-files", false, "Write the files listed in files.json to the repository's default branch before creating content")
+	cmd.Flags().BoolVar(&flags.Content.BlockedByLines, "blocked-by-lines", false, "End the body of each issue with a \"Blocked by #N\" line for every issue it depends on")
+	cmd.Flags().BoolVar(&flags.Content.AppendMetadata, "append-metadata", false, "Append a footer naming gh-demo, the run ID and the time to every created body")
+
+	// Output flags
+	cmd.Flags().StringVar(&flags.Output.APIStatsFile, "api-stats-file", "", "Write the per-operation GraphQL call counts to this file as JSON")
+	cmd.Flags().StringVar(&flags.Output.ManifestFile, "manifest", "", "Write a manifest of created items to this file so the run can be resumed with --continue-from")
+	cmd.Flags().BoolVar(&flags.Output.ShowBodies, "show-bodies", false, "With --dry-run, show a truncated preview of each body alongside its title")
+	cmd.Flags().IntVar(&flags.Output.BodyPreviewLength, "body-preview-length", config.DefaultBodyPreviewLength, "Number of body characters shown by --show-bodies")
+	cmd.Flags().StringVar(&flags.Output.StepSummary, "step-summary", "", "Append a Markdown table of results to this file (default: $GITHUB_STEP_SUMMARY when set)")
+	cmd.Flags().StringVar(&flags.Output.PlanFile, "plan-file", "", "With --dry-run, write a sorted JSON plan of the labels and content that would be created, for diff-based review")
+	cmd.Flags().StringVar(&flags.Output.ReportFormat, "report-format", "", "Render the results of the run as a report; supported: markdown, template")
+	cmd.Flags().StringVar(&flags.Output.ReportFile, "report-file", "", "With --report-format, write the report to this file instead of standard output")
+	cmd.Flags().BoolVar(&flags.Output.NoColor, "no-color", false, "Print the summary without colors, which are otherwise used when stdout is a terminal unless NO_COLOR is set")
+	cmd.Flags().BoolVar(&flags.Output.Verify, "verify", false, "After creation, re-fetch every created item and the project, and fail if any is missing or has a different title")
+	cmd.Flags().BoolVar(&flags.Output.ExplainPermissions, "explain-permissions", false, "Print the token scopes and fine-grained permissions the enabled features need, check the current token against them, and exit without writing")
+	cmd.Flags().StringVar(&flags.Output.ReportTemplate, "report-template", "", "Go template file used to render the report with --report-format template")
 }
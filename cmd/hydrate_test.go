@@ -2,12 +2,24 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+	"github.com/cli/go-gh/v2/pkg/repository"
 	"github.com/spf13/cobra"
 )
 
@@ -44,6 +56,13 @@ func TestHydrateCmd_FlagProperties(t *testing.T) {
 			expectedDefault: "",
 			shouldHaveUsage: true,
 		},
+		{
+			name:            "remote flag exists",
+			flagName:        "remote",
+			shouldExist:     true,
+			expectedDefault: "",
+			shouldHaveUsage: true,
+		},
 		{
 			name:            "issues flag exists with default true",
 			flagName:        "issues",
@@ -79,6 +98,90 @@ func TestHydrateCmd_FlagProperties(t *testing.T) {
 			expectedDefault: ".github/demos",
 			shouldHaveUsage: true,
 		},
+		{
+			name:            "labels-prune flag exists with default false",
+			flagName:        "labels-prune",
+			shouldExist:     true,
+			expectedDefault: "false",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "idempotent flag exists with default false",
+			flagName:        "idempotent",
+			shouldExist:     true,
+			expectedDefault: "false",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "default-discussion-category flag exists with empty default",
+			flagName:        "default-discussion-category",
+			shouldExist:     true,
+			expectedDefault: "",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "truncate-bodies flag exists with default false",
+			flagName:        "truncate-bodies",
+			shouldExist:     true,
+			expectedDefault: "false",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "api-stats-file flag exists with empty default",
+			flagName:        "api-stats-file",
+			shouldExist:     true,
+			expectedDefault: "",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "no-auto-labels flag exists with false default",
+			flagName:        "no-auto-labels",
+			shouldExist:     true,
+			expectedDefault: "false",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "continue-from flag exists with empty default",
+			flagName:        "continue-from",
+			shouldExist:     true,
+			expectedDefault: "",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "manifest flag exists with empty default",
+			flagName:        "manifest",
+			shouldExist:     true,
+			expectedDefault: "",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "base flag exists with empty default",
+			flagName:        "base",
+			shouldExist:     true,
+			expectedDefault: "",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "show-bodies flag exists with false default",
+			flagName:        "show-bodies",
+			shouldExist:     true,
+			expectedDefault: "false",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "body-preview-length flag exists with default length",
+			flagName:        "body-preview-length",
+			shouldExist:     true,
+			expectedDefault: "200",
+			shouldHaveUsage: true,
+		},
+		{
+			name:            "step-summary flag exists with empty default",
+			flagName:        "step-summary",
+			shouldExist:     true,
+			expectedDefault: "",
+			shouldHaveUsage: true,
+		},
 	}
 
 	cmd := NewHydrateCmd()
@@ -267,6 +370,21 @@ func TestHydrateCmdFlags(t *testing.T) {
 	}
 }
 
+// TestNewHydrateCmd_LongHelpDocumentsFlags tests that every flag of the command is described in its long help
+func TestNewHydrateCmd_LongHelpDocumentsFlags(t *testing.T) {
+	cmd := NewHydrateCmd()
+
+	names := regexp.MustCompile(`(?m)^\s+--([a-z0-9-]+)`).FindAllStringSubmatch(cmd.Flags().FlagUsages(), -1)
+	if len(names) == 0 {
+		t.Fatal("Expected flag usages to list the command's flags")
+	}
+	for _, name := range names {
+		if !regexp.MustCompile(`--` + regexp.QuoteMeta(name[1]) + `\b[^-]`).MatchString(cmd.Long) {
+			t.Errorf("Expected the long help to document --%s", name[1])
+		}
+	}
+}
+
 // TestHydrateCmdRun_GitContext tests the git context detection logic
 func TestHydrateCmdRun_GitContext(t *testing.T) {
 	if testing.Short() {
@@ -454,6 +572,8 @@ func TestHydrateCmdCleanupFlags(t *testing.T) {
 		{"clean-discussions", "false"},
 		{"clean-prs", "false"},
 		{"clean-labels", "false"},
+		{"delete-project-items-only", "false"},
+		{"max-pages", "100"},
 		{"dry-run", "false"},
 		{"preserve-config", ""},
 	}
@@ -515,6 +635,21 @@ func TestShouldPerformCleanup(t *testing.T) {
 			flags:    CleanupFlags{CleanLabels: true},
 			expected: true,
 		},
+		{
+			name:     "delete project items only flag",
+			flags:    CleanupFlags{DeleteProjectItemsOnly: true},
+			expected: true,
+		},
+		{
+			name:     "prune branches flag",
+			flags:    CleanupFlags{PruneBranches: true},
+			expected: true,
+		},
+		{
+			name:     "clean created labels flag",
+			flags:    CleanupFlags{CleanCreatedLabels: "manifest.json"},
+			expected: true,
+		},
 		{
 			name:     "multiple flags",
 			flags:    CleanupFlags{CleanIssues: true, CleanLabels: true},
@@ -654,6 +789,9 @@ func TestNewHydrateCmd_ConfigPath(t *testing.T) {
 
 // TestResolveRepositoryInfo tests the repository information resolution logic
 func TestResolveRepositoryInfo(t *testing.T) {
+	// Keep a GitHub Actions runner's repository out of the git context fallback
+	t.Setenv("GITHUB_REPOSITORY", "")
+
 	tests := []struct {
 		name          string
 		owner         string
@@ -732,7 +870,7 @@ func TestResolveRepositoryInfo(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ctx := context.Background()
-			result, err := resolveRepositoryInfo(ctx, tt.owner, tt.repo)
+			result, err := resolveRepositoryInfo(ctx, tt.owner, tt.repo, "")
 
 			if tt.expectError {
 				if err == nil {
@@ -777,11 +915,138 @@ func TestResolveRepositoryInfo(t *testing.T) {
 }
 
 // TestResolveRepositoryInfo_ContextCancellation tests context cancellation handling
+// TestResolveRepositoryInfo_Remote tests that a named remote is used for repository detection
+func TestResolveRepositoryInfo_Remote(t *testing.T) {
+	t.Setenv("GITHUB_REPOSITORY", "")
+	originalCurrent, originalRemote := currentRepository, remoteRepository
+	t.Cleanup(func() {
+		currentRepository, remoteRepository = originalCurrent, originalRemote
+	})
+
+	currentRepository = func() (repository.Repository, error) {
+		return repository.Repository{Host: "github.com", Owner: "origin-owner", Name: "origin-repo"}, nil
+	}
+
+	tests := []struct {
+		name           string
+		owner          string
+		repo           string
+		remote         string
+		remoteErr      error
+		expectError    bool
+		expectedOwner  string
+		expectedRepo   string
+		expectedRemote string
+	}{
+		{
+			name:           "named remote is used",
+			remote:         "upstream",
+			expectedOwner:  "upstream-owner",
+			expectedRepo:   "upstream-repo",
+			expectedRemote: "upstream",
+		},
+		{
+			name:          "default remote selection when no remote given",
+			expectedOwner: "origin-owner",
+			expectedRepo:  "origin-repo",
+		},
+		{
+			name:          "explicit owner and repo skip remote detection",
+			owner:         "explicit-owner",
+			repo:          "explicit-repo",
+			remote:        "upstream",
+			expectedOwner: "explicit-owner",
+			expectedRepo:  "explicit-repo",
+		},
+		{
+			name:           "unknown remote is an error",
+			remote:         "missing",
+			remoteErr:      fmt.Errorf("error: No such remote 'missing'"),
+			expectError:    true,
+			expectedRemote: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requestedRemote string
+			remoteRepository = func(ctx context.Context, remote string) (repository.Repository, error) {
+				requestedRemote = remote
+				if tt.remoteErr != nil {
+					return repository.Repository{}, tt.remoteErr
+				}
+				return repository.Repository{Host: "github.com", Owner: "upstream-owner", Name: "upstream-repo"}, nil
+			}
+
+			result, err := resolveRepositoryInfo(context.Background(), tt.owner, tt.repo, tt.remote)
+
+			if requestedRemote != tt.expectedRemote {
+				t.Errorf("Expected remote %q to be queried, got %q", tt.expectedRemote, requestedRemote)
+			}
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.remote) {
+					t.Errorf("Expected error to name remote %q, got: %v", tt.remote, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Owner != tt.expectedOwner || result.Repo != tt.expectedRepo {
+				t.Errorf("Expected %s/%s, got %s/%s", tt.expectedOwner, tt.expectedRepo, result.Owner, result.Repo)
+			}
+		})
+	}
+}
+
+// TestResolveRepositoryInfo_Environment tests that GITHUB_REPOSITORY is used before the git context
+func TestResolveRepositoryInfo_Environment(t *testing.T) {
+	originalCurrent := currentRepository
+	t.Cleanup(func() { currentRepository = originalCurrent })
+	currentRepository = func() (repository.Repository, error) {
+		return repository.Repository{Host: "github.com", Owner: "origin-owner", Name: "origin-repo"}, nil
+	}
+
+	tests := []struct {
+		name          string
+		env           string
+		owner         string
+		repo          string
+		expectedOwner string
+		expectedRepo  string
+	}{
+		{name: "parsed from environment", env: "actions-owner/actions-repo", expectedOwner: "actions-owner", expectedRepo: "actions-repo"},
+		{name: "explicit flags override environment", env: "actions-owner/actions-repo", owner: "flag-owner", repo: "flag-repo", expectedOwner: "flag-owner", expectedRepo: "flag-repo"},
+		{name: "explicit owner keeps repo from environment", env: "actions-owner/actions-repo", owner: "flag-owner", expectedOwner: "flag-owner", expectedRepo: "actions-repo"},
+		{name: "unset falls back to git context", env: "", expectedOwner: "origin-owner", expectedRepo: "origin-repo"},
+		{name: "malformed falls back to git context", env: "no-slash", expectedOwner: "origin-owner", expectedRepo: "origin-repo"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GITHUB_REPOSITORY", tt.env)
+
+			result, err := resolveRepositoryInfo(context.Background(), tt.owner, tt.repo, "")
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.Owner != tt.expectedOwner || result.Repo != tt.expectedRepo {
+				t.Errorf("Expected %s/%s, got %s/%s", tt.expectedOwner, tt.expectedRepo, result.Owner, result.Repo)
+			}
+		})
+	}
+}
+
 func TestResolveRepositoryInfo_ContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel immediately
 
-	result, err := resolveRepositoryInfo(ctx, "owner", "repo")
+	result, err := resolveRepositoryInfo(ctx, "owner", "repo", "")
 
 	if err == nil {
 		t.Error("Expected context cancellation error")
@@ -1026,10 +1291,8 @@ func TestExecuteHydrate_ParameterValidation(t *testing.T) {
 			}()
 
 			ctx := context.Background()
-			cleanupFlags := CleanupFlags{}
-			projectFlags := ProjectFlags{}
 
-			err = executeHydrate(ctx, tt.owner, tt.repo, tt.configPath, true, true, true, false, cleanupFlags, projectFlags)
+			err = executeHydrate(ctx, hydrateOptions{Owner: tt.owner, Repo: tt.repo, ConfigPath: tt.configPath, Issues: true, Discussions: true, PullRequests: true})
 
 			if tt.expectError {
 				if err == nil {
@@ -1055,10 +1318,7 @@ func TestExecuteHydrate_ContextCancellation(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	cancel() // Cancel immediately
 
-	cleanupFlags := CleanupFlags{}
-	projectFlags := ProjectFlags{}
-
-	err := executeHydrate(ctx, "owner", "repo", ".github/demos", true, true, true, false, cleanupFlags, projectFlags)
+	err := executeHydrate(ctx, hydrateOptions{Owner: "owner", Repo: "repo", ConfigPath: ".github/demos", Issues: true, Discussions: true, PullRequests: true})
 
 	if err == nil {
 		t.Error("Expected context cancellation error")
@@ -1078,3 +1338,803 @@ func TestPerformCleanup(t *testing.T) {
 
 	t.Skip("Skipping performCleanup tests - requires complex GitHub client mocking")
 }
+
+// TestReportAPICalls tests that API call counts are logged and written as JSON
+func TestReportAPICalls(t *testing.T) {
+	client, err := githubapi.NewGHClientWithClients("owner", "repo", githubapi.NewDefaultMockGraphQL())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	if _, err := client.ListLabels(context.Background()); err != nil {
+		t.Fatalf("Unexpected error listing labels: %v", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "api-stats.json")
+	logger := &testutil.MockLogger{}
+
+	if err := reportAPICalls(context.Background(), client, path, logger); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "API calls: 1 total (ListLabels: 1)") {
+		t.Errorf("Expected text summary in output, got: %v", logger.InfoCalls)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected JSON file to be written: %v", err)
+	}
+	var summary githubapi.APICallSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if summary.Total != 1 || summary.ByOperation["ListLabels"] != 1 {
+		t.Errorf("Expected 1 ListLabels call in JSON summary, got %+v", summary)
+	}
+}
+
+// TestReportAPICalls_RateLimit tests that the remaining rate limit is surfaced in the summary
+func TestReportAPICalls_RateLimit(t *testing.T) {
+	resetAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	client := hydrate.NewFailingMockGitHubClient(hydrate.MockConfig{
+		RateLimit: &types.RateLimit{Limit: 5000, Remaining: 4321, Cost: 1, ResetAt: resetAt},
+	})
+	path := filepath.Join(t.TempDir(), "api-stats.json")
+	logger := &testutil.MockLogger{}
+
+	if err := reportAPICalls(context.Background(), client, path, logger); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "Rate limit: 4321/5000 points remaining, resets at 2025-01-01T12:00:00Z"
+	if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), expected) {
+		t.Errorf("Expected %q in output, got: %v", expected, logger.InfoCalls)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Expected JSON file to be written: %v", err)
+	}
+	var summary githubapi.APICallSummary
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if summary.RateLimit == nil || summary.RateLimit.Remaining != 4321 || !summary.RateLimit.ResetAt.Equal(resetAt) {
+		t.Errorf("Expected rate limit in JSON summary, got %+v", summary.RateLimit)
+	}
+}
+
+// TestWriteStepSummary tests appending the Markdown results table to the job summary file
+func TestWriteStepSummary(t *testing.T) {
+	tests := []struct {
+		name           string
+		dryRun         bool
+		sections       []hydrate.SectionSummary
+		expectedOutput []string
+		unexpected     []string
+	}{
+		{
+			name: "results table with failures",
+			sections: []hydrate.SectionSummary{
+				{Name: "Labels", Total: 2, Success: 2},
+				{Name: "Issues", Total: 3, Success: 2, Failures: 1, Errors: []string{"failed to create issue 'Broken':\nHTTP 502"},
+					Created: []hydrate.CreatedItem{{Title: "A", Number: 4}, {Title: "B", Number: 5}}},
+			},
+			expectedOutput: []string{
+				"existing content from an earlier step\n",
+				"### gh-demo hydration: test-owner/test-repo\n",
+				"| Section | Total | Successful | Failed | Created |",
+				"| Labels | 2 | 2 | 0 |  |",
+				"| Issues | 3 | 2 | 1 | #4, #5 |",
+				"**Failures**",
+				"- failed to create issue 'Broken': HTTP 502",
+			},
+		},
+		{
+			name:     "dry run is labelled",
+			dryRun:   true,
+			sections: []hydrate.SectionSummary{{Name: "Issues", Total: 1, Success: 1}},
+			expectedOutput: []string{
+				"### gh-demo hydration: test-owner/test-repo (dry run)",
+				"| Issues | 1 | 1 | 0 |  |",
+			},
+			unexpected: []string{"**Failures**"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "step_summary.md")
+			if err := os.WriteFile(path, []byte("existing content from an earlier step\n"), 0o644); err != nil {
+				t.Fatalf("Failed to create summary file: %v", err)
+			}
+
+			report := hydrate.NewRunReport()
+			for _, section := range tt.sections {
+				report.AddSection(section)
+			}
+
+			if err := writeStepSummary(path, report, &repositoryInfo{Owner: "test-owner", Repo: "test-repo"}, tt.dryRun); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			data, err := os.ReadFile(path)
+			if err != nil {
+				t.Fatalf("Failed to read summary file: %v", err)
+			}
+			content := string(data)
+			if !strings.HasPrefix(content, "existing content from an earlier step\n") {
+				t.Errorf("Expected existing content to be kept, got:\n%s", content)
+			}
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(content, expected) {
+					t.Errorf("Expected summary to contain %q, got:\n%s", expected, content)
+				}
+			}
+			for _, unexpected := range tt.unexpected {
+				if strings.Contains(content, unexpected) {
+					t.Errorf("Expected summary not to contain %q, got:\n%s", unexpected, content)
+				}
+			}
+		})
+	}
+}
+
+// TestCheckAllowedRepository tests the repository allowlist
+func TestCheckAllowedRepository(t *testing.T) {
+	tests := []struct {
+		name        string
+		allowRepos  []string
+		expectError bool
+	}{
+		{name: "no allowlist allows every repository"},
+		{name: "listed repository is allowed", allowRepos: []string{"other/repo", "test-owner/test-repo"}},
+		{name: "match is case-insensitive", allowRepos: []string{" Test-Owner/Test-Repo "}},
+		{name: "unlisted repository is refused", allowRepos: []string{"test-owner/other-repo"}, expectError: true},
+		{name: "owner alone is not enough", allowRepos: []string{"test-owner"}, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := checkAllowedRepository(&repositoryInfo{Owner: "test-owner", Repo: "test-repo"}, tt.allowRepos)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), "refusing to modify test-owner/test-repo") {
+					t.Errorf("Expected a refusal naming the repository, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestExecuteHydrate_DisallowedRepository tests that a repository outside the allowlist aborts the run
+func TestExecuteHydrate_DisallowedRepository(t *testing.T) {
+	cleanupFlags := CleanupFlags{Clean: true, AllowRepos: []string{"owner/sandbox"}}
+
+	err := executeHydrate(context.Background(), hydrateOptions{Owner: "owner", Repo: "production", ConfigPath: ".github/demos", Issues: true, Discussions: true, PullRequests: true, Cleanup: cleanupFlags})
+
+	if err == nil {
+		t.Fatal("Expected the run to be refused")
+	}
+	if !strings.Contains(err.Error(), "refusing to modify owner/production") {
+		t.Errorf("Expected a refusal naming the repository, got: %v", err)
+	}
+}
+
+// TestExecuteHydrate_PlanFileRequiresDryRun tests that --plan-file is rejected outside a dry run
+func TestExecuteHydrate_PlanFileRequiresDryRun(t *testing.T) {
+	outputFlags := OutputFlags{PlanFile: filepath.Join(t.TempDir(), "plan.json")}
+
+	err := executeHydrate(context.Background(), hydrateOptions{Owner: "owner", Repo: "repo", ConfigPath: ".github/demos", Issues: true, Discussions: true, PullRequests: true, Output: outputFlags})
+
+	if err == nil || !strings.Contains(err.Error(), "--plan-file requires --dry-run") {
+		t.Errorf("Expected --plan-file to require --dry-run, got: %v", err)
+	}
+}
+
+// TestExecuteHydrate_DryRunSummaryOnlyRequiresDryRun tests that --dry-run-summary-only is rejected outside a dry run
+func TestExecuteHydrate_DryRunSummaryOnlyRequiresDryRun(t *testing.T) {
+	cleanupFlags := CleanupFlags{DryRunSummaryOnly: true}
+
+	err := executeHydrate(context.Background(), hydrateOptions{Owner: "owner", Repo: "repo", ConfigPath: ".github/demos", Issues: true, Discussions: true, PullRequests: true, Cleanup: cleanupFlags})
+
+	if err == nil || !strings.Contains(err.Error(), "--dry-run-summary-only requires --dry-run") {
+		t.Errorf("Expected --dry-run-summary-only to require --dry-run, got: %v", err)
+	}
+}
+
+// TestExecuteHydrate_UnsupportedReportFormat tests that only known report formats are accepted
+func TestExecuteHydrate_UnsupportedReportFormat(t *testing.T) {
+	outputFlags := OutputFlags{ReportFormat: "html"}
+
+	err := executeHydrate(context.Background(), hydrateOptions{Owner: "owner", Repo: "repo", ConfigPath: ".github/demos", Issues: true, Discussions: true, PullRequests: true, Output: outputFlags})
+
+	if err == nil || !strings.Contains(err.Error(), "unsupported --report-format 'html'") {
+		t.Errorf("Expected unsupported report format error, got: %v", err)
+	}
+}
+
+// TestWriteReport tests that the Markdown report is written to the requested file
+func TestWriteReport(t *testing.T) {
+	report := hydrate.NewRunReport()
+	report.AddSection(hydrate.SectionSummary{Name: "Issues", Total: 1, Success: 1,
+		Created: []hydrate.CreatedItem{{Title: "A", Number: 7, URL: "https://github.com/test-owner/test-repo/issues/7"}}})
+	path := filepath.Join(t.TempDir(), "report.md")
+
+	if err := writeReport(path, report, nil, &repositoryInfo{Owner: "test-owner", Repo: "test-repo"}, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	for _, expected := range []string{"# gh-demo hydration: test-owner/test-repo\n", "## Issues", "[#7](https://github.com/test-owner/test-repo/issues/7)"} {
+		if !strings.Contains(string(data), expected) {
+			t.Errorf("Expected report to contain %q, got:\n%s", expected, data)
+		}
+	}
+}
+
+// TestLoadReportTemplate tests that the report template flags are used together and template errors are reported
+func TestLoadReportTemplate(t *testing.T) {
+	dir := t.TempDir()
+	validPath := filepath.Join(dir, "valid.tmpl")
+	if err := os.WriteFile(validPath, []byte("{{total .Sections}} items"), 0o644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+	malformedPath := filepath.Join(dir, "malformed.tmpl")
+	if err := os.WriteFile(malformedPath, []byte("{{if .DryRun}}"), 0o644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		format       string
+		path         string
+		expectLoaded bool
+		errorText    string
+	}{
+		{name: "markdown needs no template", format: reportFormatMarkdown},
+		{name: "valid template", format: reportFormatTemplate, path: validPath, expectLoaded: true},
+		{name: "template format without file", format: reportFormatTemplate, errorText: "requires --report-template"},
+		{name: "template file without format", format: reportFormatMarkdown, path: validPath, errorText: "requires --report-format template"},
+		{name: "missing file", format: reportFormatTemplate, path: filepath.Join(dir, "missing.tmpl"), errorText: "failed to read report template"},
+		{name: "malformed template", format: reportFormatTemplate, path: malformedPath, errorText: "invalid report template"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := loadReportTemplate(tt.format, tt.path)
+
+			if tt.errorText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorText) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.errorText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if (tmpl != nil) != tt.expectLoaded {
+				t.Errorf("Expected template loaded %v, got %v", tt.expectLoaded, tmpl != nil)
+			}
+		})
+	}
+}
+
+// TestWriteReport_Template tests that the report is rendered with the custom template when one is given
+func TestWriteReport_Template(t *testing.T) {
+	report := hydrate.NewRunReport()
+	report.AddSection(hydrate.SectionSummary{Name: "Issues", Total: 2, Success: 2})
+	tmpl, err := hydrate.ParseReportTemplate("report.tmpl", "{{.Owner}}/{{.Repo}}: {{successes .Sections}} created")
+	if err != nil {
+		t.Fatalf("Unexpected parse error: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "report.txt")
+
+	if err := writeReport(path, report, tmpl, &repositoryInfo{Owner: "test-owner", Repo: "test-repo"}, false); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read report: %v", err)
+	}
+	if string(data) != "test-owner/test-repo: 2 created" {
+		t.Errorf("Expected rendered template, got %q", data)
+	}
+}
+
+// TestCheckProjectScopes tests that a missing project scope warns by default and aborts with --strict
+func TestCheckProjectScopes(t *testing.T) {
+	tests := []struct {
+		name          string
+		missingScope  bool
+		strict        bool
+		expectError   bool
+		expectWarning bool
+	}{
+		{name: "sufficient scope proceeds", missingScope: false, strict: true},
+		{name: "missing scope warns", missingScope: true, expectWarning: true},
+		{name: "missing scope aborts with strict", missingScope: true, strict: true, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := hydrate.NewFailingMockGitHubClient(hydrate.MockConfig{
+				ProjectAccess: testutil.ErrorConfig{ShouldError: tt.missingScope},
+			})
+			logger := &testutil.MockLogger{}
+
+			err := checkProjectScopes(context.Background(), client, tt.strict, logger)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "'project' scope") {
+					t.Errorf("Expected scope error, got: %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+
+			warned := strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Warning: token is missing the 'project' scope")
+			if warned != tt.expectWarning {
+				t.Errorf("Expected warning %v, got: %v", tt.expectWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestCheckRepositoryWritable tests that an archived or locked repository stops the run before anything is created
+func TestCheckRepositoryWritable(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      hydrate.MockConfig
+		expectError string
+	}{
+		{name: "writable repository proceeds"},
+		{
+			name:        "archived repository aborts",
+			config:      hydrate.MockConfig{RepositoryFeatures: &types.RepositoryFeatures{HasIssuesEnabled: true, IsArchived: true}},
+			expectError: "repository is archived; unarchive it or choose another target",
+		},
+		{
+			name:        "locked repository aborts",
+			config:      hydrate.MockConfig{RepositoryFeatures: &types.RepositoryFeatures{HasIssuesEnabled: true, IsLocked: true}},
+			expectError: "repository is locked",
+		},
+		{
+			name:   "failed lookup proceeds",
+			config: hydrate.MockConfig{Features: testutil.ErrorConfig{ShouldError: true}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := hydrate.NewFailingMockGitHubClient(tt.config)
+
+			err := checkRepositoryWritable(context.Background(), client, &testutil.MockLogger{})
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(client.CreatedIssues) != 0 || len(client.CreatedLabels) != 0 {
+				t.Error("Expected the check to make no writes")
+			}
+		})
+	}
+}
+
+// TestCheckRepositoryEmpty tests that --check-empty stops at a populated repository, counting only the included types
+func TestCheckRepositoryEmpty(t *testing.T) {
+	tests := []struct {
+		name          string
+		issues        []types.Issue
+		pullRequests  []types.PullRequest
+		includePRs    bool
+		maxExisting   int
+		expectError   string
+		expectedPages int
+	}{
+		{
+			name:       "empty repository proceeds",
+			includePRs: true,
+		},
+		{
+			name:          "populated repository aborts",
+			issues:        []types.Issue{{Title: "A"}, {Title: "B"}, {Title: "C"}},
+			includePRs:    true,
+			expectError:   "owner/repo already has at least 1 issues, discussions, or pull requests, more than --check-empty-max 0",
+			expectedPages: 1,
+		},
+		{
+			name:         "items within the maximum proceed",
+			issues:       []types.Issue{{Title: "A"}},
+			pullRequests: []types.PullRequest{{Title: "B"}},
+			includePRs:   true,
+			maxExisting:  2,
+		},
+		{
+			name:         "excluded types are not counted",
+			pullRequests: []types.PullRequest{{Title: "B"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := hydrate.NewFailingMockGitHubClient(hydrate.MockConfig{ListPageSize: 1})
+			client.CreatedIssues = tt.issues
+			client.CreatedPRs = tt.pullRequests
+			repoInfo := &repositoryInfo{Owner: "owner", Repo: "repo"}
+
+			err := checkRepositoryEmpty(context.Background(), client, repoInfo, true, true, tt.includePRs, tt.maxExisting, &testutil.MockLogger{})
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.expectedPages > 0 && client.ListedPages != tt.expectedPages {
+				t.Errorf("Expected listing to stop after %d pages, got %d", tt.expectedPages, client.ListedPages)
+			}
+		})
+	}
+}
+
+// wrappingPagesClient wraps the errors its issue page callbacks return, as a client adding context would
+type wrappingPagesClient struct {
+	*hydrate.ConfigurableMockGitHubClient
+}
+
+// ListIssuePages passes each page to callback and wraps the error that stops the listing
+func (c wrappingPagesClient) ListIssuePages(ctx context.Context, callback func([]types.Issue) error) error {
+	if err := c.ConfigurableMockGitHubClient.ListIssuePages(ctx, callback); err != nil {
+		return errors.APIError("list_issues", "failed to list issues", err)
+	}
+	return nil
+}
+
+// TestCheckRepositoryEmpty_WrappedStop tests that the early stop is recognized when the listing wraps it
+func TestCheckRepositoryEmpty_WrappedStop(t *testing.T) {
+	client := hydrate.NewFailingMockGitHubClient(hydrate.MockConfig{ListPageSize: 1})
+	client.CreatedIssues = []types.Issue{{Title: "A"}, {Title: "B"}}
+	repoInfo := &repositoryInfo{Owner: "owner", Repo: "repo"}
+
+	err := checkRepositoryEmpty(context.Background(), wrappingPagesClient{client}, repoInfo, true, false, false, 0, &testutil.MockLogger{})
+
+	if err == nil || !strings.Contains(err.Error(), "owner/repo already has at least 1 issues") {
+		t.Fatalf("Expected the populated repository error, got: %v", err)
+	}
+}
+
+// TestApplyNetworkProfile tests that each profile sets the network flags and that explicit flags override it
+func TestApplyNetworkProfile(t *testing.T) {
+	tests := []struct {
+		name                string
+		args                []string
+		expected            NetworkFlags
+		expectedConcurrency int
+		expectError         string
+	}{
+		{
+			name:                "balanced by default",
+			expected:            NetworkFlags{Profile: "balanced", APITimeout: 30 * time.Second, Retries: 2, RetryBackoff: time.Second},
+			expectedConcurrency: 1,
+		},
+		{
+			name:                "fast",
+			args:                []string{"--profile", "fast"},
+			expected:            NetworkFlags{Profile: "fast", APITimeout: 10 * time.Second},
+			expectedConcurrency: 4,
+		},
+		{
+			name:                "resilient",
+			args:                []string{"--profile", "resilient"},
+			expected:            NetworkFlags{Profile: "resilient", APITimeout: 90 * time.Second, Retries: 5, RetryBackoff: 2 * time.Second},
+			expectedConcurrency: 1,
+		},
+		{
+			name:                "explicit flags override the profile",
+			args:                []string{"--profile", "resilient", "--retries", "1", "--api-timeout", "45s", "--cleanup-concurrency", "3"},
+			expected:            NetworkFlags{Profile: "resilient", APITimeout: 45 * time.Second, Retries: 1, RetryBackoff: 2 * time.Second},
+			expectedConcurrency: 3,
+		},
+		{
+			name:        "unknown profile",
+			args:        []string{"--profile", "turbo"},
+			expectError: "unknown profile 'turbo'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var flags hydrateOptions
+			cmd := &cobra.Command{Use: "hydrate"}
+			setupHydrateCmdFlags(cmd, &flags)
+			if err := cmd.ParseFlags(tt.args); err != nil {
+				t.Fatalf("Failed to parse flags: %v", err)
+			}
+
+			err := applyNetworkProfile(cmd, &flags.Network, &flags.Cleanup)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if flags.Network != tt.expected {
+				t.Errorf("Expected network flags %+v, got %+v", tt.expected, flags.Network)
+			}
+			if flags.Cleanup.Concurrency != tt.expectedConcurrency {
+				t.Errorf("Expected cleanup concurrency %d, got %d", tt.expectedConcurrency, flags.Cleanup.Concurrency)
+			}
+		})
+	}
+}
+
+// TestPerformCleanup_SummaryFile tests the JSON written for a cleanup with deleted, preserved and failed items
+func TestPerformCleanup_SummaryFile(t *testing.T) {
+	cfg := writeDoctorConfig(t, map[string]string{
+		config.PreserveFilename: `{"issues": {"preserve_by_title": ["Keep me"]}}`,
+	})
+	summaryPath := filepath.Join(t.TempDir(), "cleanup.json")
+
+	client := hydrate.NewFailingMockGitHubClient(hydrate.MockConfig{FailDeleteNodeIDs: map[string]bool{"pr1": true}})
+	client.CreatedIssues = []types.Issue{
+		{NodeID: "issue1", Title: "Delete me"},
+		{NodeID: "issue2", Title: "Keep me"},
+	}
+	client.CreatedDiscussions = []types.Discussion{{NodeID: "discussion1", Title: "Delete me too"}}
+	client.CreatedPRs = []types.PullRequest{{NodeID: "pr1", Title: "Broken"}}
+
+	flags := CleanupFlags{CleanIssues: true, CleanDiscussions: true, CleanPRs: true, SummaryFile: summaryPath}
+	err := performCleanup(context.Background(), client, flags, ProjectFlags{}, "", cfg, &testutil.MockLogger{})
+	if err == nil {
+		t.Fatal("Expected a partial failure for the pull request that could not be deleted")
+	}
+
+	data, err := os.ReadFile(summaryPath)
+	if err != nil {
+		t.Fatalf("Failed to read cleanup summary: %v", err)
+	}
+	var summary map[string]interface{}
+	if err := json.Unmarshal(data, &summary); err != nil {
+		t.Fatalf("Cleanup summary is not valid JSON: %v", err)
+	}
+
+	expectedCounts := map[string]float64{
+		"issues_deleted":          1,
+		"issues_preserved":        1,
+		"discussions_deleted":     1,
+		"discussions_preserved":   0,
+		"pull_requests_deleted":   0,
+		"pull_requests_preserved": 0,
+		"labels_deleted":          0,
+		"labels_preserved":        0,
+		"branches_deleted":        0,
+		"branches_skipped":        0,
+	}
+	for key, expected := range expectedCounts {
+		if summary[key] != expected {
+			t.Errorf("Expected %s to be %v, got %v", key, expected, summary[key])
+		}
+	}
+	errorList, ok := summary["errors"].([]interface{})
+	if !ok || len(errorList) != 1 || !strings.Contains(errorList[0].(string), "simulated delete failure for: pr1") {
+		t.Errorf("Expected one delete error, got %v", summary["errors"])
+	}
+	preserved, ok := summary["preserved_items"].([]interface{})
+	if !ok || len(preserved) != 1 || !reflect.DeepEqual(preserved[0], map[string]interface{}{"type": "issue", "title": "Keep me", "node_id": "issue2"}) {
+		t.Errorf("Expected the preserved issue to be listed, got %v", summary["preserved_items"])
+	}
+	if deleted, ok := summary["deleted_items"].([]interface{}); !ok || len(deleted) != 2 {
+		t.Errorf("Expected the deleted issue and discussion to be listed, got %v", summary["deleted_items"])
+	}
+}
+
+// TestPerformCleanup_Teardown tests that a teardown finds the marker label in metadata.json and the
+// project in the manifest, or in the project configuration only when that file exists, and deletes
+// only a project the manifest records as created
+func TestPerformCleanup_Teardown(t *testing.T) {
+	tests := []struct {
+		name            string
+		files           map[string]string
+		manifest        string
+		expectError     string
+		expectedDeleted []string
+	}{
+		{
+			name:  "project from the project configuration is kept",
+			files: map[string]string{config.MetadataFilename: `{"marker_label": "demo"}`, config.ProjectConfigFilename: `{"title": "Demo board"}`},
+		},
+		{
+			name:            "created project from the manifest",
+			files:           map[string]string{config.MetadataFilename: `{"marker_label": "demo"}`},
+			manifest:        `{"labels": ["demo"], "project": {"title": "Recorded board", "node_id": "project-2"}, "project_created": true}`,
+			expectedDeleted: []string{"project-2"},
+		},
+		{
+			name:     "reused project from the manifest is kept",
+			files:    map[string]string{config.MetadataFilename: `{"marker_label": "demo"}`},
+			manifest: `{"labels": ["demo"], "project": {"title": "Demo board", "node_id": "project-1"}}`,
+		},
+		{
+			name:  "no project configured",
+			files: map[string]string{config.MetadataFilename: `{"marker_label": "demo"}`},
+		},
+		{
+			name:        "no marker label",
+			expectError: "set marker_label in metadata.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := writeDoctorConfig(t, tt.files)
+			flags := CleanupFlags{Teardown: true}
+			if tt.manifest != "" {
+				flags.TeardownManifest = filepath.Join(t.TempDir(), "run.json")
+				if err := os.WriteFile(flags.TeardownManifest, []byte(tt.manifest), 0644); err != nil {
+					t.Fatalf("Failed to write manifest: %v", err)
+				}
+			}
+			client := hydrate.NewSuccessfulMockGitHubClient("demo")
+			client.CreatedIssues = []types.Issue{{NodeID: "issue1", Title: "Demo issue", Labels: []string{"demo"}}}
+			client.Projects = []types.ProjectV2{{ID: "project-1", Title: "Demo board"}}
+
+			err := performCleanup(context.Background(), client, flags, ProjectFlags{}, "", cfg, &testutil.MockLogger{})
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(client.CreatedIssues) != 0 {
+				t.Errorf("Expected the marked issue to be deleted, got %v", client.CreatedIssues)
+			}
+			if !reflect.DeepEqual(client.DeletedProjects, tt.expectedDeleted) {
+				t.Errorf("Expected deleted projects %v, got %v", tt.expectedDeleted, client.DeletedProjects)
+			}
+		})
+	}
+}
+
+// TestColorEnabled tests that color is used only for a terminal and can be turned off with --no-color or NO_COLOR
+func TestColorEnabled(t *testing.T) {
+	tests := []struct {
+		name     string
+		forceTTY string
+		noColor  string
+		flag     bool
+		expected bool
+	}{
+		{name: "terminal", forceTTY: "1", expected: true},
+		{name: "terminal with --no-color", forceTTY: "1", flag: true, expected: false},
+		{name: "terminal with NO_COLOR", forceTTY: "1", noColor: "1", expected: false},
+		{name: "not a terminal", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GH_FORCE_TTY", tt.forceTTY)
+			t.Setenv("NO_COLOR", tt.noColor)
+			t.Setenv("CLICOLOR", "")
+			t.Setenv("CLICOLOR_FORCE", "")
+
+			if got := colorEnabled(tt.flag); got != tt.expected {
+				t.Errorf("Expected colorEnabled(%v) to be %v, got %v", tt.flag, tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestExecuteHydrate_ValidateOnly tests that --validate-only checks the configuration without any API calls
+func TestExecuteHydrate_ValidateOnly(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       map[string]string
+		expectError string
+	}{
+		{
+			name:  "valid configuration",
+			files: map[string]string{config.IssuesFilename: `[{"title": "Bug", "labels": ["bug"]}]`},
+		},
+		{
+			name:        "invalid configuration",
+			files:       map[string]string{config.PullRequestsFilename: `[{"title": "No head", "base": "main"}]`},
+			expectError: "head branch cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Without a token no client can be created, so passing proves that no API call was attempted
+			t.Setenv("GH_TOKEN", "")
+			t.Setenv("GITHUB_TOKEN", "")
+			t.Setenv("GH_CONFIG_DIR", t.TempDir())
+
+			cfg := writeDoctorConfig(t, tt.files)
+			root, err := hydrate.FindProjectRoot(context.Background())
+			if err != nil {
+				t.Fatalf("Failed to find project root: %v", err)
+			}
+			configPath, err := filepath.Rel(root, cfg.BasePath)
+			if err != nil {
+				t.Fatalf("Failed to make config path relative: %v", err)
+			}
+
+			err = executeHydrate(context.Background(), hydrateOptions{Owner: "owner", Repo: "repo", ConfigPath: configPath, Issues: true, Discussions: true, PullRequests: true, Content: ContentFlags{ValidateOnly: true}})
+
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+// TestApplyContentFileOverrides tests that an overridden content file replaces only its own type's path
+func TestApplyContentFileOverrides(t *testing.T) {
+	tests := []struct {
+		name         string
+		contentFlags ContentFlags
+		expected     [3]string
+	}{
+		{
+			name:     "no overrides",
+			expected: [3]string{"demos/issues.json", "demos/discussions.json", "demos/prs.json"},
+		},
+		{
+			name:         "issues file overridden",
+			contentFlags: ContentFlags{IssuesFile: "scenarios/demo-issues.json"},
+			expected:     [3]string{"scenarios/demo-issues.json", "demos/discussions.json", "demos/prs.json"},
+		},
+		{
+			name:         "every file overridden",
+			contentFlags: ContentFlags{IssuesFile: "a.json", DiscussionsFile: "b.json", PRsFile: "c.json"},
+			expected:     [3]string{"a.json", "b.json", "c.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.NewConfiguration(context.Background(), "demos")
+
+			applyContentFileOverrides(cfg, tt.contentFlags)
+
+			got := [3]string{cfg.IssuesPath, cfg.DiscussionsPath, cfg.PullRequestsPath}
+			if got != tt.expected {
+				t.Errorf("Expected paths %v, got %v", tt.expected, got)
+			}
+			if cfg.LabelsPath != filepath.Join("demos", config.LabelsFilename) {
+				t.Errorf("Expected labels.json to stay in the config path, got %s", cfg.LabelsPath)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/spf13/cobra"
+)
+
+// markedItem is an item carrying the marker label, as listed by list-created
+type markedItem struct {
+	Kind   string
+	Number int
+	Title  string
+	URL    string
+}
+
+// executeListCreated resolves the target repository, creates a GitHub client and prints every
+// item carrying markerLabel, or the marker label in metadata.json when markerLabel is empty.
+// It performs read-only queries only.
+func executeListCreated(ctx context.Context, owner, repo, remote, configPath, markerLabel string, debug bool, out io.Writer) error {
+	root, err := hydrate.FindProjectRoot(ctx)
+	if err != nil {
+		return errors.FileError("find_project_root", "could not find project root", err)
+	}
+	markerLabel, err = resolveMarkerLabel(ctx, config.NewConfigurationWithRoot(ctx, root, configPath), markerLabel)
+	if err != nil {
+		return err
+	}
+
+	logger := common.NewLogger(debug)
+
+	repoInfo, err := resolveRepositoryInfo(ctx, owner, repo, remote)
+	if err != nil {
+		return err
+	}
+
+	client, err := createGitHubClient(ctx, repoInfo, logger)
+	if err != nil {
+		return err
+	}
+
+	items, err := listMarkedItems(ctx, client, repoInfo, markerLabel)
+	if err != nil {
+		return err
+	}
+	printMarkedItems(out, repoInfo, markerLabel, items)
+	return nil
+}
+
+// resolveMarkerLabel returns markerLabel or, when it is empty, the marker label metadata.json sets,
+// so that list-created finds the same items as --teardown
+func resolveMarkerLabel(ctx context.Context, cfg *config.Configuration, markerLabel string) (string, error) {
+	if strings.TrimSpace(markerLabel) != "" {
+		return markerLabel, nil
+	}
+	metadataConfig, err := config.LoadMetadataConfiguration(ctx, cfg.MetadataPath)
+	if err != nil {
+		return "", errors.WithContextSafe(err, "path", cfg.MetadataPath)
+	}
+	if strings.TrimSpace(metadataConfig.MarkerLabel) == "" {
+		return "", errors.ValidationError("validate_flags", "--marker-label is required when metadata.json sets no marker_label")
+	}
+	return metadataConfig.MarkerLabel, nil
+}
+
+// listMarkedItems lists the open issues, pull requests and discussions that carry markerLabel.
+// Label names are compared case-insensitively, as GitHub does.
+func listMarkedItems(ctx context.Context, client githubapi.GitHubClient, repoInfo *repositoryInfo, markerLabel string) ([]markedItem, error) {
+	itemURL := func(path string, number int) string {
+		return fmt.Sprintf("https://github.com/%s/%s/%s/%d", repoInfo.Owner, repoInfo.Repo, path, number)
+	}
+
+	var items []markedItem
+
+	issues, err := client.ListIssues(ctx)
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "api", "list_issues", "failed to list issues")
+	}
+	for _, issue := range issues {
+		if hydrate.HasLabel(issue.Labels, markerLabel) {
+			items = append(items, markedItem{Kind: "issue", Number: issue.Number, Title: issue.Title, URL: itemURL("issues", issue.Number)})
+		}
+	}
+
+	pullRequests, err := client.ListPRs(ctx)
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "api", "list_pull_requests", "failed to list pull requests")
+	}
+	for _, pullRequest := range pullRequests {
+		if hydrate.HasLabel(pullRequest.Labels, markerLabel) {
+			items = append(items, markedItem{Kind: "pull request", Number: pullRequest.Number, Title: pullRequest.Title, URL: itemURL("pull", pullRequest.Number)})
+		}
+	}
+
+	discussions, err := client.ListDiscussions(ctx)
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "api", "list_discussions", "failed to list discussions")
+	}
+	for _, discussion := range discussions {
+		if hydrate.HasLabel(discussion.Labels, markerLabel) {
+			items = append(items, markedItem{Kind: "discussion", Number: discussion.Number, Title: discussion.Title, URL: itemURL("discussions", discussion.Number)})
+		}
+	}
+
+	return items, nil
+}
+
+// printMarkedItems writes one line per marked item followed by a total
+func printMarkedItems(out io.Writer, repoInfo *repositoryInfo, markerLabel string, items []markedItem) {
+	if len(items) == 0 {
+		fmt.Fprintf(out, "No open items in %s/%s carry the label '%s'\n", repoInfo.Owner, repoInfo.Repo, markerLabel)
+		return
+	}
+
+	for _, item := range items {
+		fmt.Fprintf(out, "%-12s #%-5d %s  %s\n", item.Kind, item.Number, item.Title, item.URL)
+	}
+	fmt.Fprintf(out, "%d items in %s/%s carry the label '%s'\n", len(items), repoInfo.Owner, repoInfo.Repo, markerLabel)
+}
+
+// NewListCreatedCmd returns the Cobra command that lists the items carrying the marker label.
+func NewListCreatedCmd() *cobra.Command {
+	var owner, repo, remote, configPath, markerLabel string
+	var debug bool
+
+	cmd := &cobra.Command{
+		Use:   "list-created",
+		Short: "List the open issues, pull requests, and discussions that carry a marker label",
+		Long: `List the open issues, pull requests, and discussions that carry the marker label, with
+their numbers, titles, and URLs. Label your demo content with a marker (for example "demo")
+to audit what a demo created before tearing it down. Without --marker-label, the marker_label
+in metadata.json is used, as --teardown does.
+This command only reads data and never modifies the repository.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if err := executeListCreated(ctx, owner, repo, remote, configPath, markerLabel, debug, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&owner, "owner", "", "GitHub repository owner (defaults to the current repository)")
+	cmd.Flags().StringVar(&repo, "repo", "", "GitHub repository name (defaults to the current repository)")
+	cmd.Flags().StringVar(&remote, "remote", "", "Git remote used to detect the repository (default: gh's remote selection)")
+	cmd.Flags().StringVar(&configPath, "config-path", config.DefaultConfigPath, "Path to configuration files relative to project root")
+	cmd.Flags().StringVar(&markerLabel, "marker-label", "", "Label that marks demo content (default: marker_label in metadata.json)")
+	cmd.Flags().BoolVar(&debug, "debug", false, "Enable debug mode for detailed logging")
+
+	return cmd
+}
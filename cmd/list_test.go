@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestListMarkedItems tests that only items carrying the marker label are listed
+func TestListMarkedItems(t *testing.T) {
+	client := hydrate.NewSuccessfulMockGitHubClient()
+	client.CreatedIssues = []types.Issue{
+		{Number: 1, Title: "Demo issue", Labels: []string{"bug", "demo"}},
+		{Number: 2, Title: "Real issue", Labels: []string{"bug"}},
+	}
+	client.CreatedPRs = []types.PullRequest{
+		{Number: 3, Title: "Demo PR", Labels: []string{"Demo"}},
+		{Number: 4, Title: "Real PR"},
+	}
+	client.CreatedDiscussions = []types.Discussion{
+		{Number: 5, Title: "Demo discussion", Labels: []string{"demo"}},
+		{Number: 6, Title: "Real discussion", Labels: []string{"question"}},
+	}
+	repoInfo := &repositoryInfo{Owner: "test-owner", Repo: "test-repo"}
+
+	items, err := listMarkedItems(context.Background(), client, repoInfo, "demo")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []markedItem{
+		{Kind: "issue", Number: 1, Title: "Demo issue", URL: "https://github.com/test-owner/test-repo/issues/1"},
+		{Kind: "pull request", Number: 3, Title: "Demo PR", URL: "https://github.com/test-owner/test-repo/pull/3"},
+		{Kind: "discussion", Number: 5, Title: "Demo discussion", URL: "https://github.com/test-owner/test-repo/discussions/5"},
+	}
+	if len(items) != len(expected) {
+		t.Fatalf("Expected %d items, got %d: %+v", len(expected), len(items), items)
+	}
+	for i := range expected {
+		if items[i] != expected[i] {
+			t.Errorf("Expected item %+v, got %+v", expected[i], items[i])
+		}
+	}
+
+	var out bytes.Buffer
+	printMarkedItems(&out, repoInfo, "demo", items)
+	if !strings.Contains(out.String(), "3 items in test-owner/test-repo carry the label 'demo'") {
+		t.Errorf("Expected a total line, got: %q", out.String())
+	}
+	if strings.Contains(out.String(), "Real") {
+		t.Errorf("Expected unmarked items to be left out, got: %q", out.String())
+	}
+}
+
+// TestNewListCreatedCmd tests the list-created command configuration
+func TestNewListCreatedCmd(t *testing.T) {
+	cmd := NewListCreatedCmd()
+
+	if cmd.Use != "list-created" {
+		t.Errorf("Expected Use to be 'list-created', got %q", cmd.Use)
+	}
+
+	for _, flagName := range []string{"owner", "repo", "remote", "config-path", "marker-label", "debug"} {
+		if cmd.Flags().Lookup(flagName) == nil {
+			t.Errorf("Expected flag %q to be defined", flagName)
+		}
+	}
+}
+
+// TestResolveMarkerLabel tests that --marker-label wins over metadata.json and that one of them is required
+func TestResolveMarkerLabel(t *testing.T) {
+	tests := []struct {
+		name        string
+		flag        string
+		metadata    string
+		expected    string
+		expectError string
+	}{
+		{name: "flag wins", flag: "demo", metadata: `{"marker_label": "demo-content"}`, expected: "demo"},
+		{name: "metadata.json default", metadata: `{"marker_label": "demo-content"}`, expected: "demo-content"},
+		{name: "neither set", expectError: "--marker-label is required when metadata.json sets no marker_label"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if tt.metadata != "" {
+				if err := os.WriteFile(filepath.Join(tempDir, config.MetadataFilename), []byte(tt.metadata), 0644); err != nil {
+					t.Fatalf("Failed to create metadata.json: %v", err)
+				}
+			}
+
+			markerLabel, err := resolveMarkerLabel(context.Background(), config.NewConfiguration(context.Background(), tempDir), tt.flag)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil || markerLabel != tt.expected {
+				t.Errorf("Expected marker label %q, got %q (error: %v)", tt.expected, markerLabel, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+)
+
+// Classic token scopes needed by hydration features
+const (
+	repoScope    = "repo"
+	projectScope = "project"
+)
+
+// permissionFeatures are the hydration features whose token requirements are explained
+type permissionFeatures struct {
+	Issues        bool
+	Discussions   bool
+	PullRequests  bool
+	CreateProject bool
+	Clean         bool
+	PruneBranches bool
+	UseCodeowners bool
+	SeedFiles     bool
+}
+
+// tokenPermission is what one feature needs from a classic token and from a fine-grained token
+type tokenPermission struct {
+	Feature     string
+	Scope       string // Classic token scope
+	FineGrained string // Fine-grained token repository or organization permission
+}
+
+// requiredPermissions lists what the token needs for features. Labels are always ensured, so
+// labels are always listed; GitHub manages them with the issues permission.
+func requiredPermissions(features permissionFeatures) []tokenPermission {
+	permissions := []tokenPermission{
+		{Feature: "labels", Scope: repoScope, FineGrained: "Issues: write"},
+	}
+	if features.Issues {
+		permissions = append(permissions, tokenPermission{Feature: "issues", Scope: repoScope, FineGrained: "Issues: write"})
+	}
+	if features.Discussions {
+		permissions = append(permissions, tokenPermission{Feature: "discussions", Scope: repoScope, FineGrained: "Discussions: write"})
+	}
+	if features.PullRequests {
+		permissions = append(permissions, tokenPermission{Feature: "pull requests", Scope: repoScope, FineGrained: "Pull requests: write"})
+	}
+	if features.UseCodeowners {
+		permissions = append(permissions, tokenPermission{Feature: "CODEOWNERS reviewers", Scope: repoScope, FineGrained: "Contents: read"})
+	}
+	if features.PruneBranches {
+		permissions = append(permissions, tokenPermission{Feature: "branch pruning", Scope: repoScope, FineGrained: "Contents: write"})
+	}
+	if features.SeedFiles {
+		permissions = append(permissions, tokenPermission{Feature: "seeded files", Scope: repoScope, FineGrained: "Contents: write"})
+	}
+	if features.Clean {
+		permissions = append(permissions, tokenPermission{Feature: "cleanup", Scope: repoScope, FineGrained: "Issues: write"})
+	}
+	if features.CreateProject {
+		permissions = append(permissions, tokenPermission{Feature: "projects", Scope: projectScope, FineGrained: "Projects: write"})
+	}
+	return permissions
+}
+
+// requiredScopes returns the distinct classic token scopes of permissions in sorted order
+func requiredScopes(permissions []tokenPermission) []string {
+	return distinctSorted(permissions, func(permission tokenPermission) string { return permission.Scope })
+}
+
+// requiredFineGrained returns the distinct fine-grained permissions of permissions in sorted order.
+// Every fine-grained token also needs read access to repository metadata.
+func requiredFineGrained(permissions []tokenPermission) []string {
+	return distinctSorted(append(permissions, tokenPermission{FineGrained: "Metadata: read"}),
+		func(permission tokenPermission) string { return permission.FineGrained })
+}
+
+// distinctSorted returns the distinct non-empty values key returns for permissions in sorted order
+func distinctSorted(permissions []tokenPermission, key func(tokenPermission) string) []string {
+	seen := make(map[string]bool)
+	var values []string
+	for _, permission := range permissions {
+		value := key(permission)
+		if value == "" || seen[value] {
+			continue
+		}
+		seen[value] = true
+		values = append(values, value)
+	}
+	sort.Strings(values)
+	return values
+}
+
+// explainPermissions prints the token scopes and fine-grained permissions the enabled features need
+// and checks the current token against them with read-only probes. GraphQL does not expose the
+// granted scopes, so the repo scope is checked through the viewer's repository permission and the
+// project scope through a project query. It returns an error when any check fails.
+func explainPermissions(ctx context.Context, client githubapi.GitHubClient, repoInfo *repositoryInfo, features permissionFeatures, out io.Writer) error {
+	permissions := requiredPermissions(features)
+
+	fmt.Fprintf(out, "Token permissions needed for %s/%s\n", repoInfo.Owner, repoInfo.Repo)
+	for _, permission := range permissions {
+		fmt.Fprintf(out, "  %s: %s scope (fine-grained: %s)\n", permission.Feature, permission.Scope, permission.FineGrained)
+	}
+	fmt.Fprintf(out, "Classic token scopes: %s\n", strings.Join(requiredScopes(permissions), ", "))
+	fmt.Fprintf(out, "Fine-grained token permissions: %s\n", strings.Join(requiredFineGrained(permissions), ", "))
+
+	checks := tokenChecks(ctx, client, requiredScopes(permissions))
+	if !printDoctorReport(out, repoInfo, checks) {
+		return errors.ValidationError("explain_permissions", "the token is missing permissions the enabled features need")
+	}
+	return nil
+}
+
+// tokenChecks probes whether the current token has each of scopes
+func tokenChecks(ctx context.Context, client githubapi.GitHubClient, scopes []string) []doctorCheck {
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		return []doctorCheck{{Name: "Authentication", Detail: err.Error(), Hint: "Run `gh auth login`, or check `gh auth status`"}}
+	}
+	checks := []doctorCheck{{Name: "Authentication", Passed: true, Detail: "logged in as " + viewer.Login}}
+
+	for _, scope := range scopes {
+		switch scope {
+		case repoScope:
+			permission := viewer.RepositoryPermission
+			if permission == "" {
+				permission = "NONE"
+			}
+			checks = append(checks, doctorCheck{
+				Name:   "Scope " + repoScope,
+				Passed: writePermissions[permission],
+				Detail: permission + " access to the repository",
+				Hint:   "Run `gh auth refresh -s repo`, or ask a repository admin for WRITE access",
+			})
+		case projectScope:
+			check := doctorCheck{Name: "Scope " + projectScope, Passed: true, Detail: "projects are accessible"}
+			if err := client.CheckProjectAccess(ctx); err != nil {
+				check = doctorCheck{Name: "Scope " + projectScope, Detail: err.Error(), Hint: "Run `gh auth refresh -s project`"}
+			}
+			checks = append(checks, check)
+		}
+	}
+	return checks
+}
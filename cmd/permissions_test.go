@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestRequiredPermissions tests the classic scopes and fine-grained permissions needed per feature combination
+func TestRequiredPermissions(t *testing.T) {
+	tests := []struct {
+		name                string
+		features            permissionFeatures
+		expectedScopes      []string
+		expectedFineGrained []string
+	}{
+		{
+			name:                "labels only",
+			expectedScopes:      []string{"repo"},
+			expectedFineGrained: []string{"Issues: write", "Metadata: read"},
+		},
+		{
+			name:                "all content",
+			features:            permissionFeatures{Issues: true, Discussions: true, PullRequests: true},
+			expectedScopes:      []string{"repo"},
+			expectedFineGrained: []string{"Discussions: write", "Issues: write", "Metadata: read", "Pull requests: write"},
+		},
+		{
+			name:                "issues with a project",
+			features:            permissionFeatures{Issues: true, CreateProject: true},
+			expectedScopes:      []string{"project", "repo"},
+			expectedFineGrained: []string{"Issues: write", "Metadata: read", "Projects: write"},
+		},
+		{
+			name:                "pull requests with codeowners and branch pruning",
+			features:            permissionFeatures{PullRequests: true, UseCodeowners: true, PruneBranches: true},
+			expectedScopes:      []string{"repo"},
+			expectedFineGrained: []string{"Contents: read", "Contents: write", "Issues: write", "Metadata: read", "Pull requests: write"},
+		},
+		{
+			name:                "issues with seeded files",
+			features:            permissionFeatures{Issues: true, SeedFiles: true},
+			expectedScopes:      []string{"repo"},
+			expectedFineGrained: []string{"Contents: write", "Issues: write", "Metadata: read"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			permissions := requiredPermissions(tt.features)
+
+			if scopes := requiredScopes(permissions); !reflect.DeepEqual(scopes, tt.expectedScopes) {
+				t.Errorf("Expected scopes %v, got %v", tt.expectedScopes, scopes)
+			}
+			if fineGrained := requiredFineGrained(permissions); !reflect.DeepEqual(fineGrained, tt.expectedFineGrained) {
+				t.Errorf("Expected fine-grained permissions %v, got %v", tt.expectedFineGrained, fineGrained)
+			}
+		})
+	}
+}
+
+// TestExplainPermissions tests that the current token is checked against the required scopes
+func TestExplainPermissions(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockConfig     hydrate.MockConfig
+		features       permissionFeatures
+		expectError    bool
+		expectedOutput []string
+	}{
+		{
+			name:       "token has every scope",
+			mockConfig: hydrate.MockConfig{ViewerLogin: "octocat", ViewerPermission: "WRITE"},
+			features:   permissionFeatures{Issues: true, CreateProject: true},
+			expectedOutput: []string{
+				"Classic token scopes: project, repo",
+				"[PASS] Scope project: projects are accessible",
+				"[PASS] Scope repo: WRITE access to the repository",
+			},
+		},
+		{
+			name:        "missing project scope",
+			mockConfig:  hydrate.MockConfig{ViewerPermission: "WRITE", ProjectAccess: testutil.ErrorConfig{ShouldError: true}},
+			features:    permissionFeatures{CreateProject: true},
+			expectError: true,
+			expectedOutput: []string{
+				"[FAIL] Scope project: ",
+				"Hint: Run `gh auth refresh -s project`",
+			},
+		},
+		{
+			name:        "read-only access",
+			mockConfig:  hydrate.MockConfig{ViewerPermission: "READ"},
+			features:    permissionFeatures{Issues: true},
+			expectError: true,
+			expectedOutput: []string{
+				"Classic token scopes: repo",
+				"[FAIL] Scope repo: READ access to the repository",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := hydrate.NewFailingMockGitHubClient(tt.mockConfig)
+			var out bytes.Buffer
+
+			err := explainPermissions(context.Background(), client, &repositoryInfo{Owner: "test-owner", Repo: "test-repo"}, tt.features, &out)
+			if (err != nil) != tt.expectError {
+				t.Errorf("Expected error %v, got: %v", tt.expectError, err)
+			}
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(out.String(), expected) {
+					t.Errorf("Expected output to contain %q, got:\n%s", expected, out.String())
+				}
+			}
+			if len(client.CreatedIssues) != 0 || len(client.CreatedLabels) != 0 {
+				t.Errorf("Expected no writes, got issues %v and labels %v", client.CreatedIssues, client.CreatedLabels)
+			}
+		})
+	}
+}
@@ -17,4 +17,10 @@ func Execute() error {
 
 func init() {
 	rootCmd.AddCommand(NewHydrateCmd())
+	rootCmd.AddCommand(NewWhoamiCmd())
+	rootCmd.AddCommand(NewDoctorCmd())
+	rootCmd.AddCommand(NewListCreatedCmd())
+	rootCmd.AddCommand(NewValidateCmd())
+	rootCmd.AddCommand(NewDiffCmd())
+	rootCmd.AddCommand(NewDiffManifestsCmd())
 }
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/spf13/cobra"
+)
+
+// executeValidate loads every content file and labels.json from the configuration path and checks
+// each entry with its Validate method. Offline, it never contacts GitHub. Online, it also resolves the
+// referenced labels, discussion categories and assignees against the target repository, read-only.
+func executeValidate(ctx context.Context, owner, repo, remote, configPath string, online bool, out io.Writer) error {
+	root, err := hydrate.FindProjectRoot(ctx)
+	if err != nil {
+		return errors.FileError("find_project_root", "could not find project root", err)
+	}
+	cfg := config.NewConfigurationWithRoot(ctx, root, configPath)
+
+	var client githubapi.GitHubClient
+	if online {
+		repoInfo, err := resolveRepositoryInfo(ctx, owner, repo, remote)
+		if err != nil {
+			return err
+		}
+		client, err = createGitHubClient(ctx, repoInfo, common.NewLogger(false))
+		if err != nil {
+			return err
+		}
+	}
+
+	return validateConfiguration(ctx, cfg, client, out)
+}
+
+// validateConfiguration validates the content files and labels.json of cfg and prints a summary to out.
+// When client is not nil, the references of the content are also resolved against its repository.
+func validateConfiguration(ctx context.Context, cfg *config.Configuration, client githubapi.GitHubClient, out io.Writer) error {
+	issues, discussions, pullRequests, err := hydrate.HydrateFromConfiguration(ctx, cfg, true, true, true)
+	if err != nil {
+		return err
+	}
+	labels, err := hydrate.ReadLabelsJSON(ctx, cfg.LabelsPath)
+	if err != nil {
+		return err
+	}
+
+	if err := hydrate.ValidateContent(issues, discussions, pullRequests, labels, cfg.LabelsPath); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "All content is valid: %d issues, %d discussions, %d pull requests, %d labels\n",
+		len(issues), len(discussions), len(pullRequests), len(labels))
+
+	if client == nil {
+		return nil
+	}
+	if err := hydrate.ResolveReferences(ctx, client, issues, discussions, pullRequests, labels); err != nil {
+		return err
+	}
+	fmt.Fprintln(out, "All referenced labels, discussion categories, and assignees exist")
+	return nil
+}
+
+// NewValidateCmd returns the Cobra command that checks content files without contacting GitHub.
+func NewValidateCmd() *cobra.Command {
+	var owner, repo, remote, configPath string
+	var online bool
+
+	cmd := &cobra.Command{
+		Use:   "validate",
+		Short: "Check issues, discussions, pull requests, and labels for missing or malformed fields",
+		Long: `Load the content files and labels.json from the configuration path and check every entry
+for required fields and valid formats, such as a head branch for each pull request and a six digit
+hex color for each label. All problems are reported together with the file and position of the entry.
+This command works offline and never modifies the repository. With --online, it also checks that the
+referenced labels, discussion categories, and assignees exist in the target repository, using
+read-only queries. Labels defined in labels.json are not reported, since hydration creates them.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if err := executeValidate(ctx, owner, repo, remote, configPath, online, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&owner, "owner", "", "GitHub repository owner checked by --online (defaults to the current repository)")
+	cmd.Flags().StringVar(&repo, "repo", "", "GitHub repository name checked by --online (defaults to the current repository)")
+	cmd.Flags().StringVar(&remote, "remote", "", "Git remote used to detect the repository (default: gh's remote selection)")
+	cmd.Flags().BoolVar(&online, "online", false, "Also check that referenced labels, discussion categories, and assignees exist in the repository")
+	cmd.Flags().StringVar(&configPath, "config-path", config.DefaultConfigPath, "Path to configuration files relative to project root")
+
+	return cmd
+}
@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+)
+
+// TestValidateConfiguration tests the summary for valid content and the report for invalid content
+func TestValidateConfiguration(t *testing.T) {
+	tests := []struct {
+		name           string
+		files          map[string]string
+		expectError    bool
+		expectedOutput string
+	}{
+		{
+			name: "valid content",
+			files: map[string]string{
+				config.IssuesFilename: `[{"title": "Bug", "body": "Body"}]`,
+				config.LabelsFilename: `[{"name": "bug", "color": "d73a4a"}]`,
+			},
+			expectedOutput: "All content is valid: 1 issues, 0 discussions, 0 pull requests, 1 labels",
+		},
+		{
+			name: "invalid content",
+			files: map[string]string{
+				config.PullRequestsFilename: `[{"title": "Same branch", "head": "main", "base": "main"}]`,
+			},
+			expectError:    true,
+			expectedOutput: "head and base branches cannot be the same",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := writeDoctorConfig(t, tt.files)
+			var out bytes.Buffer
+
+			err := validateConfiguration(context.Background(), cfg, nil, &out)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.expectedOutput) {
+					t.Errorf("Expected error to contain %q, got: %v", tt.expectedOutput, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !strings.Contains(out.String(), tt.expectedOutput) {
+				t.Errorf("Expected output to contain %q, got: %q", tt.expectedOutput, out.String())
+			}
+		})
+	}
+}
+
+// TestValidateConfiguration_Online tests that references are resolved against the repository when a client is given
+func TestValidateConfiguration_Online(t *testing.T) {
+	cfg := writeDoctorConfig(t, map[string]string{
+		config.IssuesFilename: `[{"title": "Bug", "body": "Body", "labels": ["bug"], "assignees": ["octocat"]}]`,
+	})
+
+	tests := []struct {
+		name           string
+		mockConfig     hydrate.MockConfig
+		expectError    string
+		expectedOutput string
+	}{
+		{
+			name:           "clean configuration passes",
+			mockConfig:     hydrate.MockConfig{ExistingLabels: map[string]bool{"bug": true}},
+			expectedOutput: "All referenced labels, discussion categories, and assignees exist",
+		},
+		{
+			name:        "unresolved references fail",
+			mockConfig:  hydrate.MockConfig{MissingUsers: map[string]bool{"octocat": true}},
+			expectError: "2 unresolved references",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := hydrate.NewFailingMockGitHubClient(tt.mockConfig)
+			var out bytes.Buffer
+
+			err := validateConfiguration(context.Background(), cfg, client, &out)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !strings.Contains(out.String(), tt.expectedOutput) {
+				t.Errorf("Expected output to contain %q, got: %q", tt.expectedOutput, out.String())
+			}
+			if len(client.CreatedIssues) != 0 || len(client.CreatedLabels) != 0 {
+				t.Error("Expected online validation to make no writes")
+			}
+		})
+	}
+}
+
+// TestNewValidateCmd tests the validate command configuration
+func TestNewValidateCmd(t *testing.T) {
+	cmd := NewValidateCmd()
+
+	if cmd.Use != "validate" {
+		t.Errorf("Expected Use to be 'validate', got %q", cmd.Use)
+	}
+	for _, flag := range []string{"config-path", "online", "owner", "repo", "remote"} {
+		if cmd.Flags().Lookup(flag) == nil {
+			t.Errorf("Expected flag %q to be defined", flag)
+		}
+	}
+}
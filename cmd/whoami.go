@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/spf13/cobra"
+)
+
+// executeWhoami resolves the target repository, creates a GitHub client and prints
+// the authenticated identity. It performs read-only queries only.
+func executeWhoami(ctx context.Context, owner, repo, remote string, debug bool, out io.Writer) error {
+	logger := common.NewLogger(debug)
+
+	repoInfo, err := resolveRepositoryInfo(ctx, owner, repo, remote)
+	if err != nil {
+		return err
+	}
+
+	client, err := createGitHubClient(ctx, repoInfo, logger)
+	if err != nil {
+		return err
+	}
+
+	return printWhoami(ctx, client, repoInfo, out)
+}
+
+// printWhoami queries the authenticated user and their repository permission and writes
+// them to out. Authentication failures are reported with a hint to check gh auth status.
+func printWhoami(ctx context.Context, client githubapi.GitHubClient, repoInfo *repositoryInfo, out io.Writer) error {
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return err
+		}
+		return errors.APIError("whoami", "could not determine the authenticated user (check `gh auth status`)", err)
+	}
+
+	permission := viewer.RepositoryPermission
+	if permission == "" {
+		permission = "NONE"
+	}
+
+	fmt.Fprintf(out, "Logged in as: %s\n", viewer.Login)
+	fmt.Fprintf(out, "Repository:   %s/%s\n", repoInfo.Owner, repoInfo.Repo)
+	fmt.Fprintf(out, "Permission:   %s\n", permission)
+	return nil
+}
+
+// NewWhoamiCmd returns the Cobra command that reports which account and repository
+// the hydrate command would operate against.
+func NewWhoamiCmd() *cobra.Command {
+	var owner, repo, remote string
+	var debug bool
+
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the authenticated user, target repository, and permission level",
+		Long: `Show the authenticated user, the resolved target repository, and your permission
+level on that repository. This command only reads data and never modifies the repository.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			if err := executeWhoami(ctx, owner, repo, remote, debug, os.Stdout); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVar(&owner, "owner", "", "GitHub repository owner (defaults to the current repository)")
+	cmd.Flags().StringVar(&repo, "repo", "", "GitHub repository name (defaults to the current repository)")
+	cmd.Flags().StringVar(&remote, "remote", "", "Git remote used to detect the repository (default: gh's remote selection)")
+	cmd.Flags().BoolVar(&debug, "debug", false, "Enable debug mode for detailed logging")
+
+	return cmd
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/hydrate"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestPrintWhoami tests that the viewer login, repository and permission are printed
+func TestPrintWhoami(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockConfig       hydrate.MockConfig
+		expectError      bool
+		expectedOutput   []string
+		expectedErrorMsg string
+	}{
+		{
+			name: "prints viewer login and permission",
+			mockConfig: hydrate.MockConfig{
+				ViewerLogin:      "octocat",
+				ViewerPermission: "WRITE",
+			},
+			expectedOutput: []string{
+				"Logged in as: octocat",
+				"Repository:   test-owner/test-repo",
+				"Permission:   WRITE",
+			},
+		},
+		{
+			name: "auth error is reported with guidance",
+			mockConfig: hydrate.MockConfig{
+				Viewer: testutil.ErrorConfig{ShouldError: true, ErrorMessage: "HTTP 401: Bad credentials"},
+			},
+			expectError:      true,
+			expectedErrorMsg: "gh auth status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := hydrate.NewFailingMockGitHubClient(tt.mockConfig)
+			repoInfo := &repositoryInfo{Owner: "test-owner", Repo: "test-repo"}
+			var out bytes.Buffer
+
+			err := printWhoami(context.Background(), client, repoInfo, &out)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.expectedErrorMsg) {
+					t.Errorf("Expected error to contain %q, got: %v", tt.expectedErrorMsg, err)
+				}
+				if out.Len() != 0 {
+					t.Errorf("Expected no output on error, got: %q", out.String())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, expected := range tt.expectedOutput {
+				if !strings.Contains(out.String(), expected) {
+					t.Errorf("Expected output to contain %q, got: %q", expected, out.String())
+				}
+			}
+		})
+	}
+}
+
+// TestNewWhoamiCmd tests the whoami command configuration
+func TestNewWhoamiCmd(t *testing.T) {
+	cmd := NewWhoamiCmd()
+
+	if cmd.Use != "whoami" {
+		t.Errorf("Expected Use to be 'whoami', got %q", cmd.Use)
+	}
+
+	for _, flagName := range []string{"owner", "repo", "remote", "debug"} {
+		if cmd.Flags().Lookup(flagName) == nil {
+			t.Errorf("Expected flag %q to be defined", flagName)
+		}
+	}
+}
@@ -0,0 +1,30 @@
+package common
+
+// Colorizer styles terminal output with ANSI escape codes. The zero value is disabled and returns
+// text unchanged, so output that is not a terminal, or that has color turned off, stays plain.
+type Colorizer struct {
+	Enabled bool
+}
+
+// Bold returns text in bold when color is enabled
+func (c Colorizer) Bold(text string) string {
+	return c.style("1", text)
+}
+
+// Green returns text in green when color is enabled
+func (c Colorizer) Green(text string) string {
+	return c.style("32", text)
+}
+
+// Red returns text in red when color is enabled
+func (c Colorizer) Red(text string) string {
+	return c.style("31", text)
+}
+
+// style wraps text in the ANSI escape sequence for code and a reset
+func (c Colorizer) style(code, text string) string {
+	if !c.Enabled || text == "" {
+		return text
+	}
+	return "\x1b[" + code + "m" + text + "\x1b[0m"
+}
@@ -0,0 +1,28 @@
+package common
+
+import "testing"
+
+// TestColorizer tests that text is wrapped in ANSI codes only when color is enabled
+func TestColorizer(t *testing.T) {
+	tests := []struct {
+		name     string
+		enabled  bool
+		style    func(Colorizer, string) string
+		text     string
+		expected string
+	}{
+		{name: "bold", enabled: true, style: Colorizer.Bold, text: "Issues", expected: "\x1b[1mIssues\x1b[0m"},
+		{name: "green", enabled: true, style: Colorizer.Green, text: "2 successful", expected: "\x1b[32m2 successful\x1b[0m"},
+		{name: "red", enabled: true, style: Colorizer.Red, text: "1 failed", expected: "\x1b[31m1 failed\x1b[0m"},
+		{name: "empty text stays empty", enabled: true, style: Colorizer.Red, text: "", expected: ""},
+		{name: "disabled leaves text plain", enabled: false, style: Colorizer.Bold, text: "Issues", expected: "Issues"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.style(Colorizer{Enabled: tt.enabled}, tt.text); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
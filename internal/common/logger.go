@@ -33,6 +33,11 @@ func NewLogger(debug bool) *StandardLogger {
 	}
 }
 
+// RequestID returns the ID that prefixes every message of this logger
+func (l *StandardLogger) RequestID() string {
+	return l.requestID
+}
+
 // Debug logs a message only when debug mode is enabled
 func (l *StandardLogger) Debug(format string, args ...interface{}) {
 	if l.debug {
@@ -46,8 +51,12 @@ func (l *StandardLogger) Info(format string, args ...interface{}) {
 }
 
 // FormatCreationError creates a standardized error message for failed creation operations.
-// This ensures consistent error formatting across different object types.
-func FormatCreationError(itemType, title string, index int, err error) string {
+// This ensures consistent error formatting across different object types. When source is not
+// empty, it names the content file the item came from.
+func FormatCreationError(itemType, title string, index int, source string, err error) string {
+	if source != "" {
+		return fmt.Sprintf("%s %d (%s) from %s: %v", itemType, index+1, title, source, err)
+	}
 	return fmt.Sprintf("%s %d (%s): %v", itemType, index+1, title, err)
 }
 
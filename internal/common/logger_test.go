@@ -1,6 +1,7 @@
 package common
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -93,3 +94,31 @@ func TestStandardLogger_Methods(t *testing.T) {
 		})
 	}
 }
+
+// TestFormatCreationError tests the creation error message with and without a source file
+func TestFormatCreationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "without source",
+			expected: "Issue 3 (Broken build): forbidden",
+		},
+		{
+			name:     "with source",
+			source:   "demos/issues.json",
+			expected: "Issue 3 (Broken build) from demos/issues.json: forbidden",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := FormatCreationError("Issue", "Broken build", 2, tt.source, fmt.Errorf("forbidden"))
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -5,8 +5,10 @@ package config
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/chrisreddington/gh-demo/internal/errors"
@@ -20,23 +22,74 @@ const (
 	// DefaultLabelColor is the default color for auto-generated labels
 	DefaultLabelColor = "ededed"
 
+	// DefaultLabelDescription is the description given to auto-generated labels that match no configured pattern
+	DefaultLabelDescription = "Label created by gh-demo hydration tool"
+
 	// APITimeout is the default timeout for GitHub API operations
 	APITimeout = 30 * time.Second
 
 	// FileOperationTimeout is the timeout for file I/O operations
 	FileOperationTimeout = 10 * time.Second
 
+	// MaxBodyLength is the maximum number of characters GitHub accepts in an issue, pull request, or discussion body
+	MaxBodyLength = 65536
+
+	// DefaultMaxItemsPerType is the default cap on the number of issues, discussions or pull requests
+	// a single run creates, guarding against a configuration that grew far larger than intended
+	DefaultMaxItemsPerType = 500
+
+	// DefaultMaxPages is the default cap on the number of pages a list operation fetches,
+	// guarding against a cursor that never reaches the last page
+	DefaultMaxPages = 100
+
+	// DefaultCleanupConcurrency is the default number of deletions cleanup runs at once
+	DefaultCleanupConcurrency = 1
+
+	// RequestBurst is the number of requests sent at once before --max-requests-per-minute paces them
+	RequestBurst = 10
+
+	// DefaultRetries is the default number of times a read query is retried after a transient server error
+	DefaultRetries = 2
+
+	// DefaultRetryBackoff is the default delay before the first retry; each further retry waits twice as long
+	DefaultRetryBackoff = time.Second
+
+	// DefaultMaxRateLimitWait is the default longest wait for the GraphQL rate limit to reset with --resume-on-rate-limit.
+	// GitHub resets the primary rate limit every hour, so the default covers any reset.
+	DefaultMaxRateLimitWait = time.Hour
+
+	// DefaultNetworkProfile is the network profile whose values the timeout, retry and concurrency flags default to
+	DefaultNetworkProfile = "balanced"
+
+	// DefaultBranchPrefix is the name prefix of the demo branches deleted by --prune-branches
+	DefaultBranchPrefix = "demo/"
+
+	// DefaultBodyPreviewLength is the number of body characters shown per item by --show-bodies
+	DefaultBodyPreviewLength = 200
+
+	// MaxLabelNameLength is the maximum number of characters GitHub accepts in a label name
+	MaxLabelNameLength = 50
+
+	// ViewerLogin stands for the authenticated user wherever content accepts a login, so that shared
+	// configurations can assign whoever runs them
+	ViewerLogin = "@me"
+
 	// ProjectV2 defaults
 	DefaultProjectVisibility = "private"
 	DefaultProjectTitle      = "Repository Hydration Project"
 
 	// Configuration file names
-	IssuesFilename        = "issues.json"
-	DiscussionsFilename   = "discussions.json"
-	PullRequestsFilename  = "prs.json"
-	LabelsFilename        = "labels.json"
-	PreserveFilename      = "preserve.json"
-	ProjectConfigFilename = "project-config.json"
+	IssuesFilename            = "issues.json"
+	DiscussionsFilename       = "discussions.json"
+	PullRequestsFilename      = "prs.json"
+	LabelsFilename            = "labels.json"
+	PreserveFilename          = "preserve.json"
+	ProjectConfigFilename     = "project-config.json"
+	LabelDescriptionsFilename = "label-descriptions.json"
+	AssigneeGroupsFilename    = "assignee-groups.json"
+	MetadataFilename          = "metadata.json"
+	CrossReferencesFilename   = "cross-references.json"
+	FilesFilename             = "files.json"
 )
 
 // Configuration holds all configuration paths and provides validation.
@@ -46,25 +99,35 @@ type Configuration struct {
 	BasePath string
 
 	// Computed file paths
-	IssuesPath        string
-	DiscussionsPath   string
-	PullRequestsPath  string
-	LabelsPath        string
-	PreservePath      string
-	ProjectConfigPath string
+	IssuesPath            string
+	DiscussionsPath       string
+	PullRequestsPath      string
+	LabelsPath            string
+	PreservePath          string
+	ProjectConfigPath     string
+	LabelDescriptionsPath string
+	AssigneeGroupsPath    string
+	MetadataPath          string
+	CrossReferencesPath   string
+	FilesPath             string
 }
 
 // NewConfiguration creates a new configuration with the given base path.
 // The base path should be relative to the project root.
 func NewConfiguration(ctx context.Context, basePath string) *Configuration {
 	return &Configuration{
-		BasePath:          basePath,
-		IssuesPath:        filepath.Join(basePath, IssuesFilename),
-		DiscussionsPath:   filepath.Join(basePath, DiscussionsFilename),
-		PullRequestsPath:  filepath.Join(basePath, PullRequestsFilename),
-		LabelsPath:        filepath.Join(basePath, LabelsFilename),
-		PreservePath:      filepath.Join(basePath, PreserveFilename),
-		ProjectConfigPath: filepath.Join(basePath, ProjectConfigFilename),
+		BasePath:              basePath,
+		IssuesPath:            filepath.Join(basePath, IssuesFilename),
+		DiscussionsPath:       filepath.Join(basePath, DiscussionsFilename),
+		PullRequestsPath:      filepath.Join(basePath, PullRequestsFilename),
+		LabelsPath:            filepath.Join(basePath, LabelsFilename),
+		PreservePath:          filepath.Join(basePath, PreserveFilename),
+		ProjectConfigPath:     filepath.Join(basePath, ProjectConfigFilename),
+		LabelDescriptionsPath: filepath.Join(basePath, LabelDescriptionsFilename),
+		AssigneeGroupsPath:    filepath.Join(basePath, AssigneeGroupsFilename),
+		MetadataPath:          filepath.Join(basePath, MetadataFilename),
+		CrossReferencesPath:   filepath.Join(basePath, CrossReferencesFilename),
+		FilesPath:             filepath.Join(basePath, FilesFilename),
 	}
 }
 
@@ -73,13 +136,18 @@ func NewConfiguration(ctx context.Context, basePath string) *Configuration {
 func NewConfigurationWithRoot(ctx context.Context, projectRoot, basePath string) *Configuration {
 	absoluteBasePath := filepath.Join(projectRoot, basePath)
 	return &Configuration{
-		BasePath:          absoluteBasePath,
-		IssuesPath:        filepath.Join(absoluteBasePath, IssuesFilename),
-		DiscussionsPath:   filepath.Join(absoluteBasePath, DiscussionsFilename),
-		PullRequestsPath:  filepath.Join(absoluteBasePath, PullRequestsFilename),
-		LabelsPath:        filepath.Join(absoluteBasePath, LabelsFilename),
-		PreservePath:      filepath.Join(absoluteBasePath, PreserveFilename),
-		ProjectConfigPath: filepath.Join(absoluteBasePath, ProjectConfigFilename),
+		BasePath:              absoluteBasePath,
+		IssuesPath:            filepath.Join(absoluteBasePath, IssuesFilename),
+		DiscussionsPath:       filepath.Join(absoluteBasePath, DiscussionsFilename),
+		PullRequestsPath:      filepath.Join(absoluteBasePath, PullRequestsFilename),
+		LabelsPath:            filepath.Join(absoluteBasePath, LabelsFilename),
+		PreservePath:          filepath.Join(absoluteBasePath, PreserveFilename),
+		ProjectConfigPath:     filepath.Join(absoluteBasePath, ProjectConfigFilename),
+		LabelDescriptionsPath: filepath.Join(absoluteBasePath, LabelDescriptionsFilename),
+		AssigneeGroupsPath:    filepath.Join(absoluteBasePath, AssigneeGroupsFilename),
+		MetadataPath:          filepath.Join(absoluteBasePath, MetadataFilename),
+		CrossReferencesPath:   filepath.Join(absoluteBasePath, CrossReferencesFilename),
+		FilesPath:             filepath.Join(absoluteBasePath, FilesFilename),
 	}
 }
 
@@ -143,6 +211,180 @@ func LoadPreserveConfig(ctx context.Context, filePath string) (*PreserveConfig,
 	return &config, nil
 }
 
+// LoadLabelDescriptions loads the mapping of label name patterns to default descriptions used for
+// auto-generated labels. Patterns are name prefixes with an optional trailing "*", e.g. "area/*".
+// If the file doesn't exist, it returns an empty mapping.
+func LoadLabelDescriptions(ctx context.Context, filePath string) (map[string]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return map[string]string{}, nil
+	}
+	if err != nil {
+		return nil, errors.FileError("read_label_descriptions", "failed to read label descriptions file", err)
+	}
+
+	var descriptions map[string]string
+	if err := json.Unmarshal(data, &descriptions); err != nil {
+		return nil, errors.FileError("parse_label_descriptions", "failed to parse label descriptions JSON", err)
+	}
+	if descriptions == nil {
+		descriptions = map[string]string{}
+	}
+
+	return descriptions, nil
+}
+
+// LoadAssigneeGroups loads the named groups of logins that content can assign with "@name", e.g.
+// {"triage": ["alice", "bob"]}. Groups cannot contain other groups, and "me" is reserved for
+// ViewerLogin. If the file doesn't exist, it returns an empty mapping.
+func LoadAssigneeGroups(ctx context.Context, filePath string) (map[string][]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return map[string][]string{}, nil
+	}
+	if err != nil {
+		return nil, errors.FileError("read_assignee_groups", "failed to read assignee groups file", err)
+	}
+
+	var groups map[string][]string
+	if err := json.Unmarshal(data, &groups); err != nil {
+		return nil, errors.FileError("parse_assignee_groups", "failed to parse assignee groups JSON", err)
+	}
+	for name, members := range groups {
+		if "@"+name == ViewerLogin {
+			return nil, errors.ConfigError("parse_assignee_groups", fmt.Sprintf("assignee group name '%s' is reserved for the authenticated user", name), nil)
+		}
+		for _, member := range members {
+			if strings.HasPrefix(member, "@") {
+				return nil, errors.ConfigError("parse_assignee_groups", fmt.Sprintf("assignee group '%s' contains '%s'; groups cannot contain other groups", name, member), nil)
+			}
+		}
+	}
+	if groups == nil {
+		groups = map[string][]string{}
+	}
+
+	return groups, nil
+}
+
+// MetadataConfig customizes how gh-demo marks the content it creates
+type MetadataConfig struct {
+	// MarkerLabel is added to every created issue, discussion and pull request, and ensured to exist
+	MarkerLabel string `json:"marker_label,omitempty"`
+
+	// MetadataTemplate is a Go text/template rendered into the --append-metadata footer.
+	// It can use {{.RunID}}, {{.Date}} and {{.Timestamp}}.
+	MetadataTemplate string `json:"metadata_template,omitempty"`
+}
+
+// LoadMetadataConfiguration loads the marker label and metadata footer template from the specified
+// file path, rejecting a marker label GitHub would not accept as a label name. If the file doesn't
+// exist, it returns an empty configuration, which keeps the built-in footer and adds no label.
+func LoadMetadataConfiguration(ctx context.Context, filePath string) (*MetadataConfig, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return &MetadataConfig{}, nil
+	}
+	if err != nil {
+		return nil, errors.FileError("read_metadata_config", "failed to read metadata configuration file", err)
+	}
+
+	var metadata MetadataConfig
+	if err := json.Unmarshal(data, &metadata); err != nil {
+		return nil, errors.FileError("parse_metadata_config", "failed to parse metadata configuration JSON", err)
+	}
+	if metadata.MarkerLabel != "" {
+		if err := (types.Label{Name: metadata.MarkerLabel, Color: DefaultLabelColor}).Validate(); err != nil {
+			return nil, errors.ConfigError("parse_metadata_config", "invalid marker label", err)
+		}
+		if metadata.MarkerLabel != strings.TrimSpace(metadata.MarkerLabel) {
+			return nil, errors.ConfigError("parse_metadata_config", fmt.Sprintf("marker label '%s' cannot start or end with whitespace", metadata.MarkerLabel), nil)
+		}
+		if length := len([]rune(metadata.MarkerLabel)); length > MaxLabelNameLength {
+			return nil, errors.ConfigError("parse_metadata_config", fmt.Sprintf("marker label '%s' is %d characters long; GitHub allows at most %d", metadata.MarkerLabel, length, MaxLabelNameLength), nil)
+		}
+	}
+
+	return &metadata, nil
+}
+
+// LoadCrossReferences loads the comments that link created issues and pull requests to each other,
+// rejecting invalid entries. If the file doesn't exist, it returns no cross-references.
+func LoadCrossReferences(ctx context.Context, filePath string) ([]types.CrossReference, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.FileError("read_cross_references", "failed to read cross-references file", err)
+	}
+
+	var references []types.CrossReference
+	if err := json.Unmarshal(data, &references); err != nil {
+		return nil, errors.FileError("parse_cross_references", "failed to parse cross-references JSON", err)
+	}
+	for i, reference := range references {
+		if err := reference.Validate(); err != nil {
+			return nil, errors.ConfigError("parse_cross_references", fmt.Sprintf("invalid cross-reference %d", i+1), err)
+		}
+	}
+
+	return references, nil
+}
+
+// LoadSeedFiles loads the files written to the repository's default branch, rejecting invalid
+// entries. The content of an entry with a source is read from that file, relative to the directory
+// of the files manifest. If the file doesn't exist, it returns no files.
+func LoadSeedFiles(ctx context.Context, filePath string) ([]types.SeedFile, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filePath)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.FileError("read_seed_files", "failed to read files manifest", err)
+	}
+
+	var files []types.SeedFile
+	if err := json.Unmarshal(data, &files); err != nil {
+		return nil, errors.FileError("parse_seed_files", "failed to parse files manifest JSON", err)
+	}
+	for i, file := range files {
+		if err := file.Validate(); err != nil {
+			return nil, errors.ConfigError("parse_seed_files", fmt.Sprintf("invalid file %d", i+1), err)
+		}
+		if file.Source == "" {
+			continue
+		}
+		content, err := os.ReadFile(filepath.Join(filepath.Dir(filePath), file.Source))
+		if err != nil {
+			return nil, errors.FileError("read_seed_files", fmt.Sprintf("failed to read source of file '%s'", file.Path), err)
+		}
+		files[i].Content = string(content)
+	}
+
+	return files, nil
+}
+
 // LoadProjectConfiguration loads project configuration from the specified file path.
 // If the file doesn't exist, it returns a default configuration.
 // This provides a consistent way to load project settings across the application.
@@ -228,6 +470,43 @@ func GetDefaultProjectConfiguration() *types.ProjectV2Configuration {
 	}
 }
 
+// NetworkProfile is a named preset of the settings that trade latency for reliability on API calls
+type NetworkProfile struct {
+	Name         string
+	APITimeout   time.Duration // Time allowed for each GitHub API operation, including its retries
+	Retries      int           // Number of times a read query is retried after a transient server error
+	RetryBackoff time.Duration // Delay before the first retry, doubled for each further retry
+	Concurrency  int           // Number of deletions cleanup runs at once
+}
+
+// networkProfiles lists the presets selectable with --profile. "fast" suits good networks by
+// failing quickly and deleting in parallel; "resilient" suits flaky ones by waiting and retrying longer.
+var networkProfiles = []NetworkProfile{
+	{Name: "fast", APITimeout: 10 * time.Second, Retries: 0, RetryBackoff: 0, Concurrency: 4},
+	{Name: DefaultNetworkProfile, APITimeout: APITimeout, Retries: DefaultRetries, RetryBackoff: DefaultRetryBackoff, Concurrency: DefaultCleanupConcurrency},
+	{Name: "resilient", APITimeout: 90 * time.Second, Retries: 5, RetryBackoff: 2 * time.Second, Concurrency: 1},
+}
+
+// NetworkProfileNames returns the names of the network profiles, from fastest to most resilient
+func NetworkProfileNames() []string {
+	names := make([]string, 0, len(networkProfiles))
+	for _, profile := range networkProfiles {
+		names = append(names, profile.Name)
+	}
+	return names
+}
+
+// LookupNetworkProfile returns the network profile with the given name
+func LookupNetworkProfile(name string) (NetworkProfile, error) {
+	for _, profile := range networkProfiles {
+		if strings.EqualFold(profile.Name, strings.TrimSpace(name)) {
+			return profile, nil
+		}
+	}
+	return NetworkProfile{}, errors.ValidationError("lookup_network_profile",
+		fmt.Sprintf("unknown profile '%s': must be one of %s", name, strings.Join(NetworkProfileNames(), ", ")))
+}
+
 // CreateTimeoutContext creates a context with the default API timeout.
 // This provides a consistent timeout pattern across all API operations.
 func CreateTimeoutContext(ctx context.Context) (context.Context, context.CancelFunc) {
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestNewConfiguration(t *testing.T) {
@@ -290,3 +291,229 @@ func TestLoadPreserveConfig_ContextCancellation(t *testing.T) {
 		t.Errorf("Expected context.Canceled, got %v", err)
 	}
 }
+
+// TestLoadLabelDescriptions tests loading label description patterns from file
+func TestLoadLabelDescriptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectError   bool
+		expectedCount int
+	}{
+		{
+			name:          "missing file returns empty mapping",
+			content:       "",
+			expectedCount: 0,
+		},
+		{
+			name:          "valid patterns",
+			content:       `{"area/*": "Affects area: {suffix}", "priority/*": "Priority level"}`,
+			expectedCount: 2,
+		},
+		{
+			name:        "invalid JSON",
+			content:     `{"area/*": `,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), LabelDescriptionsFilename)
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+					t.Fatalf("Failed to write test file: %v", err)
+				}
+			}
+
+			descriptions, err := LoadLabelDescriptions(context.Background(), path)
+			if tt.expectError {
+				if err == nil {
+					t.Error("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(descriptions) != tt.expectedCount {
+				t.Errorf("Expected %d patterns, got %d", tt.expectedCount, len(descriptions))
+			}
+		})
+	}
+}
+
+// TestLoadAssigneeGroups tests loading assignee groups from file
+func TestLoadAssigneeGroups(t *testing.T) {
+	tests := []struct {
+		name          string
+		content       string
+		expectError   string
+		expectedCount int
+	}{
+		{
+			name:          "missing file returns empty mapping",
+			content:       "",
+			expectedCount: 0,
+		},
+		{
+			name:          "valid groups",
+			content:       `{"triage": ["alice", "bob"], "docs": ["carol"]}`,
+			expectedCount: 2,
+		},
+		{
+			name:        "invalid JSON",
+			content:     `{"triage": `,
+			expectError: "failed to parse assignee groups JSON",
+		},
+		{
+			name:        "nested group",
+			content:     `{"triage": ["alice", "@docs"], "docs": ["carol"]}`,
+			expectError: "groups cannot contain other groups",
+		},
+		{
+			name:        "reserved group name",
+			content:     `{"me": ["alice"]}`,
+			expectError: "assignee group name 'me' is reserved for the authenticated user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), AssigneeGroupsFilename)
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+					t.Fatalf("Failed to write test file: %v", err)
+				}
+			}
+
+			groups, err := LoadAssigneeGroups(context.Background(), path)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(groups) != tt.expectedCount {
+				t.Errorf("Expected %d groups, got %d", tt.expectedCount, len(groups))
+			}
+		})
+	}
+}
+
+// TestLoadMetadataConfiguration tests that the marker label and metadata template are loaded and an illegal marker label is rejected
+func TestLoadMetadataConfiguration(t *testing.T) {
+	tests := []struct {
+		name             string
+		content          string
+		expectError      string
+		expectedMetadata MetadataConfig
+	}{
+		{
+			name:    "missing file returns empty configuration",
+			content: "",
+		},
+		{
+			name:             "custom marker label and template",
+			content:          `{"marker_label": "demo-content", "metadata_template": "Seeded on {{.Date}}"}`,
+			expectedMetadata: MetadataConfig{MarkerLabel: "demo-content", MetadataTemplate: "Seeded on {{.Date}}"},
+		},
+		{
+			name:        "invalid JSON",
+			content:     `{"marker_label": `,
+			expectError: "failed to parse metadata configuration JSON",
+		},
+		{
+			name:        "blank marker label",
+			content:     `{"marker_label": "   "}`,
+			expectError: "name cannot be empty",
+		},
+		{
+			name:        "marker label with surrounding whitespace",
+			content:     `{"marker_label": " demo"}`,
+			expectError: "cannot start or end with whitespace",
+		},
+		{
+			name:        "marker label too long",
+			content:     `{"marker_label": "` + strings.Repeat("a", MaxLabelNameLength+1) + `"}`,
+			expectError: "GitHub allows at most 50",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), MetadataFilename)
+			if tt.content != "" {
+				if err := os.WriteFile(path, []byte(tt.content), 0644); err != nil {
+					t.Fatalf("Failed to write test file: %v", err)
+				}
+			}
+
+			metadata, err := LoadMetadataConfiguration(context.Background(), path)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if *metadata != tt.expectedMetadata {
+				t.Errorf("Expected %+v, got %+v", tt.expectedMetadata, *metadata)
+			}
+		})
+	}
+}
+
+// TestLookupNetworkProfile tests the values of each network profile and the error for an unknown name
+func TestLookupNetworkProfile(t *testing.T) {
+	tests := []struct {
+		name        string
+		profile     string
+		expected    NetworkProfile
+		expectError string
+	}{
+		{
+			name:     "fast",
+			profile:  "fast",
+			expected: NetworkProfile{Name: "fast", APITimeout: 10 * time.Second, Retries: 0, RetryBackoff: 0, Concurrency: 4},
+		},
+		{
+			name:     "balanced matches the defaults",
+			profile:  "balanced",
+			expected: NetworkProfile{Name: "balanced", APITimeout: APITimeout, Retries: DefaultRetries, RetryBackoff: DefaultRetryBackoff, Concurrency: DefaultCleanupConcurrency},
+		},
+		{
+			name:     "resilient is case-insensitive",
+			profile:  "Resilient",
+			expected: NetworkProfile{Name: "resilient", APITimeout: 90 * time.Second, Retries: 5, RetryBackoff: 2 * time.Second, Concurrency: 1},
+		},
+		{
+			name:        "unknown profile",
+			profile:     "turbo",
+			expectError: "unknown profile 'turbo': must be one of fast, balanced, resilient",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile, err := LookupNetworkProfile(tt.profile)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if profile != tt.expected {
+				t.Errorf("Expected profile %+v, got %+v", tt.expected, profile)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// maxReportedErrorLength is the number of characters of each message FormatPartialFailure prints
+const maxReportedErrorLength = 300
+
+// FormatPartialFailure writes a numbered report of the errors in err to w, grouped by the section
+// they came from and then by their "layer:operation" type, both in the order they first failed.
+// Each message is collapsed onto one line and truncated. An error that is not a PartialFailureError
+// is reported as a single entry.
+func FormatPartialFailure(w io.Writer, err error) {
+	if err == nil {
+		return
+	}
+
+	type section struct {
+		name     string
+		types    []string
+		messages map[string][]string
+	}
+	var sections []*section
+	byName := make(map[string]*section)
+	for _, failure := range partialFailures(err) {
+		name, errorType := classifyFailure(failure)
+		s, found := byName[name]
+		if !found {
+			s = &section{name: name, messages: make(map[string][]string)}
+			byName[name] = s
+			sections = append(sections, s)
+		}
+		if _, found := s.messages[errorType]; !found {
+			s.types = append(s.types, errorType)
+		}
+		s.messages[errorType] = append(s.messages[errorType], failure.Message)
+	}
+
+	number := 0
+	for _, s := range sections {
+		count := 0
+		for _, errorType := range s.types {
+			count += len(s.messages[errorType])
+		}
+		fmt.Fprintf(w, "%s (%d failed)\n", s.name, count)
+		for _, errorType := range s.types {
+			fmt.Fprintf(w, "  %s\n", errorType)
+			for _, message := range s.messages[errorType] {
+				number++
+				fmt.Fprintf(w, "    %d. %s\n", number, truncateErrorMessage(message))
+			}
+		}
+	}
+}
+
+// partialFailures returns the failures err reports. Messages of a PartialFailureError without
+// failures, and an error that is not a PartialFailureError, are failures of unknown type.
+func partialFailures(err error) []Failure {
+	var partialErr *PartialFailureError
+	if !errors.As(err, &partialErr) {
+		return []Failure{{Message: err.Error(), Err: err}}
+	}
+	if len(partialErr.Failures) > 0 {
+		return partialErr.Failures
+	}
+	failures := make([]Failure, len(partialErr.Errors))
+	for i, message := range partialErr.Errors {
+		failures[i] = Failure{Message: message}
+	}
+	return failures
+}
+
+// classifyFailure returns the section and type of a failure. The type is the "layer:operation" of
+// the outermost LayeredError in its error, and the section is the failure's own section or else the
+// capitalized layer.
+func classifyFailure(failure Failure) (section, errorType string) {
+	section, errorType = "Other", "other"
+	var layeredErr *LayeredError
+	if errors.As(failure.Err, &layeredErr) && layeredErr.Layer != "" {
+		errorType = layeredErr.Layer + ":" + layeredErr.Operation
+		section = strings.ToUpper(layeredErr.Layer[:1]) + layeredErr.Layer[1:]
+	}
+	if failure.Section != "" {
+		section = failure.Section
+	}
+	return section, errorType
+}
+
+// truncateErrorMessage collapses message onto a single line and truncates it to maxReportedErrorLength characters
+func truncateErrorMessage(message string) string {
+	message = strings.Join(strings.Fields(message), " ")
+	runes := []rune(message)
+	if len(runes) <= maxReportedErrorLength {
+		return message
+	}
+	return string(runes[:maxReportedErrorLength]) + "…"
+}
@@ -0,0 +1,90 @@
+package errors
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// TestFormatPartialFailure tests that errors are grouped by section and type, numbered and truncated
+func TestFormatPartialFailure(t *testing.T) {
+	longCause := strings.Repeat("x", maxReportedErrorLength)
+
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{
+			name: "multi-section partial failure",
+			err: NewPartialFailureFromFailures([]Failure{
+				{Section: "Issues", Message: "Issue 1 (Bug) from issues.json: [api:create_issue] failed to create issue: label not found", Err: APIError("create_issue", "failed to create issue", fmt.Errorf("label not found"))},
+				{Section: "Discussions", Message: "Discussion 1 (Idea): [validation:create_discussion] category 'Ideas' not found", Err: ValidationError("create_discussion", "category 'Ideas' not found")},
+				{Section: "Issues", Message: "Issue 3 (Crash) from issues.json: [context:create_issue] operation timed out", Err: NewLayeredError("context", "create_issue", "operation timed out", nil)},
+				{Section: "Issues", Message: "Issue 2 (Feature) from issues.json: [api:create_issue] failed to create issue: rate limited", Err: APIError("create_issue", "failed to create issue", fmt.Errorf("rate limited"))},
+				{Section: "Pull Requests", Message: "Pull Request 1 (Fix): [api:create_pr] failed to create pull request:\n  - head branch missing", Err: APIError("create_pr", "failed to create pull request", fmt.Errorf("head branch missing"))},
+				{Message: "[cleanup:delete_label] failed to delete label: not found", Err: NewLayeredError("cleanup", "delete_label", "failed to delete label", fmt.Errorf("not found"))},
+			}),
+			expected: `Issues (3 failed)
+  api:create_issue
+    1. Issue 1 (Bug) from issues.json: [api:create_issue] failed to create issue: label not found
+    2. Issue 2 (Feature) from issues.json: [api:create_issue] failed to create issue: rate limited
+  context:create_issue
+    3. Issue 3 (Crash) from issues.json: [context:create_issue] operation timed out
+Discussions (1 failed)
+  validation:create_discussion
+    4. Discussion 1 (Idea): [validation:create_discussion] category 'Ideas' not found
+Pull Requests (1 failed)
+  api:create_pr
+    5. Pull Request 1 (Fix): [api:create_pr] failed to create pull request: - head branch missing
+Cleanup (1 failed)
+  cleanup:delete_label
+    6. [cleanup:delete_label] failed to delete label: not found
+`,
+		},
+		{
+			name: "long message is truncated",
+			err:  NewPartialFailureFromFailures([]Failure{{Section: "Issues", Message: "Issue 1 (Bug): " + longCause, Err: fmt.Errorf("%s", longCause)}}),
+			expected: "Issues (1 failed)\n  other\n    1. Issue 1 (Bug): " +
+				longCause[:maxReportedErrorLength-len("Issue 1 (Bug): ")] + "…\n",
+		},
+		{
+			name:     "messages are not parsed for their type",
+			err:      NewPartialFailureError([]string{"Issue 1 (Bug): [api:create_issue] failed to create issue"}),
+			expected: "Other (1 failed)\n  other\n    1. Issue 1 (Bug): [api:create_issue] failed to create issue\n",
+		},
+		{
+			name:     "collected errors are grouped by layer",
+			err:      collectedErrors(ValidationError("check_labels", "bad color"), APIError("delete_label", "failed to delete label", nil)),
+			expected: "Validation (1 failed)\n  validation:check_labels\n    1. [validation:check_labels] bad color\nApi (1 failed)\n  api:delete_label\n    2. [api:delete_label] failed to delete label\n",
+		},
+		{
+			name:     "plain error is a single entry",
+			err:      fmt.Errorf("connection refused"),
+			expected: "Other (1 failed)\n  other\n    1. connection refused\n",
+		},
+		{
+			name: "nil error writes nothing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			FormatPartialFailure(&out, tt.err)
+			if out.String() != tt.expected {
+				t.Errorf("Expected:\n%s\ngot:\n%s", tt.expected, out.String())
+			}
+		})
+	}
+}
+
+// collectedErrors returns the result of an ErrorCollector given errs
+func collectedErrors(errs ...error) error {
+	collector := NewErrorCollector("test")
+	for _, err := range errs {
+		collector.Add(err)
+	}
+	return collector.Result()
+}
@@ -12,7 +12,16 @@ import (
 // PartialFailureError represents an error where some operations succeeded and some failed.
 // This allows callers to distinguish between complete failures and partial failures.
 type PartialFailureError struct {
-	Errors []string // Individual error messages for failed operations
+	Errors   []string  // Individual error messages for failed operations
+	Failures []Failure // The failed operations behind Errors, in the same order, when they are known
+}
+
+// Failure is one failed operation of a PartialFailureError. FormatPartialFailure groups failures by
+// Section and by the layer and operation of the LayeredError in Err.
+type Failure struct {
+	Section string // Group the failure is reported under, e.g. "Issues"; empty to use the layer of Err
+	Message string // Message reported for the failure, naming the item that failed
+	Err     error  // Error that caused the failure
 }
 
 // Error implements the error interface.
@@ -25,6 +34,15 @@ func NewPartialFailureError(errors []string) *PartialFailureError {
 	return &PartialFailureError{Errors: errors}
 }
 
+// NewPartialFailureFromFailures creates a new PartialFailureError reporting each of failures.
+func NewPartialFailureFromFailures(failures []Failure) *PartialFailureError {
+	messages := make([]string, len(failures))
+	for i, failure := range failures {
+		messages[i] = failure.Message
+	}
+	return &PartialFailureError{Errors: messages, Failures: failures}
+}
+
 // IsPartialFailure checks if an error is a PartialFailureError.
 func IsPartialFailure(err error) bool {
 	_, ok := err.(*PartialFailureError)
@@ -151,12 +169,12 @@ func (ec *ErrorCollector) Result() error {
 		return ec.errors[0]
 	}
 
-	// Convert to partial failure with error strings
-	errorStrings := make([]string, len(ec.errors))
+	// Convert to partial failure, keeping the errors for grouping
+	failures := make([]Failure, len(ec.errors))
 	for i, err := range ec.errors {
-		errorStrings[i] = err.Error()
+		failures[i] = Failure{Message: err.Error(), Err: err}
 	}
-	return NewPartialFailureError(errorStrings)
+	return NewPartialFailureFromFailures(failures)
 }
 
 // Context error handling functions
@@ -166,6 +184,12 @@ func IsContextError(err error) bool {
 	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
 }
 
+// IsTimeoutError checks if error was caused by a deadline being exceeded.
+// Unlike cancellation, a timeout leaves it unknown whether the server completed the request.
+func IsTimeoutError(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
 // ContextError creates user-friendly context error messages for timeout and cancellation scenarios.
 // It wraps context errors with LayeredError to provide consistent error handling.
 func ContextError(operation string, cause error) error {
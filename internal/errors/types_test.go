@@ -312,6 +312,45 @@ func TestIsContextError(t *testing.T) {
 	}
 }
 
+// TestIsTimeoutError tests timeout error detection
+func TestIsTimeoutError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "context deadline exceeded",
+			err:      context.DeadlineExceeded,
+			expected: true,
+		},
+		{
+			name:     "wrapped deadline exceeded",
+			err:      ContextError("create_issue", context.DeadlineExceeded),
+			expected: true,
+		},
+		{
+			name:     "context canceled",
+			err:      context.Canceled,
+			expected: false,
+		},
+		{
+			name:     "nil error",
+			err:      nil,
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := IsTimeoutError(tt.err)
+			if result != tt.expected {
+				t.Errorf("IsTimeoutError() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
+
 // TestContextError tests context error wrapping
 func TestContextError(t *testing.T) {
 	tests := []struct {
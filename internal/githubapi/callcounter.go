@@ -0,0 +1,113 @@
+package githubapi
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// operationNamePattern extracts the operation name from a GraphQL document such as "query GetLabelId(...)"
+var operationNamePattern = regexp.MustCompile(`^\s*(?:query|mutation)\s+([A-Za-z_][A-Za-z0-9_]*)`)
+
+// operationName returns the GraphQL operation name of query, or "anonymous" when it is unnamed
+func operationName(query string) string {
+	if match := operationNamePattern.FindStringSubmatch(query); match != nil {
+		return match[1]
+	}
+	return "anonymous"
+}
+
+// CountingGraphQLClient wraps a GraphQLClient and counts the calls made per GraphQL operation.
+// It is safe for concurrent use.
+type CountingGraphQLClient struct {
+	client GraphQLClient
+
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+// NewCountingGraphQLClient returns a GraphQLClient that counts every call before delegating to client.
+func NewCountingGraphQLClient(client GraphQLClient) *CountingGraphQLClient {
+	return &CountingGraphQLClient{
+		client: client,
+		counts: make(map[string]int),
+	}
+}
+
+// Do records the call against its operation name and delegates to the wrapped client.
+// Calls are counted whether or not they succeed, since failed calls still consume API quota.
+func (c *CountingGraphQLClient) Do(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+	name := operationName(query)
+
+	c.mu.Lock()
+	c.counts[name]++
+	c.mu.Unlock()
+
+	return c.client.Do(ctx, query, variables, response)
+}
+
+// Counts returns a snapshot of the number of calls made per operation name.
+func (c *CountingGraphQLClient) Counts() map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot := make(map[string]int, len(c.counts))
+	for name, count := range c.counts {
+		snapshot[name] = count
+	}
+	return snapshot
+}
+
+// APICallSummary reports the number of GraphQL calls made in total and per operation.
+type APICallSummary struct {
+	Total       int              `json:"total"`
+	ByOperation map[string]int   `json:"by_operation"`
+	RateLimit   *types.RateLimit `json:"rate_limit,omitempty"` // Remaining budget after the run, when known
+}
+
+// NewAPICallSummary builds a summary from per-operation call counts.
+func NewAPICallSummary(counts map[string]int) APICallSummary {
+	summary := APICallSummary{ByOperation: make(map[string]int, len(counts))}
+	for name, count := range counts {
+		summary.ByOperation[name] = count
+		summary.Total += count
+	}
+	return summary
+}
+
+// String formats the summary for logs, listing operations by name,
+// e.g. "API calls: 5 total (CreateIssue: 2, GetLabelId: 3)".
+func (s APICallSummary) String() string {
+	if s.Total == 0 {
+		return "API calls: 0 total"
+	}
+
+	names := make([]string, 0, len(s.ByOperation))
+	for name := range s.ByOperation {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, fmt.Sprintf("%s: %d", name, s.ByOperation[name]))
+	}
+	return fmt.Sprintf("API calls: %d total (%s)", s.Total, strings.Join(parts, ", "))
+}
+
+// RateLimitString formats the remaining rate limit budget for logs, e.g.
+// "Rate limit: 4980/5000 points remaining, resets at 2025-01-01T12:00:00Z".
+// It returns an empty string when the rate limit is unknown.
+func (s APICallSummary) RateLimitString() string {
+	if s.RateLimit == nil {
+		return ""
+	}
+	return fmt.Sprintf("Rate limit: %d/%d points remaining, resets at %s",
+		s.RateLimit.Remaining, s.RateLimit.Limit, s.RateLimit.ResetAt.UTC().Format(time.RFC3339))
+}
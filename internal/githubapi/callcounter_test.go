@@ -0,0 +1,81 @@
+package githubapi
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestOperationName tests extraction of GraphQL operation names
+func TestOperationName(t *testing.T) {
+	tests := []struct {
+		name     string
+		query    string
+		expected string
+	}{
+		{name: "named query", query: getLabelIdQuery, expected: "GetLabelId"},
+		{name: "named mutation", query: createIssueMutation, expected: "CreateIssue"},
+		{name: "anonymous query", query: "query($owner: String!) { repository(owner: $owner) { id } }", expected: "anonymous"},
+		{name: "shorthand query", query: "{ viewer { login } }", expected: "anonymous"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := operationName(tt.query); got != tt.expected {
+				t.Errorf("operationName() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+// TestAPICallCounts tests that call counts reflect the operations performed in a small run
+func TestAPICallCounts(t *testing.T) {
+	client, err := NewGHClientWithClients("owner", "repo", NewDefaultMockGraphQL())
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	issues := []types.Issue{
+		{Title: "First", Body: "body", Labels: []string{"bug", "enhancement"}, Assignees: []string{"octocat"}},
+		{Title: "Second", Body: "body", Labels: []string{"bug", "enhancement"}, Assignees: []string{"octocat"}},
+	}
+	for _, issue := range issues {
+		if _, err := client.CreateIssue(context.Background(), issue); err != nil {
+			t.Fatalf("Unexpected error creating issue: %v", err)
+		}
+	}
+
+	// Labels and users are resolved once and then served from the resolver cache
+	expected := map[string]int{
+		"GetRepositoryId": 2,
+		"GetLabelId":      2,
+		"GetUserId":       1,
+		"CreateIssue":     2,
+	}
+	if counts := client.APICallCounts(); !reflect.DeepEqual(counts, expected) {
+		t.Errorf("Expected call counts %v, got %v", expected, counts)
+	}
+
+	summary := NewAPICallSummary(client.APICallCounts())
+	if summary.Total != 7 {
+		t.Errorf("Expected 7 total calls, got %d", summary.Total)
+	}
+	expectedText := "API calls: 7 total (CreateIssue: 2, GetLabelId: 2, GetRepositoryId: 2, GetUserId: 1)"
+	if summary.String() != expectedText {
+		t.Errorf("Expected summary %q, got %q", expectedText, summary.String())
+	}
+}
+
+// TestAPICallCounts_UncountedClient tests that a client built without a constructor reports no calls
+func TestAPICallCounts_UncountedClient(t *testing.T) {
+	client := &GHClient{Owner: "owner", Repo: "repo", gqlClient: NewDefaultMockGraphQL()}
+
+	if counts := client.APICallCounts(); len(counts) != 0 {
+		t.Errorf("Expected no counts, got %v", counts)
+	}
+	if text := NewAPICallSummary(client.APICallCounts()).String(); text != "API calls: 0 total" {
+		t.Errorf("Expected empty summary, got %q", text)
+	}
+}
@@ -21,16 +21,25 @@ GraphQL Operations:
 - CreateIssue: Uses createIssue mutation with labels and assignees
 - CreatePR: Uses createPullRequest mutation with labels and assignees
 - ListLabels: Uses GraphQL query for efficient label retrieval
+- ListLabelsDetailed: Pages through labels with their color and description
 - CreateDiscussion: Uses GraphQL for discussions and label management
+- CreateOrUpdateFile: Uses createCommitOnBranch against the default branch head
 */
 
 package githubapi
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
+	"path"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/chrisreddington/gh-demo/internal/common"
 	"github.com/chrisreddington/gh-demo/internal/config"
@@ -83,10 +92,16 @@ func (w *graphQLClientWrapper) Do(ctx context.Context, query string, variables m
 
 // GHClient is the main client for all GitHub API operations
 type GHClient struct {
-	Owner     string
-	Repo      string
-	gqlClient GraphQLClient
-	logger    common.Logger
+	Owner      string
+	Repo       string
+	gqlClient  GraphQLClient
+	logger     common.Logger
+	resolver   *Resolver     // Lazily created by getResolver; caches label and user IDs
+	maxPages   int           // Maximum pages fetched by a list operation; 0 uses config.DefaultMaxPages
+	apiTimeout time.Duration // Time allowed for each API operation; 0 uses config.APITimeout
+
+	// projectOwner is the login whose projects are created and found; empty uses Owner
+	projectOwner string
 }
 
 // NewGHClient creates a new GitHub API client for the specified owner and repository.
@@ -115,7 +130,7 @@ func NewGHClient(ctx context.Context, owner, repo string) (*GHClient, error) {
 	return &GHClient{
 		Owner:     strings.TrimSpace(owner),
 		Repo:      strings.TrimSpace(repo),
-		gqlClient: &graphQLClientWrapper{client: gqlClient},
+		gqlClient: NewCountingGraphQLClient(&graphQLClientWrapper{client: gqlClient}),
 		logger:    nil, // Will be set when SetLogger is called
 	}, nil
 }
@@ -134,7 +149,7 @@ func NewGHClientWithClients(owner, repo string, gqlClient GraphQLClient) (*GHCli
 	return &GHClient{
 		Owner:     strings.TrimSpace(owner),
 		Repo:      strings.TrimSpace(repo),
-		gqlClient: gqlClient,
+		gqlClient: NewCountingGraphQLClient(gqlClient),
 		logger:    nil, // Will be set when SetLogger is called
 	}, nil
 }
@@ -142,6 +157,102 @@ func NewGHClientWithClients(owner, repo string, gqlClient GraphQLClient) (*GHCli
 // SetLogger sets the logger for debug output
 func (c *GHClient) SetLogger(logger common.Logger) {
 	c.logger = logger
+	if c.resolver != nil {
+		c.resolver.SetLogger(logger)
+	}
+}
+
+// SetMaxPages caps the number of pages a list operation fetches. A value of zero or less
+// restores the default of config.DefaultMaxPages.
+func (c *GHClient) SetMaxPages(maxPages int) {
+	c.maxPages = maxPages
+}
+
+// SetNetworkProfile applies the API timeout and retry settings of profile to every later call.
+// Read queries that fail with a transient server error are retried within the timeout; the
+// profile's cleanup concurrency is applied by the caller.
+func (c *GHClient) SetNetworkProfile(profile config.NetworkProfile) {
+	c.apiTimeout = profile.APITimeout
+	if c.resolver != nil {
+		c.resolver.timeout = profile.APITimeout
+	}
+
+	// Retry beneath the call counter so that each operation is counted once
+	if counter, ok := c.gqlClient.(*CountingGraphQLClient); ok {
+		if retrying, ok := counter.client.(*RetryingGraphQLClient); ok {
+			counter.client = retrying.client
+		}
+		if profile.Retries > 0 {
+			counter.client = NewRetryingGraphQLClient(counter.client, profile.Retries, profile.RetryBackoff, c.logger)
+		}
+	}
+}
+
+// SetScheduler paces every later call with scheduler, which may be shared with other clients. Calls
+// are paced beneath the retries so that each attempt waits its turn. A nil scheduler stops pacing.
+func (c *GHClient) SetScheduler(scheduler *RequestScheduler) {
+	counter, ok := c.gqlClient.(*CountingGraphQLClient)
+	if !ok {
+		return
+	}
+
+	next := &counter.client
+	if retrying, ok := counter.client.(*RetryingGraphQLClient); ok {
+		next = &retrying.client
+	}
+	if scheduling, ok := (*next).(*SchedulingGraphQLClient); ok {
+		*next = scheduling.client
+	}
+	if scheduler != nil {
+		*next = NewSchedulingGraphQLClient(*next, scheduler)
+	}
+}
+
+// SetProjectOwner makes project operations use the projects of owner, a user or organization login,
+// instead of those of the repository owner. An empty owner restores the repository owner.
+func (c *GHClient) SetProjectOwner(owner string) {
+	c.projectOwner = strings.TrimSpace(owner)
+}
+
+// projectOwnerLogin returns the login of the user or organization whose projects are used
+func (c *GHClient) projectOwnerLogin() string {
+	if c.projectOwner != "" {
+		return c.projectOwner
+	}
+	return c.Owner
+}
+
+// requestTimeout returns the time allowed for each API operation
+func (c *GHClient) requestTimeout() time.Duration {
+	if c.apiTimeout > 0 {
+		return c.apiTimeout
+	}
+	return config.APITimeout
+}
+
+// pageLimit returns the maximum number of pages a list operation fetches
+func (c *GHClient) pageLimit() int {
+	if c.maxPages > 0 {
+		return c.maxPages
+	}
+	return config.DefaultMaxPages
+}
+
+// warnPageLimit warns that a list operation stopped at the page cap with more pages remaining.
+// The warning is always shown because cleanup acting on a truncated list silently skips items.
+func (c *GHClient) warnPageLimit(itemType string, pages, fetched int) {
+	if c.logger != nil {
+		c.logger.Info("Warning: stopped listing %s after %d pages (%d fetched); results may be truncated (raise --max-pages to fetch more)", itemType, pages, fetched)
+	}
+}
+
+// APICallCounts returns the number of GraphQL calls made by this client per operation name.
+// Clients not created through a constructor do not count calls and return an empty map.
+func (c *GHClient) APICallCounts() map[string]int {
+	if counter, ok := c.gqlClient.(*CountingGraphQLClient); ok {
+		return counter.Counts()
+	}
+	return map[string]int{}
 }
 
 // debugLog logs a debug message if logger is available
@@ -179,7 +290,7 @@ func (c *GHClient) ListLabels(ctx context.Context) ([]string, error) {
 	}
 
 	// Create timeout context for API call
-	apiCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	err := c.gqlClient.Do(apiCtx, listLabelsQuery, variables, &response)
@@ -200,6 +311,79 @@ func (c *GHClient) ListLabels(ctx context.Context) ([]string, error) {
 	return labels, nil
 }
 
+// ListLabelsDetailed retrieves every label in the repository with its color and description,
+// following pagination up to the page limit. Use ListLabels when only the names are needed.
+func (c *GHClient) ListLabelsDetailed(ctx context.Context) ([]types.Label, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("validate_client", "GraphQL client is not initialized")
+	}
+
+	c.debugLog("Fetching detailed labels from repository %s/%s", c.Owner, c.Repo)
+
+	var allLabels []types.Label
+	var cursor *string
+
+	for page := 1; ; page++ {
+		var response struct {
+			Repository struct {
+				Labels struct {
+					Nodes []struct {
+						Name        string `json:"name"`
+						Color       string `json:"color"`
+						Description string `json:"description"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool    `json:"hasNextPage"`
+						EndCursor   *string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"labels"`
+			} `json:"repository"`
+		}
+
+		variables := map[string]interface{}{
+			"owner": c.Owner,
+			"name":  c.Repo,
+			"first": 100,
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		// Create timeout context for API call
+		apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+		defer cancel()
+
+		err := c.gqlClient.Do(apiCtx, listLabelsDetailedQuery, variables, &response)
+		if err != nil {
+			c.debugLog("Failed to fetch detailed labels: %v", err)
+			if errors.IsContextError(err) {
+				return nil, errors.ContextError("list_labels", err)
+			}
+			return nil, errors.APIError("list_labels", "failed to fetch labels", err)
+		}
+
+		for _, label := range response.Repository.Labels.Nodes {
+			allLabels = append(allLabels, types.Label{
+				Name:        label.Name,
+				Color:       label.Color,
+				Description: label.Description,
+			})
+		}
+
+		if !response.Repository.Labels.PageInfo.HasNextPage {
+			break
+		}
+		if page >= c.pageLimit() {
+			c.warnPageLimit("labels", page, len(allLabels))
+			break
+		}
+		cursor = response.Repository.Labels.PageInfo.EndCursor
+	}
+
+	c.debugLog("Successfully fetched %d detailed labels", len(allLabels))
+	return allLabels, nil
+}
+
 // CreateLabel creates a new label in the repository using the provided label data.
 // It validates that the GraphQL client is initialized and creates the label with
 // the specified name, description, and color using GraphQL mutations.
@@ -223,7 +407,7 @@ func (c *GHClient) CreateLabel(ctx context.Context, label types.Label) error {
 	}
 
 	// Create timeout context for repository query
-	repoCtx, repoCancel := context.WithTimeout(ctx, config.APITimeout)
+	repoCtx, repoCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer repoCancel()
 
 	err := c.gqlClient.Do(repoCtx, getRepositoryIdQuery, repoVariables, &repoResponse)
@@ -259,7 +443,7 @@ func (c *GHClient) CreateLabel(ctx context.Context, label types.Label) error {
 	}
 
 	// Create timeout context for label creation
-	createCtx, createCancel := context.WithTimeout(ctx, config.APITimeout)
+	createCtx, createCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer createCancel()
 
 	err = c.gqlClient.Do(createCtx, createLabelMutation, mutationVariables, &mutationResponse)
@@ -279,94 +463,20 @@ func (c *GHClient) CreateLabel(ctx context.Context, label types.Label) error {
 		return errors.WithContextSafe(err, "name", label.Name)
 	}
 
+	c.getResolver().RememberLabel(label.Name, mutationResponse.CreateLabel.Label.ID)
 	c.debugLog("Successfully created label '%s' with color '%s'", label.Name, label.Color)
 	return nil
 }
 
-// resolveLabelIDs resolves label names to their corresponding IDs
-func (c *GHClient) resolveLabelIDs(ctx context.Context, labelNames []string) ([]string, error) {
-	if len(labelNames) == 0 {
-		return nil, nil
-	}
-
-	labelIDs := make([]string, 0, len(labelNames))
-
-	for _, labelName := range labelNames {
-		var labelResponse struct {
-			Repository struct {
-				Label struct {
-					ID string `json:"id"`
-				} `json:"label"`
-			} `json:"repository"`
-		}
-
-		labelVariables := map[string]interface{}{
-			"owner":     c.Owner,
-			"name":      c.Repo,
-			"labelName": labelName,
-		}
-
-		// Create timeout context for the label query
-		labelCtx, labelCancel := context.WithTimeout(ctx, config.APITimeout)
-		defer labelCancel()
-
-		err := c.gqlClient.Do(labelCtx, getLabelIdQuery, labelVariables, &labelResponse)
-		if err != nil {
-			c.debugLog("Failed to find label '%s': %v", labelName, err)
-			// Continue with other labels even if one fails
-			continue
-		}
-
-		if labelResponse.Repository.Label.ID != "" {
-			labelIDs = append(labelIDs, labelResponse.Repository.Label.ID)
-			c.debugLog("Resolved label '%s' to ID: %s", labelName, labelResponse.Repository.Label.ID)
-		} else {
-			c.debugLog("Label '%s' not found in repository", labelName)
-		}
-	}
-
-	return labelIDs, nil
-}
-
-// resolveUserIDs resolves user logins to their corresponding IDs
-func (c *GHClient) resolveUserIDs(ctx context.Context, userLogins []string) ([]string, error) {
-	if len(userLogins) == 0 {
-		return nil, nil
-	}
-
-	userIDs := make([]string, 0, len(userLogins))
-
-	for _, login := range userLogins {
-		var userResponse struct {
-			User struct {
-				ID string `json:"id"`
-			} `json:"user"`
-		}
-
-		userVariables := map[string]interface{}{
-			"login": login,
-		}
-
-		// Create timeout context for the user query
-		userCtx, userCancel := context.WithTimeout(ctx, config.APITimeout)
-		defer userCancel()
-
-		err := c.gqlClient.Do(userCtx, getUserIdQuery, userVariables, &userResponse)
-		if err != nil {
-			c.debugLog("Failed to find user '%s': %v", login, err)
-			// Continue with other users even if one fails
-			continue
-		}
-
-		if userResponse.User.ID != "" {
-			userIDs = append(userIDs, userResponse.User.ID)
-			c.debugLog("Resolved user '%s' to ID: %s", login, userResponse.User.ID)
-		} else {
-			c.debugLog("User '%s' not found", login)
-		}
+// getResolver returns the client's label and user ID resolver, creating it on first use
+// so that its caches are shared by every create operation on this client.
+func (c *GHClient) getResolver() *Resolver {
+	if c.resolver == nil {
+		c.resolver = NewResolver(c.gqlClient, c.Owner, c.Repo)
+		c.resolver.SetLogger(c.logger)
+		c.resolver.timeout = c.apiTimeout
 	}
-
-	return userIDs, nil
+	return c.resolver
 }
 
 // CreateIssue creates a new issue in the repository and returns detailed information about the created item.
@@ -392,7 +502,7 @@ func (c *GHClient) CreateIssue(ctx context.Context, issue types.Issue) (*types.C
 	}
 
 	// Create timeout context for repository query
-	repoCtx, repoCancel := context.WithTimeout(ctx, config.APITimeout)
+	repoCtx, repoCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer repoCancel()
 
 	err := c.gqlClient.Do(repoCtx, getRepositoryIdQuery, repoVariables, &repoResponse)
@@ -409,14 +519,14 @@ func (c *GHClient) CreateIssue(ctx context.Context, issue types.Issue) (*types.C
 	}
 
 	// Resolve label names to IDs
-	labelIDs, err := c.resolveLabelIDs(ctx, issue.Labels)
+	labelIDs, err := c.getResolver().LabelIDs(ctx, issue.Labels)
 	if err != nil {
 		c.debugLog("Failed to resolve label IDs: %v", err)
 		return nil, errors.APIError("resolve_labels", "failed to resolve label IDs", err)
 	}
 
 	// Resolve assignee logins to IDs
-	assigneeIDs, err := c.resolveUserIDs(ctx, issue.Assignees)
+	assigneeIDs, err := c.getResolver().UserIDs(ctx, issue.Assignees)
 	if err != nil {
 		c.debugLog("Failed to resolve assignee IDs: %v", err)
 		return nil, errors.APIError("resolve_assignees", "failed to resolve assignee IDs", err)
@@ -443,7 +553,7 @@ func (c *GHClient) CreateIssue(ctx context.Context, issue types.Issue) (*types.C
 	}
 
 	// Create timeout context for issue creation
-	createCtx, createCancel := context.WithTimeout(ctx, config.APITimeout)
+	createCtx, createCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer createCancel()
 
 	err = c.gqlClient.Do(createCtx, createIssueMutation, mutationVariables, &mutationResponse)
@@ -475,6 +585,78 @@ func (c *GHClient) CreateIssue(ctx context.Context, issue types.Issue) (*types.C
 	}, nil
 }
 
+// UpdateIssue replaces the title, body, labels and assignees of the existing issue nodeID with those
+// of issue. An empty title keeps the current one. Labels and assignees replace the current ones
+// rather than being added to them; those that cannot be resolved are skipped, as when creating an issue.
+func (c *GHClient) UpdateIssue(ctx context.Context, nodeID string, issue types.Issue) (*types.CreatedItemInfo, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("validate_client", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(nodeID) == "" {
+		return nil, errors.ValidationError("update_issue", "issue node ID cannot be empty")
+	}
+
+	c.debugLog("Updating issue '%s' (%s)", issue.Title, nodeID)
+
+	labelIDs, err := c.getResolver().LabelIDs(ctx, issue.Labels)
+	if err != nil {
+		c.debugLog("Failed to resolve label IDs: %v", err)
+		return nil, errors.APIError("resolve_labels", "failed to resolve label IDs", err)
+	}
+	assigneeIDs, err := c.getResolver().UserIDs(ctx, issue.Assignees)
+	if err != nil {
+		c.debugLog("Failed to resolve assignee IDs: %v", err)
+		return nil, errors.APIError("resolve_assignees", "failed to resolve assignee IDs", err)
+	}
+
+	var mutationResponse struct {
+		UpdateIssue struct {
+			Issue struct {
+				ID     string `json:"id"`
+				Number int    `json:"number"`
+				Title  string `json:"title"`
+				URL    string `json:"url"`
+			} `json:"issue"`
+		} `json:"updateIssue"`
+	}
+
+	// Empty lists are sent rather than nil so that labels and assignees removed from the
+	// configuration are also removed from the issue
+	mutationVariables := map[string]interface{}{
+		"id":          nodeID,
+		"body":        issue.Body,
+		"labelIds":    append([]string{}, labelIDs...),
+		"assigneeIds": append([]string{}, assigneeIDs...),
+	}
+	if strings.TrimSpace(issue.Title) != "" {
+		mutationVariables["title"] = issue.Title
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err = c.gqlClient.Do(updateCtx, updateIssueMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		c.debugLog("Failed to update issue '%s': %v", issue.Title, err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("update_issue", err)
+		}
+		layeredErr := errors.NewLayeredError("api", "update_issue", "failed to update GitHub issue", err)
+		return nil, layeredErr.WithContext("title", issue.Title)
+	}
+
+	c.debugLog("Successfully updated issue '%s' (Number: %d)", issue.Title, mutationResponse.UpdateIssue.Issue.Number)
+
+	return &types.CreatedItemInfo{
+		NodeID: mutationResponse.UpdateIssue.Issue.ID,
+		Title:  mutationResponse.UpdateIssue.Issue.Title,
+		Type:   "issue",
+		Number: mutationResponse.UpdateIssue.Issue.Number,
+		URL:    mutationResponse.UpdateIssue.Issue.URL,
+	}, nil
+}
+
 // CreateDiscussion creates a new discussion in the repository and returns detailed information about the created item.
 // It uses GraphQL to create the discussion with the specified title, body, category, and labels.
 // The method automatically finds the correct category ID and adds labels after creation.
@@ -505,7 +687,7 @@ func (c *GHClient) CreateDiscussion(ctx context.Context, discussion types.Discus
 	}
 
 	// Create timeout context for API call
-	apiCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	err := c.gqlClient.Do(apiCtx, repositoryWithDiscussionCategoriesQuery, repoVariables, &repoResponse)
@@ -521,16 +703,27 @@ func (c *GHClient) CreateDiscussion(ctx context.Context, discussion types.Discus
 	}
 	c.debugLog("Available discussion categories: %v", availableCategories)
 
-	// Find the category ID that matches the requested category name
+	// No category can match in a repository without categories, so say what is actually wrong
+	if len(availableCategories) == 0 {
+		err := errors.ValidationError("validate_discussion_category", noDiscussionCategoriesMessage)
+		return nil, errors.WithContextSafe(err, "requested_category", discussion.Category)
+	}
+
+	// Find the category ID that matches the requested category name, preferring an exact match
+	// over one that differs only in case
 	var categoryID string
 	var matchedCategory string
 	for _, category := range repoResponse.Repository.Categories.Nodes {
 		c.debugLog("Comparing category '%s' with requested '%s'", category.Name, discussion.Category)
-		if strings.EqualFold(category.Name, discussion.Category) {
+		if category.Name == discussion.Category {
 			categoryID = category.ID
 			matchedCategory = category.Name
 			break
 		}
+		if categoryID == "" && strings.EqualFold(category.Name, discussion.Category) {
+			categoryID = category.ID
+			matchedCategory = category.Name
+		}
 	}
 
 	if categoryID == "" {
@@ -544,6 +737,11 @@ func (c *GHClient) CreateDiscussion(ctx context.Context, discussion types.Discus
 	c.debugLog("Found matching category ID for '%s': %s (actual: '%s')",
 		discussion.Category, categoryID, matchedCategory)
 
+	// An inexact match still works, but the author should fix the casing in the configuration
+	if matchedCategory != discussion.Category && c.logger != nil {
+		c.logger.Info("Discussion '%s': matched '%s' to category '%s' ignoring case; use the exact name in the configuration", discussion.Title, discussion.Category, matchedCategory)
+	}
+
 	// Create the discussion
 
 	var mutationResponse struct {
@@ -571,7 +769,7 @@ func (c *GHClient) CreateDiscussion(ctx context.Context, discussion types.Discus
 	c.debugLog("Mutation input: %s", string(inputData))
 
 	// Create timeout context for the creation mutation
-	createCtx, createCancel := context.WithTimeout(ctx, config.APITimeout)
+	createCtx, createCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer createCancel()
 
 	err = c.gqlClient.Do(createCtx, createDiscussionMutation, mutationVariables, &mutationResponse)
@@ -602,14 +800,11 @@ func (c *GHClient) CreateDiscussion(ctx context.Context, discussion types.Discus
 	if len(discussion.Labels) > 0 && mutationResponse.CreateDiscussion.Discussion.ID != "" {
 		c.debugLog("Adding %d labels to discussion '%s'", len(discussion.Labels), discussion.Title)
 
-		// Add labels to the discussion using the discussion ID
-		for _, label := range discussion.Labels {
-			err := c.addLabelToDiscussion(ctx, mutationResponse.CreateDiscussion.Discussion.ID, label)
-			if err != nil {
-				c.debugLog("Failed to add label '%s' to discussion: %v", label, err)
-				// Continue with other labels even if one fails
-			} else {
-				c.debugLog("Successfully added label '%s' to discussion", label)
+		// Labels are optional extras, so a failure does not fail the discussion, but it is not silent either
+		if err := c.addLabelsToDiscussion(ctx, mutationResponse.CreateDiscussion.Discussion.ID, discussion.Labels); err != nil {
+			c.debugLog("Failed to add labels to discussion '%s': %v", discussion.Title, err)
+			if c.logger != nil {
+				c.logger.Info("Warning: could not add labels to discussion '%s': %v", discussion.Title, err)
 			}
 		}
 	}
@@ -624,39 +819,21 @@ func (c *GHClient) CreateDiscussion(ctx context.Context, discussion types.Discus
 	}, nil
 }
 
-// addLabelToDiscussion is a helper method to add a label to a discussion
-func (c *GHClient) addLabelToDiscussion(ctx context.Context, discussionID, labelName string) error {
-	// First, find the label ID for the label name
-
-	var labelResponse struct {
-		Repository struct {
-			Label struct {
-				ID string `json:"id"`
-			} `json:"label"`
-		} `json:"repository"`
-	}
-
-	labelVariables := map[string]interface{}{
-		"owner": c.Owner,
-		"repo":  c.Repo,
-		"name":  labelName,
-	}
-
-	// Create timeout context for the label query
-	labelCtx, labelCancel := context.WithTimeout(ctx, config.APITimeout)
-	defer labelCancel()
-
-	err := c.gqlClient.Do(labelCtx, labelByNameQuery, labelVariables, &labelResponse)
+// addLabelsToDiscussion resolves all label names in one pass and adds the labels that exist to a
+// discussion with a single mutation. Labels that cannot be resolved are skipped.
+func (c *GHClient) addLabelsToDiscussion(ctx context.Context, discussionID string, labelNames []string) error {
+	labelIDs, err := c.getResolver().LabelIDs(ctx, labelNames)
 	if err != nil {
-		return errors.APIError("find_label", fmt.Sprintf("failed to find label '%s'", labelName), err)
+		return errors.APIError("find_labels", "failed to resolve discussion labels", err)
 	}
 
-	if labelResponse.Repository.Label.ID == "" {
-		err := errors.ValidationError("validate_label", fmt.Sprintf("label '%s' not found in repository", labelName))
-		return errors.WithContextSafe(err, "label_name", labelName)
+	if len(labelIDs) == 0 {
+		err := errors.ValidationError("validate_labels", "none of the labels were found in the repository")
+		return errors.WithContextSafe(err, "label_names", strings.Join(labelNames, ", "))
+	}
+	if len(labelIDs) < len(labelNames) && c.logger != nil {
+		c.logger.Info("Warning: only %d of the labels %s exist in the repository; adding those to the discussion", len(labelIDs), strings.Join(labelNames, ", "))
 	}
-
-	// Add the label to the discussion
 
 	var labelMutationResponse struct {
 		AddLabelsToLabelable struct {
@@ -667,19 +844,20 @@ func (c *GHClient) addLabelToDiscussion(ctx context.Context, discussionID, label
 	labelMutationVariables := map[string]interface{}{
 		"input": map[string]interface{}{
 			"labelableId": discussionID,
-			"labelIds":    []string{labelResponse.Repository.Label.ID},
+			"labelIds":    labelIDs,
 		},
 	}
 
-	// Create timeout context for the add label mutation
-	addLabelCtx, addLabelCancel := context.WithTimeout(ctx, config.APITimeout)
+	// Create timeout context for the add labels mutation
+	addLabelCtx, addLabelCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer addLabelCancel()
 
 	err = c.gqlClient.Do(addLabelCtx, addLabelsToLabelableMutation, labelMutationVariables, &labelMutationResponse)
 	if err != nil {
-		return errors.APIError("add_label_to_discussion", fmt.Sprintf("failed to add label '%s' to discussion", labelName), err)
+		return errors.APIError("add_labels_to_discussion", fmt.Sprintf("failed to add %d labels to discussion", len(labelIDs)), err)
 	}
 
+	c.debugLog("Added %d labels to discussion", len(labelIDs))
 	return nil
 }
 
@@ -690,14 +868,14 @@ func (c *GHClient) addLabelsAndAssigneesToPR(ctx context.Context, prID string, l
 	}
 
 	// Resolve label names to IDs
-	labelIDs, err := c.resolveLabelIDs(ctx, labelNames)
+	labelIDs, err := c.getResolver().LabelIDs(ctx, labelNames)
 	if err != nil {
 		c.debugLog("Failed to resolve label IDs for PR: %v", err)
 		return errors.APIError("resolve_labels", "failed to resolve label IDs", err)
 	}
 
 	// Resolve assignee logins to IDs
-	assigneeIDs, err := c.resolveUserIDs(ctx, assigneeLogins)
+	assigneeIDs, err := c.getResolver().UserIDs(ctx, assigneeLogins)
 	if err != nil {
 		c.debugLog("Failed to resolve assignee IDs for PR: %v", err)
 		return errors.APIError("resolve_assignees", "failed to resolve assignee IDs", err)
@@ -723,7 +901,7 @@ func (c *GHClient) addLabelsAndAssigneesToPR(ctx context.Context, prID string, l
 			"labelIds":    labelIDs,
 		}
 
-		labelCtx, labelCancel := context.WithTimeout(ctx, config.APITimeout)
+		labelCtx, labelCancel := context.WithTimeout(ctx, c.requestTimeout())
 		defer labelCancel()
 
 		err = c.gqlClient.Do(labelCtx, addLabelsToLabelableMutationWithParams, labelVariables, &labelResponse)
@@ -747,7 +925,7 @@ func (c *GHClient) addLabelsAndAssigneesToPR(ctx context.Context, prID string, l
 			"assigneeIds":  assigneeIDs,
 		}
 
-		assigneeCtx, assigneeCancel := context.WithTimeout(ctx, config.APITimeout)
+		assigneeCtx, assigneeCancel := context.WithTimeout(ctx, c.requestTimeout())
 		defer assigneeCancel()
 
 		err = c.gqlClient.Do(assigneeCtx, addAssigneesToAssignableMutation, assigneeVariables, &assigneeResponse)
@@ -769,19 +947,15 @@ func (c *GHClient) CreatePR(ctx context.Context, pullRequest types.PullRequest)
 
 	c.debugLog("Creating pull request '%s' in repository %s/%s (head: %s, base: %s)", pullRequest.Title, c.Owner, c.Repo, pullRequest.Head, pullRequest.Base)
 
-	// Basic validation
-	if pullRequest.Head == "" {
-		c.debugLog("PR head branch is empty")
-		return nil, errors.ValidationError("validate_pr", "head branch cannot be empty")
+	// Basic validation; the base is optional in content files but required by the API
+	if err := pullRequest.Validate(); err != nil {
+		c.debugLog("PR is invalid: %v", err)
+		return nil, err
 	}
 	if pullRequest.Base == "" {
 		c.debugLog("PR base branch is empty")
 		return nil, errors.ValidationError("validate_pr", "base branch cannot be empty")
 	}
-	if pullRequest.Head == pullRequest.Base {
-		c.debugLog("PR head and base branches are the same: %s", pullRequest.Head)
-		return nil, errors.ValidationError("validate_pr", fmt.Sprintf("head and base branches cannot be the same (%s)", pullRequest.Head))
-	}
 
 	// First, get the repository ID
 	var repoResponse struct {
@@ -796,7 +970,7 @@ func (c *GHClient) CreatePR(ctx context.Context, pullRequest types.PullRequest)
 	}
 
 	// Create timeout context for repository query
-	repoCtx, repoCancel := context.WithTimeout(ctx, config.APITimeout)
+	repoCtx, repoCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer repoCancel()
 
 	err := c.gqlClient.Do(repoCtx, getRepositoryIdQuery, repoVariables, &repoResponse)
@@ -833,7 +1007,7 @@ func (c *GHClient) CreatePR(ctx context.Context, pullRequest types.PullRequest)
 	}
 
 	// Create timeout context for PR creation
-	createCtx, createCancel := context.WithTimeout(ctx, config.APITimeout)
+	createCtx, createCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer createCancel()
 
 	err = c.gqlClient.Do(createCtx, createPullRequestMutation, mutationVariables, &mutationResponse)
@@ -884,16 +1058,31 @@ func (c *GHClient) CreatePR(ctx context.Context, pullRequest types.PullRequest)
 
 // ListIssues retrieves all existing issues from the repository
 func (c *GHClient) ListIssues(ctx context.Context) ([]types.Issue, error) {
+	var allIssues []types.Issue
+	err := c.ListIssuePages(ctx, func(issues []types.Issue) error {
+		allIssues = append(allIssues, issues...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allIssues, nil
+}
+
+// ListIssuePages retrieves the existing issues of the repository a page at a time, calling fn
+// with each page as soon as it is fetched. Listing stops at the first error fn returns, which is
+// returned as is.
+func (c *GHClient) ListIssuePages(ctx context.Context, fn func([]types.Issue) error) error {
 	if c.gqlClient == nil {
-		return nil, errors.ValidationError("list_issues", "GraphQL client is not initialized")
+		return errors.ValidationError("list_issues", "GraphQL client is not initialized")
 	}
 
 	c.debugLog("Fetching issues from repository %s/%s", c.Owner, c.Repo)
 
-	var allIssues []types.Issue
+	fetched := 0
 	var cursor *string
 
-	for {
+	for page := 1; ; page++ {
 		var response struct {
 			Repository struct {
 				Issues struct {
@@ -907,6 +1096,11 @@ func (c *GHClient) ListIssues(ctx context.Context) ([]types.Issue, error) {
 								Name string `json:"name"`
 							} `json:"nodes"`
 						} `json:"labels"`
+						Assignees struct {
+							Nodes []struct {
+								Login string `json:"login"`
+							} `json:"nodes"`
+						} `json:"assignees"`
 					} `json:"nodes"`
 					PageInfo struct {
 						HasNextPage bool    `json:"hasNextPage"`
@@ -926,57 +1120,87 @@ func (c *GHClient) ListIssues(ctx context.Context) ([]types.Issue, error) {
 		}
 
 		// Create timeout context for API call
-		apiCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+		apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 		defer cancel()
 
 		err := c.gqlClient.Do(apiCtx, listIssuesQuery, variables, &response)
 		if err != nil {
 			c.debugLog("Failed to fetch issues: %v", err)
 			if errors.IsContextError(err) {
-				return nil, errors.ContextError("list_issues", err)
+				return errors.ContextError("list_issues", err)
 			}
-			return nil, errors.APIError("list_issues", "failed to fetch issues", err)
+			return errors.APIError("list_issues", "failed to fetch issues", err)
 		}
 
 		// Convert GraphQL response to types.Issue
+		issues := make([]types.Issue, 0, len(response.Repository.Issues.Nodes))
 		for _, issue := range response.Repository.Issues.Nodes {
 			labels := make([]string, 0, len(issue.Labels.Nodes))
 			for _, label := range issue.Labels.Nodes {
 				labels = append(labels, label.Name)
 			}
 
-			allIssues = append(allIssues, types.Issue{
-				NodeID: issue.ID,
-				Number: issue.Number,
-				Title:  issue.Title,
-				Body:   issue.Body,
-				Labels: labels,
+			assignees := make([]string, 0, len(issue.Assignees.Nodes))
+			for _, assignee := range issue.Assignees.Nodes {
+				assignees = append(assignees, assignee.Login)
+			}
+
+			issues = append(issues, types.Issue{
+				NodeID:    issue.ID,
+				Number:    issue.Number,
+				Title:     issue.Title,
+				Body:      issue.Body,
+				Labels:    labels,
+				Assignees: assignees,
 			})
 		}
+		fetched += len(issues)
+		if err := fn(issues); err != nil {
+			return err
+		}
 
 		// Check if we need to fetch more pages
 		if !response.Repository.Issues.PageInfo.HasNextPage {
 			break
 		}
+		if page >= c.pageLimit() {
+			c.warnPageLimit("issues", page, fetched)
+			break
+		}
 		cursor = response.Repository.Issues.PageInfo.EndCursor
 	}
 
-	c.debugLog("Successfully fetched %d issues", len(allIssues))
-	return allIssues, nil
+	c.debugLog("Successfully fetched %d issues", fetched)
+	return nil
 }
 
 // ListDiscussions retrieves all existing discussions from the repository
 func (c *GHClient) ListDiscussions(ctx context.Context) ([]types.Discussion, error) {
+	var allDiscussions []types.Discussion
+	err := c.ListDiscussionPages(ctx, func(discussions []types.Discussion) error {
+		allDiscussions = append(allDiscussions, discussions...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allDiscussions, nil
+}
+
+// ListDiscussionPages retrieves the existing discussions of the repository a page at a time,
+// calling fn with each page as soon as it is fetched. Listing stops at the first error fn
+// returns, which is returned as is.
+func (c *GHClient) ListDiscussionPages(ctx context.Context, fn func([]types.Discussion) error) error {
 	if c.gqlClient == nil {
-		return nil, errors.ValidationError("list_discussions", "GraphQL client is not initialized")
+		return errors.ValidationError("list_discussions", "GraphQL client is not initialized")
 	}
 
 	c.debugLog("Fetching discussions from repository %s/%s", c.Owner, c.Repo)
 
-	var allDiscussions []types.Discussion
+	fetched := 0
 	var cursor *string
 
-	for {
+	for page := 1; ; page++ {
 		var response struct {
 			Repository struct {
 				Discussions struct {
@@ -985,9 +1209,15 @@ func (c *GHClient) ListDiscussions(ctx context.Context) ([]types.Discussion, err
 						Number   int    `json:"number"`
 						Title    string `json:"title"`
 						Body     string `json:"body"`
+						Closed   bool   `json:"closed"`
 						Category struct {
 							Name string `json:"name"`
 						} `json:"category"`
+						Labels struct {
+							Nodes []struct {
+								Name string `json:"name"`
+							} `json:"nodes"`
+						} `json:"labels"`
 					} `json:"nodes"`
 					PageInfo struct {
 						HasNextPage bool    `json:"hasNextPage"`
@@ -1007,52 +1237,91 @@ func (c *GHClient) ListDiscussions(ctx context.Context) ([]types.Discussion, err
 		}
 
 		// Create timeout context for API call
-		apiCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+		apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 		defer cancel()
 
 		err := c.gqlClient.Do(apiCtx, listDiscussionsQuery, variables, &response)
 		if err != nil {
 			c.debugLog("Failed to fetch discussions: %v", err)
 			if errors.IsContextError(err) {
-				return nil, errors.ContextError("list_discussions", err)
+				return errors.ContextError("list_discussions", err)
 			}
-			return nil, errors.APIError("list_discussions", "failed to fetch discussions", err)
+			return errors.APIError("list_discussions", "failed to fetch discussions", err)
 		}
 
 		// Convert GraphQL response to types.Discussion
+		discussions := make([]types.Discussion, 0, len(response.Repository.Discussions.Nodes))
 		for _, discussion := range response.Repository.Discussions.Nodes {
-			allDiscussions = append(allDiscussions, types.Discussion{
+			labels := make([]string, 0, len(discussion.Labels.Nodes))
+			for _, label := range discussion.Labels.Nodes {
+				labels = append(labels, label.Name)
+			}
+
+			discussions = append(discussions, types.Discussion{
 				NodeID:   discussion.ID,
 				Number:   discussion.Number,
 				Title:    discussion.Title,
 				Body:     discussion.Body,
 				Category: discussion.Category.Name,
+				Labels:   labels,
+				State:    discussionState(discussion.Closed),
 			})
 		}
+		fetched += len(discussions)
+		if err := fn(discussions); err != nil {
+			return err
+		}
 
 		// Check if we need to fetch more pages
 		if !response.Repository.Discussions.PageInfo.HasNextPage {
 			break
 		}
+		if page >= c.pageLimit() {
+			c.warnPageLimit("discussions", page, fetched)
+			break
+		}
 		cursor = response.Repository.Discussions.PageInfo.EndCursor
 	}
 
-	c.debugLog("Successfully fetched %d discussions", len(allDiscussions))
-	return allDiscussions, nil
+	c.debugLog("Successfully fetched %d discussions", fetched)
+	return nil
+}
+
+// discussionState maps the closed flag of a discussion to the OPEN/CLOSED states used for issues
+func discussionState(closed bool) string {
+	if closed {
+		return "CLOSED"
+	}
+	return "OPEN"
 }
 
 // ListPRs retrieves all existing pull requests from the repository
 func (c *GHClient) ListPRs(ctx context.Context) ([]types.PullRequest, error) {
+	var allPRs []types.PullRequest
+	err := c.ListPRPages(ctx, func(pullRequests []types.PullRequest) error {
+		allPRs = append(allPRs, pullRequests...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return allPRs, nil
+}
+
+// ListPRPages retrieves the existing pull requests of the repository a page at a time, calling fn
+// with each page as soon as it is fetched. Listing stops at the first error fn returns, which is
+// returned as is.
+func (c *GHClient) ListPRPages(ctx context.Context, fn func([]types.PullRequest) error) error {
 	if c.gqlClient == nil {
-		return nil, errors.ValidationError("list_prs", "GraphQL client is not initialized")
+		return errors.ValidationError("list_prs", "GraphQL client is not initialized")
 	}
 
 	c.debugLog("Fetching pull requests from repository %s/%s", c.Owner, c.Repo)
 
-	var allPRs []types.PullRequest
+	fetched := 0
 	var cursor *string
 
-	for {
+	for page := 1; ; page++ {
 		var response struct {
 			Repository struct {
 				PullRequests struct {
@@ -1087,26 +1356,27 @@ func (c *GHClient) ListPRs(ctx context.Context) ([]types.PullRequest, error) {
 		}
 
 		// Create timeout context for API call
-		apiCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+		apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 		defer cancel()
 
 		err := c.gqlClient.Do(apiCtx, listPullRequestsQuery, variables, &response)
 		if err != nil {
 			c.debugLog("Failed to fetch pull requests: %v", err)
 			if errors.IsContextError(err) {
-				return nil, errors.ContextError("list_prs", err)
+				return errors.ContextError("list_prs", err)
 			}
-			return nil, errors.APIError("list_prs", "failed to fetch pull requests", err)
+			return errors.APIError("list_prs", "failed to fetch pull requests", err)
 		}
 
 		// Convert GraphQL response to types.PullRequest
+		pullRequests := make([]types.PullRequest, 0, len(response.Repository.PullRequests.Nodes))
 		for _, pr := range response.Repository.PullRequests.Nodes {
 			labels := make([]string, 0, len(pr.Labels.Nodes))
 			for _, label := range pr.Labels.Nodes {
 				labels = append(labels, label.Name)
 			}
 
-			allPRs = append(allPRs, types.PullRequest{
+			pullRequests = append(pullRequests, types.PullRequest{
 				NodeID: pr.ID,
 				Number: pr.Number,
 				Title:  pr.Title,
@@ -1116,20 +1386,192 @@ func (c *GHClient) ListPRs(ctx context.Context) ([]types.PullRequest, error) {
 				Labels: labels,
 			})
 		}
+		fetched += len(pullRequests)
+		if err := fn(pullRequests); err != nil {
+			return err
+		}
 
 		// Check if we need to fetch more pages
 		if !response.Repository.PullRequests.PageInfo.HasNextPage {
 			break
 		}
+		if page >= c.pageLimit() {
+			c.warnPageLimit("pull requests", page, fetched)
+			break
+		}
 		cursor = response.Repository.PullRequests.PageInfo.EndCursor
 	}
 
-	c.debugLog("Successfully fetched %d pull requests", len(allPRs))
-	return allPRs, nil
+	c.debugLog("Successfully fetched %d pull requests", fetched)
+	return nil
 }
 
-// Deletion operations for cleanup
-
+// GetIssue retrieves a single issue by number, including closed issues. It returns a
+// validation error when the repository has no issue with that number.
+func (c *GHClient) GetIssue(ctx context.Context, number int) (*types.Issue, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("get_issue", "GraphQL client is not initialized")
+	}
+
+	if number <= 0 {
+		return nil, errors.ValidationError("get_issue", "issue number must be positive")
+	}
+
+	c.debugLog("Retrieving issue #%d from repository %s/%s", number, c.Owner, c.Repo)
+
+	var response struct {
+		Repository struct {
+			Issue *struct {
+				ID     string `json:"id"`
+				Number int    `json:"number"`
+				Title  string `json:"title"`
+				Body   string `json:"body"`
+				State  string `json:"state"`
+				Labels struct {
+					Nodes []struct {
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"labels"`
+				Assignees struct {
+					Nodes []struct {
+						Login string `json:"login"`
+					} `json:"nodes"`
+				} `json:"assignees"`
+			} `json:"issue"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  c.Owner,
+		"name":   c.Repo,
+		"number": number,
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(apiCtx, getIssueQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to retrieve issue #%d: %v", number, err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_issue", err)
+		}
+		err = errors.APIError("get_issue", "failed to retrieve issue", err)
+		return nil, errors.WithContextSafe(err, "number", strconv.Itoa(number))
+	}
+
+	found := response.Repository.Issue
+	if found == nil {
+		err := errors.ValidationError("get_issue", fmt.Sprintf("issue #%d not found in repository %s/%s", number, c.Owner, c.Repo))
+		return nil, errors.WithContextSafe(err, "number", strconv.Itoa(number))
+	}
+
+	issue := &types.Issue{
+		NodeID:    found.ID,
+		Number:    found.Number,
+		Title:     found.Title,
+		Body:      found.Body,
+		State:     found.State,
+		Labels:    make([]string, 0, len(found.Labels.Nodes)),
+		Assignees: make([]string, 0, len(found.Assignees.Nodes)),
+	}
+	for _, label := range found.Labels.Nodes {
+		issue.Labels = append(issue.Labels, label.Name)
+	}
+	for _, assignee := range found.Assignees.Nodes {
+		issue.Assignees = append(issue.Assignees, assignee.Login)
+	}
+
+	c.debugLog("Retrieved issue #%d '%s' (%s)", issue.Number, issue.Title, issue.State)
+	return issue, nil
+}
+
+// GetPullRequest retrieves a single pull request by number, including closed and merged pull
+// requests. It returns a validation error when the repository has no pull request with that number.
+func (c *GHClient) GetPullRequest(ctx context.Context, number int) (*types.PullRequest, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("get_pull_request", "GraphQL client is not initialized")
+	}
+
+	if number <= 0 {
+		return nil, errors.ValidationError("get_pull_request", "pull request number must be positive")
+	}
+
+	c.debugLog("Retrieving pull request #%d from repository %s/%s", number, c.Owner, c.Repo)
+
+	var response struct {
+		Repository struct {
+			PullRequest *struct {
+				ID          string `json:"id"`
+				Number      int    `json:"number"`
+				Title       string `json:"title"`
+				Body        string `json:"body"`
+				State       string `json:"state"`
+				HeadRefName string `json:"headRefName"`
+				BaseRefName string `json:"baseRefName"`
+				Labels      struct {
+					Nodes []struct {
+						Name string `json:"name"`
+					} `json:"nodes"`
+				} `json:"labels"`
+				Assignees struct {
+					Nodes []struct {
+						Login string `json:"login"`
+					} `json:"nodes"`
+				} `json:"assignees"`
+			} `json:"pullRequest"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner":  c.Owner,
+		"name":   c.Repo,
+		"number": number,
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(apiCtx, getPullRequestQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to retrieve pull request #%d: %v", number, err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_pull_request", err)
+		}
+		err = errors.APIError("get_pull_request", "failed to retrieve pull request", err)
+		return nil, errors.WithContextSafe(err, "number", strconv.Itoa(number))
+	}
+
+	found := response.Repository.PullRequest
+	if found == nil {
+		err := errors.ValidationError("get_pull_request", fmt.Sprintf("pull request #%d not found in repository %s/%s", number, c.Owner, c.Repo))
+		return nil, errors.WithContextSafe(err, "number", strconv.Itoa(number))
+	}
+
+	pullRequest := &types.PullRequest{
+		NodeID:    found.ID,
+		Number:    found.Number,
+		Title:     found.Title,
+		Body:      found.Body,
+		State:     found.State,
+		Head:      found.HeadRefName,
+		Base:      found.BaseRefName,
+		Labels:    make([]string, 0, len(found.Labels.Nodes)),
+		Assignees: make([]string, 0, len(found.Assignees.Nodes)),
+	}
+	for _, label := range found.Labels.Nodes {
+		pullRequest.Labels = append(pullRequest.Labels, label.Name)
+	}
+	for _, assignee := range found.Assignees.Nodes {
+		pullRequest.Assignees = append(pullRequest.Assignees, assignee.Login)
+	}
+
+	c.debugLog("Retrieved pull request #%d '%s' (%s)", pullRequest.Number, pullRequest.Title, pullRequest.State)
+	return pullRequest, nil
+}
+
+// Deletion operations for cleanup
+
 // DeleteIssue deletes an issue by its node ID
 func (c *GHClient) DeleteIssue(ctx context.Context, nodeID string) error {
 	if c.gqlClient == nil {
@@ -1156,7 +1598,7 @@ func (c *GHClient) DeleteIssue(ctx context.Context, nodeID string) error {
 	}
 
 	// Create timeout context for API call
-	apiCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	err := c.gqlClient.Do(apiCtx, deleteIssueMutation, variables, &response)
@@ -1206,7 +1648,7 @@ func (c *GHClient) DeleteDiscussion(ctx context.Context, nodeID string) error {
 		} `json:"deleteDiscussion"`
 	}
 
-	deleteCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	deleteCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	err := c.gqlClient.Do(deleteCtx, deleteDiscussionMutation, mutationVariables, &mutationResponse)
@@ -1223,6 +1665,67 @@ func (c *GHClient) DeleteDiscussion(ctx context.Context, nodeID string) error {
 	return nil
 }
 
+// discussionCloseReasons are the reasons GitHub accepts for closing a discussion
+var discussionCloseReasons = map[string]bool{
+	"RESOLVED":  true,
+	"OUTDATED":  true,
+	"DUPLICATE": true,
+}
+
+// CloseDiscussion closes a discussion by its node ID with reason RESOLVED, OUTDATED or DUPLICATE
+// (case-insensitive). Unlike DeleteDiscussion the discussion and its comments are kept and it can
+// be reopened.
+func (c *GHClient) CloseDiscussion(ctx context.Context, nodeID, reason string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("close_discussion", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(nodeID) == "" {
+		return errors.ValidationError("close_discussion", "node ID cannot be empty")
+	}
+
+	reason = strings.ToUpper(strings.TrimSpace(reason))
+	if !discussionCloseReasons[reason] {
+		err := errors.ValidationError("close_discussion", fmt.Sprintf("invalid close reason '%s': must be RESOLVED, OUTDATED or DUPLICATE", reason))
+		return errors.WithContextSafe(err, "node_id", nodeID)
+	}
+
+	c.debugLog("Closing discussion with nodeID: %s as %s in repository %s/%s", nodeID, reason, c.Owner, c.Repo)
+
+	mutationVariables := map[string]interface{}{
+		"discussionId": nodeID,
+		"reason":       reason,
+	}
+
+	var mutationResponse struct {
+		CloseDiscussion struct {
+			Discussion struct {
+				ID    string `json:"id"`
+				Title string `json:"title"`
+			} `json:"discussion"`
+		} `json:"closeDiscussion"`
+	}
+
+	closeCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(closeCtx, closeDiscussionMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		c.debugLog("Failed to close discussion with nodeID %s: %v", nodeID, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("close_discussion", err)
+		}
+		err = errors.APIError("close_discussion", "failed to close discussion via GraphQL", err)
+		return errors.WithContextSafe(err, "node_id", nodeID)
+	}
+
+	c.debugLog("Successfully closed discussion '%s' (ID: %s)",
+		mutationResponse.CloseDiscussion.Discussion.Title,
+		mutationResponse.CloseDiscussion.Discussion.ID)
+
+	return nil
+}
+
 // DeletePR deletes a pull request by its node ID
 func (c *GHClient) DeletePR(ctx context.Context, nodeID string) error {
 	if c.gqlClient == nil {
@@ -1249,7 +1752,7 @@ func (c *GHClient) DeletePR(ctx context.Context, nodeID string) error {
 	}
 
 	// Create timeout context for API call
-	apiCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	err := c.gqlClient.Do(apiCtx, deletePullRequestMutation, variables, &response)
@@ -1286,7 +1789,97 @@ func (c *GHClient) DeleteLabel(ctx context.Context, name string) error {
 
 	c.debugLog("Deleting label '%s' from repository %s/%s", name, c.Owner, c.Repo)
 
-	// First, get the label ID by name
+	labelID, err := c.findLabelID(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	// Delete the label using its ID
+	var deleteResponse struct {
+		DeleteLabel struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"deleteLabel"`
+	}
+
+	deleteVariables := map[string]interface{}{
+		"labelId": labelID,
+	}
+
+	// Create timeout context for the delete mutation
+	deleteCtx, deleteCancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer deleteCancel()
+
+	err = c.gqlClient.Do(deleteCtx, deleteLabelMutation, deleteVariables, &deleteResponse)
+	if err != nil {
+		c.debugLog("Failed to delete label '%s': %v", name, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("delete_label", err)
+		}
+		err = errors.APIError("delete_label", fmt.Sprintf("failed to delete label '%s'", name), err)
+		err = errors.WithContextSafe(err, "label_name", name)
+		return errors.WithContextSafe(err, "label_id", labelID)
+	}
+
+	c.debugLog("Successfully deleted label '%s'", name)
+	return nil
+}
+
+// UpdateLabel updates the color and description of the label with the given name to those of label.
+// The label keeps its name, so a label matched in another case is not renamed.
+func (c *GHClient) UpdateLabel(ctx context.Context, name string, label types.Label) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("update_label", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(name) == "" {
+		return errors.ValidationError("update_label", "label name cannot be empty")
+	}
+
+	c.debugLog("Updating label '%s' (color: %s) in repository %s/%s", name, label.Color, c.Owner, c.Repo)
+
+	labelID, err := c.findLabelID(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	var updateResponse struct {
+		UpdateLabel struct {
+			Label struct {
+				ID          string `json:"id"`
+				Name        string `json:"name"`
+				Color       string `json:"color"`
+				Description string `json:"description"`
+			} `json:"label"`
+		} `json:"updateLabel"`
+	}
+
+	updateVariables := map[string]interface{}{
+		"labelId":     labelID,
+		"color":       label.Color,
+		"description": label.Description,
+	}
+
+	// Create timeout context for the update mutation
+	updateCtx, updateCancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer updateCancel()
+
+	err = c.gqlClient.Do(updateCtx, updateLabelMutation, updateVariables, &updateResponse)
+	if err != nil {
+		c.debugLog("Failed to update label '%s': %v", name, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("update_label", err)
+		}
+		err = errors.APIError("update_label", fmt.Sprintf("failed to update label '%s'", name), err)
+		err = errors.WithContextSafe(err, "label_name", name)
+		return errors.WithContextSafe(err, "label_id", labelID)
+	}
+
+	c.debugLog("Successfully updated label '%s'", name)
+	return nil
+}
+
+// findLabelID looks up the ID of the label with the given name
+func (c *GHClient) findLabelID(ctx context.Context, name string) (string, error) {
 	var labelResponse struct {
 		Repository struct {
 			Label struct {
@@ -1302,58 +1895,31 @@ func (c *GHClient) DeleteLabel(ctx context.Context, name string) error {
 	}
 
 	// Create timeout context for the label query
-	labelCtx, labelCancel := context.WithTimeout(ctx, config.APITimeout)
+	labelCtx, labelCancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer labelCancel()
 
 	err := c.gqlClient.Do(labelCtx, getLabelByNameQuery, labelVariables, &labelResponse)
 	if err != nil {
 		c.debugLog("Failed to find label '%s': %v", name, err)
 		if errors.IsContextError(err) {
-			return errors.ContextError("find_label", err)
+			return "", errors.ContextError("find_label", err)
 		}
 		err = errors.APIError("find_label", fmt.Sprintf("failed to find label '%s'", name), err)
-		return errors.WithContextSafe(err, "label_name", name)
+		return "", errors.WithContextSafe(err, "label_name", name)
 	}
 
 	if labelResponse.Repository.Label.ID == "" {
 		c.debugLog("Label '%s' not found in repository", name)
 		err := errors.ValidationError("validate_label", fmt.Sprintf("label '%s' not found in repository", name))
-		return errors.WithContextSafe(err, "label_name", name)
-	}
-
-	// Delete the label using its ID
-	var deleteResponse struct {
-		DeleteLabel struct {
-			ClientMutationID string `json:"clientMutationId"`
-		} `json:"deleteLabel"`
-	}
-
-	deleteVariables := map[string]interface{}{
-		"labelId": labelResponse.Repository.Label.ID,
-	}
-
-	// Create timeout context for the delete mutation
-	deleteCtx, deleteCancel := context.WithTimeout(ctx, config.APITimeout)
-	defer deleteCancel()
-
-	err = c.gqlClient.Do(deleteCtx, deleteLabelMutation, deleteVariables, &deleteResponse)
-	if err != nil {
-		c.debugLog("Failed to delete label '%s': %v", name, err)
-		if errors.IsContextError(err) {
-			return errors.ContextError("delete_label", err)
-		}
-		err = errors.APIError("delete_label", fmt.Sprintf("failed to delete label '%s'", name), err)
-		err = errors.WithContextSafe(err, "label_name", name)
-		return errors.WithContextSafe(err, "label_id", labelResponse.Repository.Label.ID)
+		return "", errors.WithContextSafe(err, "label_name", name)
 	}
 
-	c.debugLog("Successfully deleted label '%s'", name)
-	return nil
+	return labelResponse.Repository.Label.ID, nil
 }
 
 // ProjectV2 operations
 
-// CreateProjectV2 creates a new ProjectV2 for the repository owner using the provided configuration.
+// CreateProjectV2 creates a new ProjectV2 for the project owner using the provided configuration.
 // It returns the created project with its ID and URL for further operations.
 func (c *GHClient) CreateProjectV2(ctx context.Context, projectConfig types.ProjectV2Configuration) (*types.ProjectV2, error) {
 	if c.gqlClient == nil {
@@ -1364,12 +1930,12 @@ func (c *GHClient) CreateProjectV2(ctx context.Context, projectConfig types.Proj
 		return nil, errors.ValidationError("create_project", "project title cannot be empty")
 	}
 
-	c.debugLog("Creating ProjectV2 '%s' for owner %s", projectConfig.Title, c.Owner)
+	c.debugLog("Creating ProjectV2 '%s' for owner %s", projectConfig.Title, c.projectOwnerLogin())
 
 	// First, get the owner ID
-	ownerID, err := c.getRepositoryOwnerID(ctx)
+	ownerID, err := c.GetProjectOwnerID(ctx)
 	if err != nil {
-		return nil, errors.ProjectError("get_owner_id", "failed to get repository owner ID", err)
+		return nil, errors.ProjectError("get_owner_id", "failed to get project owner ID", err)
 	}
 
 	// Create the project
@@ -1389,7 +1955,7 @@ func (c *GHClient) CreateProjectV2(ctx context.Context, projectConfig types.Proj
 		"title":   projectConfig.Title,
 	}
 
-	createCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	createCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	err = c.gqlClient.Do(createCtx, createProjectV2Mutation, mutationVariables, &mutationResponse)
@@ -1498,7 +2064,7 @@ func (c *GHClient) createProjectV2Field(ctx context.Context, projectID string, f
 		"name":      field.Name,
 	}
 
-	createCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	createCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
 	err := c.gqlClient.Do(createCtx, createProjectV2FieldMutation, mutationVariables, &mutationResponse)
@@ -1512,9 +2078,45 @@ func (c *GHClient) createProjectV2Field(ctx context.Context, projectID string, f
 
 // createProjectV2SingleSelectField creates a single select field with options.
 func (c *GHClient) createProjectV2SingleSelectField(ctx context.Context, projectID string, field types.ProjectV2Field) error {
-	// Convert field options to GraphQL format using ProjectV2SingleSelectFieldOptionInput structure
+	options := c.singleSelectOptionsInput(field.Options)
+
+	if len(options) == 0 {
+		return errors.ValidationError("create_single_select_field", "single_select fields must have at least one option")
+	}
+
+	var mutationResponse struct {
+		CreateProjectV2Field struct {
+			ProjectV2Field struct {
+				ID       string `json:"id"`
+				Name     string `json:"name"`
+				DataType string `json:"dataType"`
+			} `json:"projectV2Field"`
+		} `json:"createProjectV2Field"`
+	}
+
+	mutationVariables := map[string]interface{}{
+		"projectId": projectID,
+		"name":      field.Name,
+		"options":   options,
+	}
+
+	createCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(createCtx, createProjectV2SingleSelectFieldMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		return errors.APIError("create_single_select_field", fmt.Sprintf("failed to create single select field '%s'", field.Name), err)
+	}
+
+	c.debugLog("Successfully created single select field: %s with %d options", field.Name, len(options))
+	return nil
+}
+
+// singleSelectOptionsInput converts field options to the ProjectV2SingleSelectFieldOptionInput format,
+// filling in the description and color that GitHub requires.
+func (c *GHClient) singleSelectOptionsInput(fieldOptions []types.ProjectV2FieldOption) []map[string]interface{} {
 	var options []map[string]interface{}
-	for _, option := range field.Options {
+	for _, option := range fieldOptions {
 		// Ensure description is not empty as it's required by GitHub API
 		description := option.Description
 		if description == "" {
@@ -1548,181 +2150,1491 @@ func (c *GHClient) createProjectV2SingleSelectField(ctx context.Context, project
 		gqlOption["color"] = color
 		options = append(options, gqlOption)
 	}
+	return options
+}
 
-	if len(options) == 0 {
-		return errors.ValidationError("create_single_select_field", "single_select fields must have at least one option")
+// UpdateProjectV2Description updates the description of an existing ProjectV2.
+func (c *GHClient) UpdateProjectV2Description(ctx context.Context, projectID, description string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("update_project_description", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(description) == "" {
+		c.debugLog("No description to update for project")
+		return nil
 	}
 
+	c.debugLog("Updating ProjectV2 description")
+
 	var mutationResponse struct {
-		CreateProjectV2Field struct {
-			ProjectV2Field struct {
-				ID       string `json:"id"`
-				Name     string `json:"name"`
-				DataType string `json:"dataType"`
-			} `json:"projectV2Field"`
-		} `json:"createProjectV2Field"`
+		UpdateProjectV2 struct {
+			ProjectV2 struct {
+				ID          string `json:"id"`
+				Description string `json:"description"`
+			} `json:"projectV2"`
+		} `json:"updateProjectV2"`
 	}
 
 	mutationVariables := map[string]interface{}{
-		"projectId": projectID,
-		"name":      field.Name,
-		"options":   options,
+		"projectId":   projectID,
+		"description": description,
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(updateCtx, updateProjectV2Mutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		return errors.APIError("update_project_description", "failed to update project description", err)
+	}
+
+	c.debugLog("Successfully updated ProjectV2 description")
+	return nil
+}
+
+// GetProjectOwnerID retrieves the node ID of the user or organization that owns projects, the repository
+// owner unless SetProjectOwner named another. It returns a validation error when the owner does not exist.
+func (c *GHClient) GetProjectOwnerID(ctx context.Context) (string, error) {
+	if c.gqlClient == nil {
+		return "", errors.ValidationError("get_project_owner_id", "GraphQL client is not initialized")
+	}
+
+	var ownerResponse struct {
+		RepositoryOwner struct {
+			ID string `json:"id"`
+		} `json:"repositoryOwner"`
+	}
+
+	ownerVariables := map[string]interface{}{
+		"owner": c.projectOwnerLogin(),
+	}
+
+	ownerCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(ownerCtx, getRepositoryOwnerIdQuery, ownerVariables, &ownerResponse)
+	if err != nil {
+		c.debugLog("Failed to fetch project owner ID: %v", err)
+		if errors.IsContextError(err) {
+			return "", errors.ContextError("get_project_owner_id", err)
+		}
+		return "", errors.APIError("get_project_owner_id", "failed to fetch project owner ID", err)
+	}
+
+	if ownerResponse.RepositoryOwner.ID == "" {
+		return "", errors.ValidationError("validate_project_owner", fmt.Sprintf("project owner %s not found", c.projectOwnerLogin()))
+	}
+
+	c.debugLog("Retrieved owner ID %s for %s", ownerResponse.RepositoryOwner.ID, c.projectOwnerLogin())
+	return ownerResponse.RepositoryOwner.ID, nil
+}
+
+// GetViewer retrieves the authenticated user's login and their permission level on the
+// configured repository. It is a read-only query and performs no writes.
+func (c *GHClient) GetViewer(ctx context.Context) (*types.ViewerInfo, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("get_viewer", "GraphQL client is not initialized")
+	}
+
+	var response struct {
+		Viewer struct {
+			Login string `json:"login"`
+		} `json:"viewer"`
+		Repository struct {
+			ViewerPermission string `json:"viewerPermission"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": c.Owner,
+		"name":  c.Repo,
+	}
+
+	viewerCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(viewerCtx, getViewerQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to fetch authenticated user: %v", err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_viewer", err)
+		}
+		return nil, errors.APIError("get_viewer", "failed to fetch authenticated user", err)
+	}
+
+	if response.Viewer.Login == "" {
+		return nil, errors.ValidationError("validate_viewer", "authenticated user not found")
+	}
+
+	c.debugLog("Authenticated as %s with %s permission on %s/%s", response.Viewer.Login, response.Repository.ViewerPermission, c.Owner, c.Repo)
+	return &types.ViewerInfo{
+		Login:                response.Viewer.Login,
+		RepositoryPermission: response.Repository.ViewerPermission,
+	}, nil
+}
+
+// GetRepositoryFeatures reports whether issues, discussions and projects are enabled on the
+// configured repository, and whether it is archived or locked. It is a read-only query.
+func (c *GHClient) GetRepositoryFeatures(ctx context.Context) (*types.RepositoryFeatures, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("get_repository_features", "GraphQL client is not initialized")
+	}
+
+	var response struct {
+		Repository *struct {
+			HasIssuesEnabled      bool `json:"hasIssuesEnabled"`
+			HasDiscussionsEnabled bool `json:"hasDiscussionsEnabled"`
+			HasProjectsEnabled    bool `json:"hasProjectsEnabled"`
+			IsArchived            bool `json:"isArchived"`
+			IsLocked              bool `json:"isLocked"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": c.Owner,
+		"name":  c.Repo,
+	}
+
+	featuresCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(featuresCtx, getRepositoryFeaturesQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to fetch repository features: %v", err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_repository_features", err)
+		}
+		return nil, errors.APIError("get_repository_features", "failed to fetch repository features", err)
+	}
+
+	if response.Repository == nil {
+		return nil, errors.ValidationError("get_repository_features", fmt.Sprintf("repository %s/%s not found", c.Owner, c.Repo))
+	}
+
+	c.debugLog("Repository features for %s/%s: issues=%v discussions=%v projects=%v archived=%v locked=%v", c.Owner, c.Repo,
+		response.Repository.HasIssuesEnabled, response.Repository.HasDiscussionsEnabled, response.Repository.HasProjectsEnabled,
+		response.Repository.IsArchived, response.Repository.IsLocked)
+	return &types.RepositoryFeatures{
+		HasIssuesEnabled:      response.Repository.HasIssuesEnabled,
+		HasDiscussionsEnabled: response.Repository.HasDiscussionsEnabled,
+		HasProjectsEnabled:    response.Repository.HasProjectsEnabled,
+		IsArchived:            response.Repository.IsArchived,
+		IsLocked:              response.Repository.IsLocked,
+	}, nil
+}
+
+// ListDiscussionCategories returns the names of the repository's discussion categories.
+// It is a read-only query.
+func (c *GHClient) ListDiscussionCategories(ctx context.Context) ([]string, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("list_discussion_categories", "GraphQL client is not initialized")
+	}
+
+	c.debugLog("Fetching discussion categories of repository %s/%s", c.Owner, c.Repo)
+
+	var response struct {
+		Repository struct {
+			ID         string `json:"id"`
+			Categories struct {
+				Nodes []struct {
+					ID   string `json:"id"`
+					Name string `json:"name"`
+				} `json:"nodes"`
+			} `json:"discussionCategories"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": c.Owner,
+		"name":  c.Repo,
+	}
+
+	// Create timeout context for API call
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(apiCtx, repositoryWithDiscussionCategoriesQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to fetch discussion categories: %v", err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("list_discussion_categories", err)
+		}
+		return nil, errors.APIError("list_discussion_categories", "failed to fetch discussion categories", err)
+	}
+
+	categories := make([]string, 0, len(response.Repository.Categories.Nodes))
+	for _, category := range response.Repository.Categories.Nodes {
+		categories = append(categories, category.Name)
+	}
+
+	c.debugLog("Found %d discussion categories", len(categories))
+	return categories, nil
+}
+
+// UserExists reports whether a GitHub user with the given login exists. It is a read-only query.
+func (c *GHClient) UserExists(ctx context.Context, login string) (bool, error) {
+	if c.gqlClient == nil {
+		return false, errors.ValidationError("user_exists", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(login) == "" {
+		return false, errors.ValidationError("user_exists", "login cannot be empty")
+	}
+
+	c.debugLog("Looking up user '%s'", login)
+
+	var response struct {
+		User *struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+
+	variables := map[string]interface{}{
+		"login": login,
+	}
+
+	// Create timeout context for API call
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(apiCtx, getUserIdQuery, variables, &response)
+	if err != nil {
+		if isNotFoundError(err) {
+			c.debugLog("User '%s' was not found", login)
+			return false, nil
+		}
+		c.debugLog("Failed to look up user '%s': %v", login, err)
+		if errors.IsContextError(err) {
+			return false, errors.ContextError("user_exists", err)
+		}
+		err = errors.APIError("user_exists", fmt.Sprintf("failed to look up user '%s'", login), err)
+		return false, errors.WithContextSafe(err, "login", login)
+	}
+
+	return response.User != nil && response.User.ID != "", nil
+}
+
+// noDiscussionCategoriesMessage explains why no discussion can be created in a repository without categories.
+// Categories can only be created in the repository settings; the API offers no mutation for them.
+const noDiscussionCategoriesMessage = "the repository has Discussions enabled but no categories defined; create one in the repository's Discussions settings (Discussions > Categories)"
+
+// isNotFoundError reports whether a GraphQL error says that a requested object does not exist
+func isNotFoundError(err error) bool {
+	var gqlErr *api.GraphQLError
+	if !stderrors.As(err, &gqlErr) {
+		return false
+	}
+	for _, item := range gqlErr.Errors {
+		if item.Type == "NOT_FOUND" {
+			return true
+		}
+	}
+	return false
+}
+
+// GetDefaultBranch returns the name of the configured repository's default branch.
+// It is a read-only query.
+func (c *GHClient) GetDefaultBranch(ctx context.Context) (string, error) {
+	if c.gqlClient == nil {
+		return "", errors.ValidationError("get_default_branch", "GraphQL client is not initialized")
+	}
+
+	var response struct {
+		Repository *struct {
+			DefaultBranchRef *struct {
+				Name string `json:"name"`
+			} `json:"defaultBranchRef"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": c.Owner,
+		"name":  c.Repo,
+	}
+
+	branchCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(branchCtx, getDefaultBranchQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to fetch default branch: %v", err)
+		if errors.IsContextError(err) {
+			return "", errors.ContextError("get_default_branch", err)
+		}
+		return "", errors.APIError("get_default_branch", "failed to fetch default branch", err)
+	}
+
+	if response.Repository == nil {
+		return "", errors.ValidationError("get_default_branch", fmt.Sprintf("repository %s/%s not found", c.Owner, c.Repo))
+	}
+	// An empty repository has no commits and therefore no default branch
+	if response.Repository.DefaultBranchRef == nil || response.Repository.DefaultBranchRef.Name == "" {
+		return "", errors.ValidationError("get_default_branch", fmt.Sprintf("repository %s/%s has no default branch", c.Owner, c.Repo))
+	}
+
+	c.debugLog("Default branch of %s/%s is %s", c.Owner, c.Repo, response.Repository.DefaultBranchRef.Name)
+	return response.Repository.DefaultBranchRef.Name, nil
+}
+
+// ListBranches retrieves the branches whose name starts with prefix, following pagination up to
+// the page limit. Each branch reports whether a branch protection rule applies to it.
+func (c *GHClient) ListBranches(ctx context.Context, prefix string) ([]types.Branch, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("list_branches", "GraphQL client is not initialized")
+	}
+
+	c.debugLog("Fetching branches starting with '%s' from repository %s/%s", prefix, c.Owner, c.Repo)
+
+	var allBranches []types.Branch
+	var cursor *string
+
+	for page := 1; ; page++ {
+		var response struct {
+			Repository struct {
+				Refs struct {
+					Nodes []struct {
+						ID                   string `json:"id"`
+						Name                 string `json:"name"`
+						BranchProtectionRule *struct {
+							ID string `json:"id"`
+						} `json:"branchProtectionRule"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool    `json:"hasNextPage"`
+						EndCursor   *string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"refs"`
+			} `json:"repository"`
+		}
+
+		variables := map[string]interface{}{
+			"owner": c.Owner,
+			"name":  c.Repo,
+			"query": prefix,
+			"first": 100,
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		// Create timeout context for API call
+		apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+		defer cancel()
+
+		err := c.gqlClient.Do(apiCtx, listBranchesQuery, variables, &response)
+		if err != nil {
+			c.debugLog("Failed to fetch branches: %v", err)
+			if errors.IsContextError(err) {
+				return nil, errors.ContextError("list_branches", err)
+			}
+			return nil, errors.APIError("list_branches", "failed to fetch branches", err)
+		}
+
+		// The query matches anywhere in the name, so only branches starting with the prefix are kept
+		for _, ref := range response.Repository.Refs.Nodes {
+			if !strings.HasPrefix(ref.Name, prefix) {
+				continue
+			}
+			allBranches = append(allBranches, types.Branch{
+				NodeID:    ref.ID,
+				Name:      ref.Name,
+				Protected: ref.BranchProtectionRule != nil,
+			})
+		}
+
+		if !response.Repository.Refs.PageInfo.HasNextPage {
+			break
+		}
+		if page >= c.pageLimit() {
+			c.warnPageLimit("branches", page, len(allBranches))
+			break
+		}
+		cursor = response.Repository.Refs.PageInfo.EndCursor
+	}
+
+	c.debugLog("Successfully fetched %d branches", len(allBranches))
+	return allBranches, nil
+}
+
+// DeleteBranch deletes a branch by the node ID of its ref
+func (c *GHClient) DeleteBranch(ctx context.Context, refID string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("delete_branch", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(refID) == "" {
+		return errors.ValidationError("delete_branch", "ref ID cannot be empty")
+	}
+
+	c.debugLog("Deleting branch ref %s in repository %s/%s", refID, c.Owner, c.Repo)
+
+	var response struct {
+		DeleteRef struct {
+			ClientMutationID string `json:"clientMutationId"`
+		} `json:"deleteRef"`
+	}
+
+	variables := map[string]interface{}{
+		"refId": refID,
+	}
+
+	// Create timeout context for API call
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(apiCtx, deleteRefMutation, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to delete branch ref %s: %v", refID, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("delete_branch", err)
+		}
+		err = errors.APIError("delete_branch", "failed to delete branch", err)
+		return errors.WithContextSafe(err, "node_id", refID)
+	}
+
+	c.debugLog("Successfully deleted branch ref %s", refID)
+	return nil
+}
+
+// ListIssueTemplates reads the Markdown issue templates in the .github/ISSUE_TEMPLATE directory of
+// the default branch. A repository without the directory has no templates and is not an error.
+// YAML issue forms and other files are ignored. It is a read-only query.
+func (c *GHClient) ListIssueTemplates(ctx context.Context) ([]types.IssueTemplate, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("list_issue_templates", "GraphQL client is not initialized")
+	}
+
+	var response struct {
+		Repository *struct {
+			Object *struct {
+				Entries []struct {
+					Name   string `json:"name"`
+					Type   string `json:"type"`
+					Object *struct {
+						Text *string `json:"text"`
+					} `json:"object"`
+				} `json:"entries"`
+			} `json:"object"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": c.Owner,
+		"name":  c.Repo,
+	}
+
+	templatesCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(templatesCtx, listIssueTemplatesQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to fetch issue templates: %v", err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("list_issue_templates", err)
+		}
+		return nil, errors.APIError("list_issue_templates", "failed to fetch issue templates", err)
+	}
+
+	if response.Repository == nil {
+		return nil, errors.ValidationError("list_issue_templates", fmt.Sprintf("repository %s/%s not found", c.Owner, c.Repo))
+	}
+	if response.Repository.Object == nil {
+		c.debugLog("Repository %s/%s has no issue templates", c.Owner, c.Repo)
+		return nil, nil
+	}
+
+	var templates []types.IssueTemplate
+	for _, entry := range response.Repository.Object.Entries {
+		if entry.Type != "blob" || !strings.EqualFold(path.Ext(entry.Name), ".md") || entry.Object == nil || entry.Object.Text == nil {
+			continue
+		}
+		templates = append(templates, parseIssueTemplate(entry.Name, *entry.Object.Text))
+	}
+
+	c.debugLog("Found %d issue templates in %s/%s", len(templates), c.Owner, c.Repo)
+	return templates, nil
+}
+
+// GetCodeowners reads the rules of the CODEOWNERS file on the default branch, from .github/, the
+// repository root or docs/, in the order GitHub looks for it. A repository without the file has no
+// rules and is not an error. It is a read-only query.
+func (c *GHClient) GetCodeowners(ctx context.Context) ([]types.CodeownersRule, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("get_codeowners", "GraphQL client is not initialized")
+	}
+
+	type blob struct {
+		Text *string `json:"text"`
+	}
+	var response struct {
+		Repository *struct {
+			GitHub *blob `json:"github"`
+			Root   *blob `json:"root"`
+			Docs   *blob `json:"docs"`
+		} `json:"repository"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": c.Owner,
+		"name":  c.Repo,
+	}
+
+	codeownersCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(codeownersCtx, getCodeownersQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Failed to fetch CODEOWNERS: %v", err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_codeowners", err)
+		}
+		return nil, errors.APIError("get_codeowners", "failed to fetch CODEOWNERS", err)
+	}
+
+	if response.Repository == nil {
+		return nil, errors.ValidationError("get_codeowners", fmt.Sprintf("repository %s/%s not found", c.Owner, c.Repo))
+	}
+	for _, file := range []*blob{response.Repository.GitHub, response.Repository.Root, response.Repository.Docs} {
+		if file != nil && file.Text != nil {
+			rules := parseCodeowners(*file.Text)
+			c.debugLog("Found %d CODEOWNERS rules in %s/%s", len(rules), c.Owner, c.Repo)
+			return rules, nil
+		}
+	}
+
+	c.debugLog("Repository %s/%s has no CODEOWNERS file", c.Owner, c.Repo)
+	return nil, nil
+}
+
+// CreateOrUpdateFile commits content to path on the default branch, creating the file or replacing
+// an existing one. The commit is made against the head commit the file was read from, so it fails
+// rather than overwriting a change pushed in between; this is the GraphQL counterpart of the SHA the
+// contents API requires for updates. A file that already has content is left without a commit.
+// An empty message defaults to "Add <path>" or "Update <path>".
+func (c *GHClient) CreateOrUpdateFile(ctx context.Context, path, content, message string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("create_or_update_file", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(path) == "" {
+		return errors.ValidationError("create_or_update_file", "file path cannot be empty")
+	}
+
+	var fileResponse struct {
+		Repository *struct {
+			DefaultBranchRef *struct {
+				Name   string `json:"name"`
+				Target struct {
+					Oid string `json:"oid"`
+				} `json:"target"`
+			} `json:"defaultBranchRef"`
+			Object *struct {
+				Oid string `json:"oid"`
+			} `json:"object"`
+		} `json:"repository"`
+	}
+
+	fileVariables := map[string]interface{}{
+		"owner":      c.Owner,
+		"name":       c.Repo,
+		"expression": "HEAD:" + path,
+	}
+
+	fileCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(fileCtx, getFileQuery, fileVariables, &fileResponse)
+	if err != nil {
+		c.debugLog("Failed to fetch file '%s': %v", path, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("create_or_update_file", err)
+		}
+		return errors.APIError("create_or_update_file", fmt.Sprintf("failed to fetch file '%s'", path), err)
+	}
+
+	if fileResponse.Repository == nil {
+		return errors.ValidationError("create_or_update_file", fmt.Sprintf("repository %s/%s not found", c.Owner, c.Repo))
+	}
+	// Commits are made on top of the default branch, which an empty repository does not have
+	branch := fileResponse.Repository.DefaultBranchRef
+	if branch == nil || branch.Name == "" {
+		return errors.ValidationError("create_or_update_file", fmt.Sprintf("repository %s/%s has no default branch", c.Owner, c.Repo))
+	}
+
+	existing := fileResponse.Repository.Object
+	if existing != nil && existing.Oid == gitBlobOid(content) {
+		c.debugLog("File '%s' is unchanged on %s", path, branch.Name)
+		return nil
+	}
+	if message == "" {
+		message = "Add " + path
+		if existing != nil {
+			message = "Update " + path
+		}
+	}
+
+	var commitResponse struct {
+		CreateCommitOnBranch struct {
+			Commit struct {
+				Oid string `json:"oid"`
+			} `json:"commit"`
+		} `json:"createCommitOnBranch"`
+	}
+
+	commitVariables := map[string]interface{}{
+		"repository":      c.Owner + "/" + c.Repo,
+		"branch":          branch.Name,
+		"message":         message,
+		"path":            path,
+		"contents":        base64.StdEncoding.EncodeToString([]byte(content)),
+		"expectedHeadOid": branch.Target.Oid,
+	}
+
+	commitCtx, commitCancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer commitCancel()
+
+	err = c.gqlClient.Do(commitCtx, createCommitOnBranchMutation, commitVariables, &commitResponse)
+	if err != nil {
+		c.debugLog("Failed to commit file '%s': %v", path, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("create_or_update_file", err)
+		}
+		return errors.APIError("create_or_update_file", fmt.Sprintf("failed to commit file '%s'", path), err)
+	}
+
+	c.debugLog("Committed '%s' to %s as %s", path, branch.Name, commitResponse.CreateCommitOnBranch.Commit.Oid)
+	return nil
+}
+
+// gitBlobOid returns the object ID git gives a file with content, used to tell whether a file
+// in the repository already has that content
+func gitBlobOid(content string) string {
+	hash := sha1.New()
+	fmt.Fprintf(hash, "blob %d\x00%s", len(content), content)
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// RequestReviews requests reviews on a pull request from users and teams, given as logins and
+// "org/team" names. Reviewers that do not exist are skipped; it is an error when none remain.
+func (c *GHClient) RequestReviews(ctx context.Context, prNodeID string, reviewers []string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("request_reviews", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(prNodeID) == "" {
+		return errors.ValidationError("request_reviews", "pull request node ID cannot be empty")
+	}
+
+	var logins, teams []string
+	for _, reviewer := range reviewers {
+		if strings.Contains(reviewer, "/") {
+			teams = append(teams, reviewer)
+		} else {
+			logins = append(logins, reviewer)
+		}
+	}
+
+	userIDs, err := c.getResolver().UserIDs(ctx, logins)
+	if err != nil {
+		return err
+	}
+	teamIDs, err := c.getResolver().TeamIDs(ctx, teams)
+	if err != nil {
+		return err
+	}
+	if len(userIDs) == 0 && len(teamIDs) == 0 {
+		return errors.ValidationError("request_reviews", fmt.Sprintf("none of the reviewers could be found: %s", strings.Join(reviewers, ", ")))
+	}
+
+	c.debugLog("Requesting reviews on pull request %s from %d users and %d teams", prNodeID, len(userIDs), len(teamIDs))
+
+	mutationVariables := map[string]interface{}{
+		"pullRequestId": prNodeID,
+		"userIds":       userIDs,
+		"teamIds":       teamIDs,
+	}
+
+	var mutationResponse struct {
+		RequestReviews struct {
+			PullRequest struct {
+				ID string `json:"id"`
+			} `json:"pullRequest"`
+		} `json:"requestReviews"`
+	}
+
+	reviewCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err = c.gqlClient.Do(reviewCtx, requestReviewsMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		c.debugLog("Failed to request reviews: %v", err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("request_reviews", err)
+		}
+		return errors.APIError("request_reviews", "failed to request reviews", err)
+	}
+
+	c.debugLog("Successfully requested reviews on pull request %s", prNodeID)
+	return nil
+}
+
+// AddPullRequestReview submits a review on a pull request. event is COMMENT, APPROVE or
+// REQUEST_CHANGES; GitHub requires a body for every event except APPROVE.
+func (c *GHClient) AddPullRequestReview(ctx context.Context, prNodeID, body, event string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("add_pull_request_review", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(prNodeID) == "" {
+		return errors.ValidationError("add_pull_request_review", "pull request node ID cannot be empty")
+	}
+	event = strings.ToUpper(strings.TrimSpace(event))
+	if !types.ReviewEvents[event] {
+		return errors.ValidationError("add_pull_request_review", fmt.Sprintf("invalid review event '%s' (must be COMMENT, APPROVE or REQUEST_CHANGES)", event))
+	}
+	if event != "APPROVE" && strings.TrimSpace(body) == "" {
+		return errors.ValidationError("add_pull_request_review", fmt.Sprintf("a %s review requires a body", event))
+	}
+
+	c.debugLog("Adding %s review to pull request %s", event, prNodeID)
+
+	mutationVariables := map[string]interface{}{
+		"pullRequestId": prNodeID,
+		"body":          body,
+		"event":         event,
+	}
+
+	var mutationResponse struct {
+		AddPullRequestReview struct {
+			PullRequestReview struct {
+				ID string `json:"id"`
+			} `json:"pullRequestReview"`
+		} `json:"addPullRequestReview"`
+	}
+
+	reviewCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(reviewCtx, addPullRequestReviewMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		c.debugLog("Failed to add review: %v", err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("add_pull_request_review", err)
+		}
+		return errors.APIError("add_pull_request_review", "failed to add pull request review", err)
+	}
+
+	c.debugLog("Successfully added review %s to pull request %s", mutationResponse.AddPullRequestReview.PullRequestReview.ID, prNodeID)
+	return nil
+}
+
+// CheckProjectAccess probes whether the token can use ProjectV2s of the project owner, so that a
+// missing scope is found before any content is created. It returns a project permission error with
+// the command that grants the scope when GitHub reports insufficient scopes. GraphQL does not expose
+// the granted scopes, so a token that can read projects but not write them passes the probe.
+func (c *GHClient) CheckProjectAccess(ctx context.Context) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("check_project_access", "GraphQL client is not initialized")
+	}
+
+	var response struct {
+		RepositoryOwner *struct {
+			ProjectsV2 struct {
+				TotalCount int `json:"totalCount"`
+			} `json:"projectsV2"`
+		} `json:"repositoryOwner"`
+	}
+
+	variables := map[string]interface{}{
+		"owner": c.projectOwnerLogin(),
+	}
+
+	accessCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(accessCtx, checkProjectAccessQuery, variables, &response)
+	if err != nil {
+		c.debugLog("Project access check failed: %v", err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("check_project_access", err)
+		}
+		message := strings.ToLower(err.Error())
+		if strings.Contains(message, "scope") || strings.Contains(message, "forbidden") {
+			return errors.ProjectPermissionError("check_project_access",
+				"token is missing the 'project' scope needed to create projects - run `gh auth refresh -s project` to add it", err)
+		}
+		return errors.APIError("check_project_access", "failed to check project access", err)
+	}
+
+	if response.RepositoryOwner == nil {
+		return errors.ValidationError("check_project_access", fmt.Sprintf("project owner %s not found", c.projectOwnerLogin()))
+	}
+
+	c.debugLog("Token can access the %d projects of %s", response.RepositoryOwner.ProjectsV2.TotalCount, c.projectOwnerLogin())
+	return nil
+}
+
+// GetRateLimit retrieves the remaining GraphQL rate limit budget and when it resets.
+// It is a read-only query.
+func (c *GHClient) GetRateLimit(ctx context.Context) (*types.RateLimit, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("get_rate_limit", "GraphQL client is not initialized")
+	}
+
+	var response struct {
+		RateLimit *struct {
+			Limit     int       `json:"limit"`
+			Remaining int       `json:"remaining"`
+			Cost      int       `json:"cost"`
+			ResetAt   time.Time `json:"resetAt"`
+		} `json:"rateLimit"`
+	}
+
+	rateCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(rateCtx, getRateLimitQuery, nil, &response)
+	if err != nil {
+		c.debugLog("Failed to fetch rate limit: %v", err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_rate_limit", err)
+		}
+		return nil, errors.APIError("get_rate_limit", "failed to fetch rate limit", err)
+	}
+
+	if response.RateLimit == nil {
+		return nil, errors.APIError("get_rate_limit", "rate limit information was not returned", nil)
+	}
+
+	rateLimit := &types.RateLimit{
+		Limit:     response.RateLimit.Limit,
+		Remaining: response.RateLimit.Remaining,
+		Cost:      response.RateLimit.Cost,
+		ResetAt:   response.RateLimit.ResetAt,
+	}
+
+	c.debugLog("Rate limit: %d/%d remaining, resets at %s", rateLimit.Remaining, rateLimit.Limit, rateLimit.ResetAt)
+	return rateLimit, nil
+}
+
+// AddItemToProjectV2 adds an item (issue, PR, discussion) to a ProjectV2 by item node ID.
+// The item must be a valid GitHub content item with a node ID.
+func (c *GHClient) AddItemToProjectV2(ctx context.Context, projectID, itemNodeID string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("add_item_to_project", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(projectID) == "" {
+		return errors.ValidationError("add_item_to_project", "project ID cannot be empty")
+	}
+
+	if strings.TrimSpace(itemNodeID) == "" {
+		return errors.ValidationError("add_item_to_project", "item node ID cannot be empty")
+	}
+
+	c.debugLog("Adding item %s to ProjectV2 %s", itemNodeID, projectID)
+
+	var mutationResponse struct {
+		AddProjectV2ItemById struct {
+			Item struct {
+				ID string `json:"id"`
+			} `json:"item"`
+		} `json:"addProjectV2ItemById"`
+	}
+
+	mutationVariables := map[string]interface{}{
+		"projectId": projectID,
+		"contentId": itemNodeID,
+	}
+
+	addCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(addCtx, addProjectV2ItemByIdMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		c.debugLog("Failed to add item to ProjectV2: %v", err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("add_item_to_project", err)
+		}
+		return errors.APIError("add_item_to_project", "failed to add item to project", err)
+	}
+
+	if mutationResponse.AddProjectV2ItemById.Item.ID == "" {
+		c.debugLog("Item addition failed - no item ID returned")
+		return errors.APIError("add_item_to_project", "item addition failed - no item ID returned from GitHub API", nil)
+	}
+
+	c.debugLog("Successfully added item %s to ProjectV2", itemNodeID)
+	return nil
+}
+
+// MarkIssueAsDuplicate marks an issue as a duplicate of issue #originalNumber. It comments
+// "Duplicate of #N", which GitHub links to the original issue, and then closes the issue with the
+// DUPLICATE state reason.
+func (c *GHClient) MarkIssueAsDuplicate(ctx context.Context, issueNodeID string, originalNumber int) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("mark_duplicate", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(issueNodeID) == "" {
+		return errors.ValidationError("mark_duplicate", "issue node ID cannot be empty")
+	}
+
+	if originalNumber <= 0 {
+		return errors.ValidationError("mark_duplicate", "original issue number must be positive")
+	}
+
+	c.debugLog("Marking issue %s as a duplicate of #%d", issueNodeID, originalNumber)
+
+	var commentResponse struct {
+		AddComment struct {
+			CommentEdge struct {
+				Node struct {
+					ID string `json:"id"`
+				} `json:"node"`
+			} `json:"commentEdge"`
+		} `json:"addComment"`
+	}
+
+	commentVariables := map[string]interface{}{
+		"subjectId": issueNodeID,
+		"body":      fmt.Sprintf("Duplicate of #%d", originalNumber),
+	}
+
+	commentCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	if err := c.gqlClient.Do(commentCtx, addCommentMutation, commentVariables, &commentResponse); err != nil {
+		c.debugLog("Failed to comment on issue %s: %v", issueNodeID, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("mark_duplicate", err)
+		}
+		err = errors.APIError("mark_duplicate", "failed to add duplicate comment", err)
+		return errors.WithContextSafe(err, "node_id", issueNodeID)
+	}
+
+	var closeResponse struct {
+		CloseIssue struct {
+			Issue struct {
+				ID    string `json:"id"`
+				State string `json:"state"`
+			} `json:"issue"`
+		} `json:"closeIssue"`
+	}
+
+	closeCtx, closeCancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer closeCancel()
+
+	if err := c.gqlClient.Do(closeCtx, closeIssueAsDuplicateMutation, map[string]interface{}{"issueId": issueNodeID}, &closeResponse); err != nil {
+		c.debugLog("Failed to close issue %s as duplicate: %v", issueNodeID, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("mark_duplicate", err)
+		}
+		err = errors.APIError("mark_duplicate", "failed to close issue as duplicate", err)
+		return errors.WithContextSafe(err, "node_id", issueNodeID)
+	}
+
+	c.debugLog("Closed issue %s as a duplicate of #%d", issueNodeID, originalNumber)
+	return nil
+}
+
+// AddComment adds a comment with body to the issue or pull request with node ID subjectNodeID
+func (c *GHClient) AddComment(ctx context.Context, subjectNodeID, body string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("add_comment", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(subjectNodeID) == "" {
+		return errors.ValidationError("add_comment", "node ID cannot be empty")
+	}
+
+	if strings.TrimSpace(body) == "" {
+		return errors.ValidationError("add_comment", "comment body cannot be empty")
+	}
+
+	c.debugLog("Adding comment to %s", subjectNodeID)
+
+	var response struct {
+		AddComment struct {
+			CommentEdge struct {
+				Node struct {
+					ID string `json:"id"`
+				} `json:"node"`
+			} `json:"commentEdge"`
+		} `json:"addComment"`
+	}
+
+	variables := map[string]interface{}{
+		"subjectId": subjectNodeID,
+		"body":      body,
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	if err := c.gqlClient.Do(apiCtx, addCommentMutation, variables, &response); err != nil {
+		c.debugLog("Failed to comment on %s: %v", subjectNodeID, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("add_comment", err)
+		}
+		err = errors.APIError("add_comment", "failed to add comment", err)
+		return errors.WithContextSafe(err, "node_id", subjectNodeID)
+	}
+
+	c.debugLog("Added comment %s to %s", response.AddComment.CommentEdge.Node.ID, subjectNodeID)
+	return nil
+}
+
+// autoMergeUnavailableMarkers are fragments of GitHub error messages returned when auto-merge
+// cannot be enabled because of repository settings rather than a transient failure
+var autoMergeUnavailableMarkers = []string{
+	"auto merge is not allowed",
+	"auto-merge is not allowed",
+	"clean status",
+	"protected branch",
+	"branch protection",
+}
+
+// EnablePullRequestAutoMerge enables auto-merge on a pull request so that it merges once its
+// requirements are met. method is MERGE, SQUASH or REBASE; an empty method uses the repository default.
+func (c *GHClient) EnablePullRequestAutoMerge(ctx context.Context, prNodeID, method string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("enable_auto_merge", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(prNodeID) == "" {
+		return errors.ValidationError("enable_auto_merge", "pull request node ID cannot be empty")
+	}
+
+	mutationVariables := map[string]interface{}{
+		"pullRequestId": prNodeID,
+	}
+
+	if method != "" {
+		method = strings.ToUpper(method)
+		switch method {
+		case "MERGE", "SQUASH", "REBASE":
+			mutationVariables["mergeMethod"] = method
+		default:
+			return errors.ValidationError("enable_auto_merge", fmt.Sprintf("invalid auto-merge method '%s' (must be MERGE, SQUASH or REBASE)", method))
+		}
+	}
+
+	c.debugLog("Enabling auto-merge on pull request %s (method: %s)", prNodeID, method)
+
+	var mutationResponse struct {
+		EnablePullRequestAutoMerge struct {
+			PullRequest struct {
+				ID string `json:"id"`
+			} `json:"pullRequest"`
+		} `json:"enablePullRequestAutoMerge"`
+	}
+
+	mergeCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(mergeCtx, enablePullRequestAutoMergeMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		c.debugLog("Failed to enable auto-merge: %v", err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("enable_auto_merge", err)
+		}
+		message := strings.ToLower(err.Error())
+		for _, marker := range autoMergeUnavailableMarkers {
+			if strings.Contains(message, marker) {
+				return errors.APIError("enable_auto_merge",
+					"auto-merge is not available: the repository must allow auto-merge and the base branch must be protected with required checks", err)
+			}
+		}
+		return errors.APIError("enable_auto_merge", "failed to enable auto-merge", err)
+	}
+
+	c.debugLog("Successfully enabled auto-merge on pull request %s", prNodeID)
+	return nil
+}
+
+// GetProjectV2 retrieves project information by project ID.
+// This is useful for verifying project existence and getting project details.
+func (c *GHClient) GetProjectV2(ctx context.Context, projectID string) (*types.ProjectV2, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("get_project", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(projectID) == "" {
+		return nil, errors.ValidationError("get_project", "project ID cannot be empty")
+	}
+
+	c.debugLog("Retrieving ProjectV2 %s", projectID)
+
+	var queryResponse struct {
+		Node struct {
+			ID          string `json:"id"`
+			Number      int    `json:"number"`
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+		} `json:"node"`
+	}
+
+	queryVariables := map[string]interface{}{
+		"projectId": projectID,
 	}
 
-	createCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	queryCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
-	err := c.gqlClient.Do(createCtx, createProjectV2SingleSelectFieldMutation, mutationVariables, &mutationResponse)
+	err := c.gqlClient.Do(queryCtx, getProjectV2Query, queryVariables, &queryResponse)
 	if err != nil {
-		return errors.APIError("create_single_select_field", fmt.Sprintf("failed to create single select field '%s'", field.Name), err)
+		c.debugLog("Failed to retrieve ProjectV2: %v", err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_project", err)
+		}
+		return nil, errors.APIError("get_project", "failed to retrieve project", err)
 	}
 
-	c.debugLog("Successfully created single select field: %s with %d options", field.Name, len(options))
-	return nil
+	if queryResponse.Node.ID == "" {
+		return nil, errors.ValidationError("validate_project", "project not found")
+	}
+
+	project := &types.ProjectV2{
+		NodeID:      queryResponse.Node.ID,
+		ID:          queryResponse.Node.ID,
+		Number:      queryResponse.Node.Number,
+		Title:       queryResponse.Node.Title,
+		Description: queryResponse.Node.Description,
+		URL:         queryResponse.Node.URL,
+	}
+
+	c.debugLog("Successfully retrieved ProjectV2 '%s'", project.Title)
+	return project, nil
 }
 
-// UpdateProjectV2Description updates the description of an existing ProjectV2.
-func (c *GHClient) UpdateProjectV2Description(ctx context.Context, projectID, description string) error {
+// GetProjectV2ByNumber retrieves a ProjectV2 owned by the project owner by its project number.
+func (c *GHClient) GetProjectV2ByNumber(ctx context.Context, number int) (*types.ProjectV2, error) {
 	if c.gqlClient == nil {
-		return errors.ValidationError("update_project_description", "GraphQL client is not initialized")
+		return nil, errors.ValidationError("get_project_by_number", "GraphQL client is not initialized")
 	}
 
-	if strings.TrimSpace(description) == "" {
-		c.debugLog("No description to update for project")
-		return nil
+	if number <= 0 {
+		return nil, errors.ValidationError("get_project_by_number", "project number must be positive")
 	}
 
-	c.debugLog("Updating ProjectV2 description")
+	c.debugLog("Retrieving ProjectV2 #%d for %s", number, c.projectOwnerLogin())
 
-	var mutationResponse struct {
-		UpdateProjectV2 struct {
+	var queryResponse struct {
+		RepositoryOwner struct {
 			ProjectV2 struct {
 				ID          string `json:"id"`
+				Number      int    `json:"number"`
+				Title       string `json:"title"`
 				Description string `json:"description"`
+				URL         string `json:"url"`
 			} `json:"projectV2"`
-		} `json:"updateProjectV2"`
+		} `json:"repositoryOwner"`
 	}
 
-	mutationVariables := map[string]interface{}{
-		"projectId":   projectID,
-		"description": description,
+	queryVariables := map[string]interface{}{
+		"owner":  c.projectOwnerLogin(),
+		"number": number,
 	}
 
-	updateCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	queryCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
-	err := c.gqlClient.Do(updateCtx, updateProjectV2Mutation, mutationVariables, &mutationResponse)
+	err := c.gqlClient.Do(queryCtx, getProjectV2ByNumberQuery, queryVariables, &queryResponse)
 	if err != nil {
-		return errors.APIError("update_project_description", "failed to update project description", err)
+		c.debugLog("Failed to retrieve ProjectV2 #%d: %v", number, err)
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("get_project_by_number", err)
+		}
+		err = errors.APIError("get_project_by_number", "failed to retrieve project", err)
+		return nil, errors.WithContextSafe(err, "number", strconv.Itoa(number))
 	}
 
-	c.debugLog("Successfully updated ProjectV2 description")
-	return nil
+	if queryResponse.RepositoryOwner.ProjectV2.ID == "" {
+		err := errors.ValidationError("validate_project", fmt.Sprintf("project #%d not found for owner %s", number, c.projectOwnerLogin()))
+		return nil, errors.WithContextSafe(err, "number", strconv.Itoa(number))
+	}
+
+	found := queryResponse.RepositoryOwner.ProjectV2
+	project := &types.ProjectV2{
+		NodeID:      found.ID,
+		ID:          found.ID,
+		Number:      found.Number,
+		Title:       found.Title,
+		Description: found.Description,
+		URL:         found.URL,
+	}
+
+	c.debugLog("Resolved ProjectV2 #%d to '%s' (%s)", number, project.Title, project.ID)
+	return project, nil
 }
 
-// getRepositoryOwnerID retrieves the node ID for the repository owner (needed for project creation).
-func (c *GHClient) getRepositoryOwnerID(ctx context.Context) (string, error) {
-	var ownerResponse struct {
+// FindProjectV2ByTitle finds an open ProjectV2 owned by the project owner whose title matches exactly.
+// It returns nil without an error when no project matches.
+func (c *GHClient) FindProjectV2ByTitle(ctx context.Context, title string) (*types.ProjectV2, error) {
+	if c.gqlClient == nil {
+		return nil, errors.ValidationError("find_project", "GraphQL client is not initialized")
+	}
+
+	if strings.TrimSpace(title) == "" {
+		return nil, errors.ValidationError("find_project", "project title cannot be empty")
+	}
+
+	c.debugLog("Searching for ProjectV2 '%s' owned by %s", title, c.projectOwnerLogin())
+
+	type projectNodes struct {
+		Nodes []struct {
+			ID          string `json:"id"`
+			Number      int    `json:"number"`
+			Title       string `json:"title"`
+			Description string `json:"description"`
+			URL         string `json:"url"`
+			Closed      bool   `json:"closed"`
+		} `json:"nodes"`
+	}
+	var queryResponse struct {
 		RepositoryOwner struct {
-			ID string `json:"id"`
+			ProjectsV2 projectNodes `json:"projectsV2"`
 		} `json:"repositoryOwner"`
 	}
 
-	ownerVariables := map[string]interface{}{
-		"owner": c.Owner,
+	queryVariables := map[string]interface{}{
+		"owner": c.projectOwnerLogin(),
+		"query": title,
 	}
 
-	ownerCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	queryCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
-	err := c.gqlClient.Do(ownerCtx, getRepositoryOwnerIdQuery, ownerVariables, &ownerResponse)
+	err := c.gqlClient.Do(queryCtx, findProjectsV2Query, queryVariables, &queryResponse)
 	if err != nil {
-		c.debugLog("Failed to fetch repository owner ID: %v", err)
+		c.debugLog("Failed to search for ProjectV2 '%s': %v", title, err)
 		if errors.IsContextError(err) {
-			return "", errors.ContextError("get_repository_owner_id", err)
+			return nil, errors.ContextError("find_project", err)
 		}
-		return "", errors.APIError("get_repository_owner_id", "failed to fetch repository owner ID", err)
+		err = errors.APIError("find_project", "failed to search for project", err)
+		return nil, errors.WithContextSafe(err, "title", title)
 	}
 
-	if ownerResponse.RepositoryOwner.ID == "" {
-		return "", errors.ValidationError("validate_repository_owner", "repository owner not found")
-	}
-
-	c.debugLog("Retrieved owner ID %s for %s", ownerResponse.RepositoryOwner.ID, c.Owner)
-	return ownerResponse.RepositoryOwner.ID, nil
+	// The search is fuzzy, so only an exact title match counts as the same project
+	for _, node := range queryResponse.RepositoryOwner.ProjectsV2.Nodes {
+		if node.Title != title || node.Closed {
+			continue
+		}
+		c.debugLog("Found existing ProjectV2 '%s' (Number: %d)", node.Title, node.Number)
+		return &types.ProjectV2{
+			NodeID:      node.ID,
+			ID:          node.ID,
+			Number:      node.Number,
+			Title:       node.Title,
+			Description: node.Description,
+			URL:         node.URL,
+		}, nil
+	}
+
+	c.debugLog("No open ProjectV2 titled '%s' found", title)
+	return nil, nil
 }
 
-// AddItemToProjectV2 adds an item (issue, PR, discussion) to a ProjectV2 by item node ID.
-// The item must be a valid GitHub content item with a node ID.
-func (c *GHClient) AddItemToProjectV2(ctx context.Context, projectID, itemNodeID string) error {
+// ListProjectV2Items lists every item on a ProjectV2 board, following pagination.
+func (c *GHClient) ListProjectV2Items(ctx context.Context, projectID string) ([]types.ProjectV2Item, error) {
 	if c.gqlClient == nil {
-		return errors.ValidationError("add_item_to_project", "GraphQL client is not initialized")
+		return nil, errors.ValidationError("list_project_items", "GraphQL client is not initialized")
 	}
 
 	if strings.TrimSpace(projectID) == "" {
-		return errors.ValidationError("add_item_to_project", "project ID cannot be empty")
+		return nil, errors.ValidationError("list_project_items", "project ID cannot be empty")
 	}
 
-	if strings.TrimSpace(itemNodeID) == "" {
-		return errors.ValidationError("add_item_to_project", "item node ID cannot be empty")
+	c.debugLog("Fetching items of ProjectV2 %s", projectID)
+
+	var allItems []types.ProjectV2Item
+	var cursor *string
+
+	for page := 1; ; page++ {
+		var response struct {
+			Node struct {
+				Items struct {
+					Nodes []struct {
+						ID      string `json:"id"`
+						Content struct {
+							Title string `json:"title"`
+						} `json:"content"`
+					} `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool    `json:"hasNextPage"`
+						EndCursor   *string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"items"`
+			} `json:"node"`
+		}
+
+		variables := map[string]interface{}{
+			"projectId": projectID,
+			"first":     100,
+		}
+		if cursor != nil {
+			variables["after"] = *cursor
+		}
+
+		apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+		err := c.gqlClient.Do(apiCtx, listProjectV2ItemsQuery, variables, &response)
+		cancel()
+		if err != nil {
+			c.debugLog("Failed to fetch items of ProjectV2 %s: %v", projectID, err)
+			if errors.IsContextError(err) {
+				return nil, errors.ContextError("list_project_items", err)
+			}
+			err = errors.APIError("list_project_items", "failed to fetch project items", err)
+			return nil, errors.WithContextSafe(err, "project_id", projectID)
+		}
+
+		for _, item := range response.Node.Items.Nodes {
+			allItems = append(allItems, types.ProjectV2Item{ID: item.ID, Title: item.Content.Title})
+		}
+
+		if !response.Node.Items.PageInfo.HasNextPage {
+			break
+		}
+		if page >= c.pageLimit() {
+			c.warnPageLimit("project items", page, len(allItems))
+			break
+		}
+		cursor = response.Node.Items.PageInfo.EndCursor
 	}
 
-	c.debugLog("Adding item %s to ProjectV2 %s", itemNodeID, projectID)
+	c.debugLog("Successfully fetched %d items of ProjectV2 %s", len(allItems), projectID)
+	return allItems, nil
+}
 
-	var mutationResponse struct {
-		AddProjectV2ItemById struct {
-			Item struct {
-				ID string `json:"id"`
-			} `json:"item"`
-		} `json:"addProjectV2ItemById"`
+// ClearProjectV2Items removes every item from a ProjectV2 board. The project, its fields and the
+// underlying issues and pull requests are kept. All items are listed before any is removed so that
+// deletions do not shift the pages being read. A failed removal does not stop the others; the
+// failures are returned together.
+func (c *GHClient) ClearProjectV2Items(ctx context.Context, projectID string) error {
+	items, err := c.ListProjectV2Items(ctx, projectID)
+	if err != nil {
+		return err
 	}
 
-	mutationVariables := map[string]interface{}{
+	collector := errors.NewErrorCollector("clear_project_items")
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return errors.ContextError("clear_project_items", err)
+		}
+		if err := c.deleteProjectV2Item(ctx, projectID, item); err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			collector.Add(err)
+		}
+	}
+
+	c.debugLog("Cleared %d items from ProjectV2 %s", len(items), projectID)
+	return collector.Result()
+}
+
+// deleteProjectV2Item removes a single item from a ProjectV2 board
+func (c *GHClient) deleteProjectV2Item(ctx context.Context, projectID string, item types.ProjectV2Item) error {
+	c.debugLog("Removing item %s ('%s') from ProjectV2 %s", item.ID, item.Title, projectID)
+
+	var response struct {
+		DeleteProjectV2Item struct {
+			DeletedItemID string `json:"deletedItemId"`
+		} `json:"deleteProjectV2Item"`
+	}
+
+	variables := map[string]interface{}{
 		"projectId": projectID,
-		"contentId": itemNodeID,
+		"itemId":    item.ID,
 	}
 
-	addCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
-	err := c.gqlClient.Do(addCtx, addProjectV2ItemByIdMutation, mutationVariables, &mutationResponse)
-	if err != nil {
-		c.debugLog("Failed to add item to ProjectV2: %v", err)
+	if err := c.gqlClient.Do(apiCtx, deleteProjectV2ItemMutation, variables, &response); err != nil {
+		c.debugLog("Failed to remove item %s from ProjectV2: %v", item.ID, err)
 		if errors.IsContextError(err) {
-			return errors.ContextError("add_item_to_project", err)
+			return errors.ContextError("delete_project_item", err)
 		}
-		return errors.APIError("add_item_to_project", "failed to add item to project", err)
+		err = errors.APIError("delete_project_item", "failed to remove item from project", err)
+		err = errors.WithContextSafe(err, "item_id", item.ID)
+		return errors.WithContextSafe(err, "title", item.Title)
 	}
 
-	if mutationResponse.AddProjectV2ItemById.Item.ID == "" {
-		c.debugLog("Item addition failed - no item ID returned")
-		return errors.APIError("add_item_to_project", "item addition failed - no item ID returned from GitHub API", nil)
+	return nil
+}
+
+// DeleteProjectV2 deletes a ProjectV2 board together with its fields and items. The issues and pull
+// requests the items refer to are kept.
+func (c *GHClient) DeleteProjectV2(ctx context.Context, projectID string) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("delete_project", "GraphQL client is not initialized")
 	}
 
-	c.debugLog("Successfully added item %s to ProjectV2", itemNodeID)
+	if strings.TrimSpace(projectID) == "" {
+		return errors.ValidationError("delete_project", "project ID cannot be empty")
+	}
+
+	c.debugLog("Deleting ProjectV2 %s", projectID)
+
+	var response struct {
+		DeleteProjectV2 struct {
+			ProjectV2 struct {
+				ID string `json:"id"`
+			} `json:"projectV2"`
+		} `json:"deleteProjectV2"`
+	}
+
+	variables := map[string]interface{}{
+		"projectId": projectID,
+	}
+
+	apiCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	if err := c.gqlClient.Do(apiCtx, deleteProjectV2Mutation, variables, &response); err != nil {
+		c.debugLog("Failed to delete ProjectV2 %s: %v", projectID, err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("delete_project", err)
+		}
+		err = errors.APIError("delete_project", "failed to delete project", err)
+		return errors.WithContextSafe(err, "project_id", projectID)
+	}
+
+	c.debugLog("Deleted ProjectV2 %s", projectID)
 	return nil
 }
 
-// GetProjectV2 retrieves project information by project ID.
-// This is useful for verifying project existence and getting project details.
-func (c *GHClient) GetProjectV2(ctx context.Context, projectID string) (*types.ProjectV2, error) {
+// ListProjectV2Fields lists the fields of a ProjectV2. Field types are returned in the lowercase
+// form used by project configuration files (text, number, date, single_select, ...).
+func (c *GHClient) ListProjectV2Fields(ctx context.Context, projectID string) ([]types.ProjectV2Field, error) {
 	if c.gqlClient == nil {
-		return nil, errors.ValidationError("get_project", "GraphQL client is not initialized")
+		return nil, errors.ValidationError("list_project_fields", "GraphQL client is not initialized")
 	}
 
 	if strings.TrimSpace(projectID) == "" {
-		return nil, errors.ValidationError("get_project", "project ID cannot be empty")
+		return nil, errors.ValidationError("list_project_fields", "project ID cannot be empty")
 	}
 
-	c.debugLog("Retrieving ProjectV2 %s", projectID)
+	c.debugLog("Listing fields of ProjectV2 %s", projectID)
 
 	var queryResponse struct {
 		Node struct {
-			ID          string `json:"id"`
-			Number      int    `json:"number"`
-			Title       string `json:"title"`
-			Description string `json:"description"`
-			URL         string `json:"url"`
+			Fields struct {
+				Nodes []struct {
+					ID       string `json:"id"`
+					Name     string `json:"name"`
+					DataType string `json:"dataType"`
+					Options  []struct {
+						ID          string `json:"id"`
+						Name        string `json:"name"`
+						Description string `json:"description"`
+						Color       string `json:"color"`
+					} `json:"options"`
+				} `json:"nodes"`
+			} `json:"fields"`
 		} `json:"node"`
 	}
 
@@ -1730,31 +3642,86 @@ func (c *GHClient) GetProjectV2(ctx context.Context, projectID string) (*types.P
 		"projectId": projectID,
 	}
 
-	queryCtx, cancel := context.WithTimeout(ctx, config.APITimeout)
+	queryCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
 	defer cancel()
 
-	err := c.gqlClient.Do(queryCtx, getProjectV2Query, queryVariables, &queryResponse)
+	err := c.gqlClient.Do(queryCtx, listProjectV2FieldsQuery, queryVariables, &queryResponse)
 	if err != nil {
-		c.debugLog("Failed to retrieve ProjectV2: %v", err)
+		c.debugLog("Failed to list ProjectV2 fields: %v", err)
 		if errors.IsContextError(err) {
-			return nil, errors.ContextError("get_project", err)
+			return nil, errors.ContextError("list_project_fields", err)
 		}
-		return nil, errors.APIError("get_project", "failed to retrieve project", err)
+		return nil, errors.APIError("list_project_fields", "failed to list project fields", err)
 	}
 
-	if queryResponse.Node.ID == "" {
-		return nil, errors.ValidationError("validate_project", "project not found")
+	var fields []types.ProjectV2Field
+	for _, node := range queryResponse.Node.Fields.Nodes {
+		if node.ID == "" {
+			continue
+		}
+		field := types.ProjectV2Field{
+			ID:   node.ID,
+			Name: node.Name,
+			Type: strings.ToLower(node.DataType),
+		}
+		for _, option := range node.Options {
+			field.Options = append(field.Options, types.ProjectV2FieldOption{
+				ID:          option.ID,
+				Name:        option.Name,
+				Description: option.Description,
+				Color:       option.Color,
+			})
+		}
+		fields = append(fields, field)
 	}
 
-	project := &types.ProjectV2{
-		NodeID:      queryResponse.Node.ID,
-		ID:          queryResponse.Node.ID,
-		Number:      queryResponse.Node.Number,
-		Title:       queryResponse.Node.Title,
-		Description: queryResponse.Node.Description,
-		URL:         queryResponse.Node.URL,
+	c.debugLog("Found %d fields on ProjectV2 %s", len(fields), projectID)
+	return fields, nil
+}
+
+// UpdateProjectV2FieldOptions replaces the options of a single select field. GitHub replaces the
+// whole option list, so callers adding options must include the existing ones as well.
+func (c *GHClient) UpdateProjectV2FieldOptions(ctx context.Context, fieldID string, options []types.ProjectV2FieldOption) error {
+	if c.gqlClient == nil {
+		return errors.ValidationError("update_project_field", "GraphQL client is not initialized")
 	}
 
-	c.debugLog("Successfully retrieved ProjectV2 '%s'", project.Title)
-	return project, nil
+	if strings.TrimSpace(fieldID) == "" {
+		return errors.ValidationError("update_project_field", "field ID cannot be empty")
+	}
+
+	if len(options) == 0 {
+		return errors.ValidationError("update_project_field", "single_select fields must have at least one option")
+	}
+
+	c.debugLog("Updating options of ProjectV2 field %s (%d options)", fieldID, len(options))
+
+	var mutationResponse struct {
+		UpdateProjectV2Field struct {
+			ProjectV2Field struct {
+				ID string `json:"id"`
+			} `json:"projectV2Field"`
+		} `json:"updateProjectV2Field"`
+	}
+
+	mutationVariables := map[string]interface{}{
+		"fieldId": fieldID,
+		"options": c.singleSelectOptionsInput(options),
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, c.requestTimeout())
+	defer cancel()
+
+	err := c.gqlClient.Do(updateCtx, updateProjectV2FieldOptionsMutation, mutationVariables, &mutationResponse)
+	if err != nil {
+		c.debugLog("Failed to update ProjectV2 field options: %v", err)
+		if errors.IsContextError(err) {
+			return errors.ContextError("update_project_field", err)
+		}
+		err = errors.APIError("update_project_field", "failed to update project field options", err)
+		return errors.WithContextSafe(err, "field_id", fieldID)
+	}
+
+	c.debugLog("Successfully updated options of ProjectV2 field %s", fieldID)
+	return nil
 }
@@ -2,15 +2,20 @@ package githubapi
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/chrisreddington/gh-demo/internal/config"
 	customErrors "github.com/chrisreddington/gh-demo/internal/errors"
 	"github.com/chrisreddington/gh-demo/internal/testutil"
 	"github.com/chrisreddington/gh-demo/internal/types"
+	"github.com/cli/go-gh/v2/pkg/api"
 )
 
 // Tests for GHClient
@@ -172,6 +177,72 @@ func TestCreateDiscussion(t *testing.T) {
 	}
 }
 
+// TestCreateDiscussion_CategoryCaseMismatch tests that a category matched ignoring case is reported and an exact match is not
+func TestCreateDiscussion_CategoryCaseMismatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		category         string
+		expectedCategory string
+		expectedMessage  string
+	}{
+		{
+			name:             "case mismatch is reported",
+			category:         "general",
+			expectedCategory: "DC_1",
+			expectedMessage:  "Discussion 'Welcome': matched 'general' to category 'General' ignoring case",
+		},
+		{
+			name:             "exact match is silent",
+			category:         "General",
+			expectedCategory: "DC_1",
+		},
+		{
+			name:             "exact match is preferred over a case variant",
+			category:         "ideas",
+			expectedCategory: "DC_3",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var categoryID interface{}
+			logger := &testutil.MockLogger{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					switch operationName(query) {
+					case "GetDiscussionCategories":
+						return json.Unmarshal([]byte(`{"repository":{"id":"R_1","discussionCategories":{"nodes":[{"id":"DC_1","name":"General"},{"id":"DC_2","name":"Ideas"},{"id":"DC_3","name":"ideas"}]}}}`), response)
+					case "CreateDiscussion":
+						categoryID = variables["input"].(map[string]interface{})["categoryId"]
+						return json.Unmarshal([]byte(`{"createDiscussion":{"discussion":{"id":"D_1","number":1,"title":"Welcome","url":"https://github.com/testowner/testrepo/discussions/1"}}}`), response)
+					}
+					return fmt.Errorf("unexpected query: %s", query)
+				}},
+				logger: logger,
+			}
+
+			_, err := client.CreateDiscussion(context.Background(), types.Discussion{Title: "Welcome", Body: "Body", Category: tt.category})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if categoryID != tt.expectedCategory {
+				t.Errorf("Expected category ID %s, got %v", tt.expectedCategory, categoryID)
+			}
+			infoLog := strings.Join(logger.InfoCalls, "\n")
+			if tt.expectedMessage == "" {
+				if strings.Contains(infoLog, "ignoring case") {
+					t.Errorf("Expected no case mismatch message, got: %v", logger.InfoCalls)
+				}
+			} else if !strings.Contains(infoLog, tt.expectedMessage) {
+				t.Errorf("Expected message %q, got: %v", tt.expectedMessage, logger.InfoCalls)
+			}
+		})
+	}
+}
+
 func TestCreateDiscussion_CategoryNotFound(t *testing.T) {
 	gqlClient := &testutil.SimpleMockGraphQLClient{
 		DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
@@ -220,6 +291,38 @@ func TestCreateDiscussion_CategoryNotFound(t *testing.T) {
 	}
 }
 
+// TestCreateDiscussion_NoCategories tests that a repository without categories gets its own error
+// instead of "category not found"
+func TestCreateDiscussion_NoCategories(t *testing.T) {
+	mutationCalled := false
+	client := &GHClient{
+		Owner: "testowner",
+		Repo:  "testrepo",
+		gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+			if strings.Contains(query, "createDiscussion") {
+				mutationCalled = true
+			}
+			return json.Unmarshal([]byte(`{"repository":{"id":"R_1","discussionCategories":{"nodes":[]}}}`), response)
+		}},
+		logger: &MockLogger{},
+	}
+
+	_, err := client.CreateDiscussion(context.Background(), types.Discussion{Title: "Test Discussion", Category: "General"})
+
+	if err == nil {
+		t.Fatal("Expected an error for a repository without categories")
+	}
+	if !strings.Contains(err.Error(), "the repository has Discussions enabled but no categories defined") {
+		t.Errorf("Expected the no categories message, got: %v", err)
+	}
+	if strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected no 'not found' wording, got: %v", err)
+	}
+	if mutationCalled {
+		t.Error("Expected no createDiscussion mutation")
+	}
+}
+
 func TestCreateDiscussion_GraphQLError(t *testing.T) {
 	gqlClient := &testutil.SimpleMockGraphQLClient{
 		DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
@@ -522,7 +625,7 @@ func TestDebugLog(t *testing.T) {
 	}
 }
 
-// TestCreateDiscussionWithLabels tests the addLabelToDiscussion function through CreateDiscussion
+// TestCreateDiscussionWithLabels tests the addLabelsToDiscussion function through CreateDiscussion
 func TestCreateDiscussionWithLabels(t *testing.T) {
 	gqlClient := &testutil.SimpleMockGraphQLClient{
 		DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
@@ -563,7 +666,7 @@ func TestCreateDiscussionWithLabels(t *testing.T) {
 				resp.CreateDiscussion.Discussion.Title = "Test Discussion"
 				resp.CreateDiscussion.Discussion.URL = "https://github.com/testowner/testrepo/discussions/1"
 			} else if strings.Contains(query, "label(name:") {
-				// Label query for addLabelToDiscussion
+				// Label query for addLabelsToDiscussion
 				resp := response.(*struct {
 					Repository struct {
 						Label struct {
@@ -602,7 +705,7 @@ func TestCreateDiscussionWithLabels(t *testing.T) {
 	}
 }
 
-// TestAddLabelToDiscussion_LabelNotFound tests error handling in addLabelToDiscussion
+// TestAddLabelToDiscussion_LabelNotFound tests error handling in addLabelsToDiscussion
 func TestAddLabelToDiscussion_LabelNotFound(t *testing.T) {
 	gqlClient := &testutil.SimpleMockGraphQLClient{
 		DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
@@ -657,13 +760,15 @@ func TestAddLabelToDiscussion_LabelNotFound(t *testing.T) {
 		},
 	}
 
+	logger := &testutil.MockLogger{}
 	client := &GHClient{
 		Owner:     "testowner",
 		Repo:      "testrepo",
 		gqlClient: gqlClient,
+		logger:    logger,
 	}
 
-	// This should still succeed, but the label addition will fail silently
+	// This should still succeed, with a warning that the label could not be added
 	_, err := client.CreateDiscussion(context.Background(), types.Discussion{
 		Title:    "Test Discussion",
 		Body:     "This is a test discussion",
@@ -673,6 +778,9 @@ func TestAddLabelToDiscussion_LabelNotFound(t *testing.T) {
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
+	if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "could not add labels to discussion 'Test Discussion'") {
+		t.Errorf("Expected a warning about the missing label, got: %v", logger.InfoCalls)
+	}
 }
 
 // TestAddLabelToDiscussion_GraphQLError tests GraphQL error handling
@@ -741,6 +849,81 @@ func TestAddLabelToDiscussion_GraphQLError(t *testing.T) {
 	}
 }
 
+// TestCreateDiscussion_BulkLabels tests that discussion labels are added with a single mutation
+func TestCreateDiscussion_BulkLabels(t *testing.T) {
+	tests := []struct {
+		name             string
+		labels           []string
+		existing         map[string]string
+		expectedMutation int
+		expectedLabelIDs []string
+	}{
+		{
+			name:             "three labels add in one mutation",
+			labels:           []string{"bug", "enhancement", "question"},
+			existing:         map[string]string{"bug": "L_1", "enhancement": "L_2", "question": "L_3"},
+			expectedMutation: 1,
+			expectedLabelIDs: []string{"L_1", "L_2", "L_3"},
+		},
+		{
+			name:             "unresolved labels are skipped",
+			labels:           []string{"bug", "missing", "question"},
+			existing:         map[string]string{"bug": "L_1", "question": "L_3"},
+			expectedMutation: 1,
+			expectedLabelIDs: []string{"L_1", "L_3"},
+		},
+		{
+			name:             "no resolved labels skips the mutation",
+			labels:           []string{"missing"},
+			existing:         map[string]string{},
+			expectedMutation: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var addLabelCalls []interface{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					switch operationName(query) {
+					case "GetDiscussionCategories":
+						return json.Unmarshal([]byte(`{"repository":{"id":"R_1","discussionCategories":{"nodes":[{"id":"DC_1","name":"General"}]}}}`), response)
+					case "CreateDiscussion":
+						return json.Unmarshal([]byte(`{"createDiscussion":{"discussion":{"id":"D_1","number":1,"title":"Test Discussion","url":"https://github.com/testowner/testrepo/discussions/1"}}}`), response)
+					case "GetLabelId":
+						id := tt.existing[variables["labelName"].(string)]
+						return json.Unmarshal([]byte(fmt.Sprintf(`{"repository":{"label":{"id":%q}}}`, id)), response)
+					case "AddLabelsToLabelable":
+						input := variables["input"].(map[string]interface{})
+						addLabelCalls = append(addLabelCalls, input["labelIds"])
+					}
+					return nil
+				}},
+				logger: &MockLogger{},
+			}
+
+			_, err := client.CreateDiscussion(context.Background(), types.Discussion{
+				Title:    "Test Discussion",
+				Body:     "This is a test discussion",
+				Category: "General",
+				Labels:   tt.labels,
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(addLabelCalls) != tt.expectedMutation {
+				t.Fatalf("Expected %d add-labels mutations, got %d", tt.expectedMutation, len(addLabelCalls))
+			}
+			if tt.expectedMutation > 0 && !reflect.DeepEqual(addLabelCalls[0], tt.expectedLabelIDs) {
+				t.Errorf("Expected label IDs %v, got %v", tt.expectedLabelIDs, addLabelCalls[0])
+			}
+		})
+	}
+}
+
 // TestCreatePR_ValidationErrors tests CreatePR validation error paths
 func TestCreatePR_ValidationErrors(t *testing.T) {
 	gqlClient := &testutil.SimpleMockGraphQLClient{
@@ -1211,6 +1394,70 @@ func TestDeleteDiscussion_GraphQLError(t *testing.T) {
 	}
 }
 
+// TestCloseDiscussion tests closing a discussion with a valid and an invalid close reason
+func TestCloseDiscussion(t *testing.T) {
+	tests := []struct {
+		name           string
+		nodeID         string
+		reason         string
+		expectError    bool
+		errorText      string
+		expectedReason string
+	}{
+		{name: "valid reason", nodeID: "D_1", reason: "OUTDATED", expectedReason: "OUTDATED"},
+		{name: "reason is case insensitive", nodeID: "D_1", reason: " resolved ", expectedReason: "RESOLVED"},
+		{name: "invalid reason", nodeID: "D_1", reason: "ANSWERED", expectError: true, errorText: "invalid close reason 'ANSWERED'"},
+		{name: "empty node ID", nodeID: " ", reason: "OUTDATED", expectError: true, errorText: "node ID cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := &GHClient{
+				Owner:  "testowner",
+				Repo:   "testrepo",
+				logger: &MockLogger{},
+				gqlClient: &testutil.SimpleMockGraphQLClient{
+					DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+						calls++
+						if operationName(query) != "CloseDiscussion" {
+							t.Errorf("Expected CloseDiscussion mutation, got %s", operationName(query))
+						}
+						if variables["discussionId"] != tt.nodeID {
+							t.Errorf("Expected discussionId %q, got %v", tt.nodeID, variables["discussionId"])
+						}
+						if variables["reason"] != tt.expectedReason {
+							t.Errorf("Expected reason %q, got %v", tt.expectedReason, variables["reason"])
+						}
+						return nil
+					},
+				},
+			}
+
+			err := client.CloseDiscussion(context.Background(), tt.nodeID, tt.reason)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorText) {
+					t.Errorf("Expected error to contain %q, got: %v", tt.errorText, err)
+				}
+				if calls != 0 {
+					t.Errorf("Expected no GraphQL call on validation error, got %d", calls)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if calls != 1 {
+				t.Errorf("Expected 1 GraphQL call, got %d", calls)
+			}
+		})
+	}
+}
+
 // TestListIssues tests the ListIssues function
 func TestListIssues(t *testing.T) {
 	tests := []struct {
@@ -1240,6 +1487,11 @@ func TestListIssues(t *testing.T) {
 												Name string `json:"name"`
 											} `json:"nodes"`
 										} `json:"labels"`
+										Assignees struct {
+											Nodes []struct {
+												Login string `json:"login"`
+											} `json:"nodes"`
+										} `json:"assignees"`
 									} `json:"nodes"`
 									PageInfo struct {
 										HasNextPage bool    `json:"hasNextPage"`
@@ -1262,6 +1514,11 @@ func TestListIssues(t *testing.T) {
 										Name string `json:"name"`
 									} `json:"nodes"`
 								} `json:"labels"`
+								Assignees struct {
+									Nodes []struct {
+										Login string `json:"login"`
+									} `json:"nodes"`
+								} `json:"assignees"`
 							}{
 								{
 									ID:     "issue1",
@@ -1293,6 +1550,11 @@ func TestListIssues(t *testing.T) {
 										Name string `json:"name"`
 									} `json:"nodes"`
 								} `json:"labels"`
+								Assignees struct {
+									Nodes []struct {
+										Login string `json:"login"`
+									} `json:"nodes"`
+								} `json:"assignees"`
 							}{
 								{
 									ID:     "issue2",
@@ -1338,6 +1600,11 @@ func TestListIssues(t *testing.T) {
 												Name string `json:"name"`
 											} `json:"nodes"`
 										} `json:"labels"`
+										Assignees struct {
+											Nodes []struct {
+												Login string `json:"login"`
+											} `json:"nodes"`
+										} `json:"assignees"`
 									} `json:"nodes"`
 									PageInfo struct {
 										HasNextPage bool    `json:"hasNextPage"`
@@ -1357,6 +1624,11 @@ func TestListIssues(t *testing.T) {
 									Name string `json:"name"`
 								} `json:"nodes"`
 							} `json:"labels"`
+							Assignees struct {
+								Nodes []struct {
+									Login string `json:"login"`
+								} `json:"nodes"`
+							} `json:"assignees"`
 						}{}
 						resp.Repository.Issues.PageInfo.HasNextPage = false
 						resp.Repository.Issues.PageInfo.EndCursor = nil
@@ -1461,9 +1733,15 @@ func TestListDiscussions(t *testing.T) {
 										Number   int    `json:"number"`
 										Title    string `json:"title"`
 										Body     string `json:"body"`
+										Closed   bool   `json:"closed"`
 										Category struct {
 											Name string `json:"name"`
 										} `json:"category"`
+										Labels struct {
+											Nodes []struct {
+												Name string `json:"name"`
+											} `json:"nodes"`
+										} `json:"labels"`
 									} `json:"nodes"`
 									PageInfo struct {
 										HasNextPage bool    `json:"hasNextPage"`
@@ -1478,9 +1756,15 @@ func TestListDiscussions(t *testing.T) {
 							Number   int    `json:"number"`
 							Title    string `json:"title"`
 							Body     string `json:"body"`
+							Closed   bool   `json:"closed"`
 							Category struct {
 								Name string `json:"name"`
 							} `json:"category"`
+							Labels struct {
+								Nodes []struct {
+									Name string `json:"name"`
+								} `json:"nodes"`
+							} `json:"labels"`
 						}{
 							{
 								ID:     "discussion1",
@@ -1492,6 +1776,9 @@ func TestListDiscussions(t *testing.T) {
 								}{Name: "General"},
 							},
 						}
+						resp.Repository.Discussions.Nodes[0].Labels.Nodes = []struct {
+							Name string `json:"name"`
+						}{{Name: "demo"}}
 						resp.Repository.Discussions.PageInfo.HasNextPage = false
 						resp.Repository.Discussions.PageInfo.EndCursor = nil
 						return nil
@@ -1545,6 +1832,9 @@ func TestListDiscussions(t *testing.T) {
 			if len(discussions) != tt.expectedCount {
 				t.Errorf("Expected %d discussions, got %d", tt.expectedCount, len(discussions))
 			}
+			if tt.expectedCount > 0 && (len(discussions[0].Labels) != 1 || discussions[0].Labels[0] != "demo") {
+				t.Errorf("Expected discussion labels [demo], got %v", discussions[0].Labels)
+			}
 		})
 	}
 }
@@ -1994,3 +2284,2040 @@ func TestDeleteLabel(t *testing.T) {
 		})
 	}
 }
+
+// TestUpdateLabel tests that a label is looked up by name and updated with the defined color and description
+func TestUpdateLabel(t *testing.T) {
+	tests := []struct {
+		name          string
+		labelName     string
+		labelResponse string
+		updateErr     error
+		expectError   string
+		expectUpdate  bool
+	}{
+		{
+			name:          "label updated",
+			labelName:     "docs",
+			labelResponse: `{"repository":{"label":{"id":"LA_1"}}}`,
+			expectUpdate:  true,
+		},
+		{
+			name:        "empty label name",
+			expectError: "label name cannot be empty",
+		},
+		{
+			name:          "label not found",
+			labelName:     "docs",
+			labelResponse: `{"repository":{"label":null}}`,
+			expectError:   "label 'docs' not found in repository",
+		},
+		{
+			name:          "update rejected",
+			labelName:     "docs",
+			labelResponse: `{"repository":{"label":{"id":"LA_1"}}}`,
+			updateErr:     fmt.Errorf("invalid color"),
+			expectError:   "failed to update label 'docs'",
+			expectUpdate:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := false
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					switch operationName(query) {
+					case "GetLabelByName":
+						if variables["labelName"] != tt.labelName {
+							t.Errorf("Expected label name %q, got %v", tt.labelName, variables["labelName"])
+						}
+						return json.Unmarshal([]byte(tt.labelResponse), response)
+					case "UpdateLabel":
+						updated = true
+						if variables["labelId"] != "LA_1" || variables["color"] != "0075ca" || variables["description"] != "Documentation" {
+							t.Errorf("Unexpected update variables: %v", variables)
+						}
+						if tt.updateErr != nil {
+							return tt.updateErr
+						}
+						return json.Unmarshal([]byte(`{"updateLabel":{"label":{"id":"LA_1","name":"docs","color":"0075ca","description":"Documentation"}}}`), response)
+					}
+					return fmt.Errorf("unexpected query: %s", query)
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.UpdateLabel(context.Background(), tt.labelName, types.Label{Name: "docs", Color: "0075ca", Description: "Documentation"})
+
+			if tt.expectError == "" && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			if tt.expectError != "" && (err == nil || !strings.Contains(err.Error(), tt.expectError)) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+			}
+			if updated != tt.expectUpdate {
+				t.Errorf("Expected update %v, got %v", tt.expectUpdate, updated)
+			}
+		})
+	}
+}
+
+// TestGetViewer tests retrieving the authenticated user and repository permission
+func TestGetViewer(t *testing.T) {
+	tests := []struct {
+		name               string
+		doFunc             func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error
+		expectError        bool
+		errorText          string
+		expectedLogin      string
+		expectedPermission string
+	}{
+		{
+			name: "successful lookup",
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				if !strings.Contains(query, "viewer") {
+					return fmt.Errorf("unexpected query: %s", query)
+				}
+				if variables["owner"] != "testowner" || variables["name"] != "testrepo" {
+					return fmt.Errorf("unexpected variables: %v", variables)
+				}
+				resp := response.(*struct {
+					Viewer struct {
+						Login string `json:"login"`
+					} `json:"viewer"`
+					Repository struct {
+						ViewerPermission string `json:"viewerPermission"`
+					} `json:"repository"`
+				})
+				resp.Viewer.Login = "octocat"
+				resp.Repository.ViewerPermission = "ADMIN"
+				return nil
+			},
+			expectedLogin:      "octocat",
+			expectedPermission: "ADMIN",
+		},
+		{
+			name: "authentication error",
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				return fmt.Errorf("HTTP 401: Bad credentials")
+			},
+			expectError: true,
+			errorText:   "failed to fetch authenticated user",
+		},
+		{
+			name: "empty login",
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				return nil
+			},
+			expectError: true,
+			errorText:   "authenticated user not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner:     "testowner",
+				Repo:      "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: tt.doFunc},
+				logger:    &MockLogger{},
+			}
+
+			viewer, err := client.GetViewer(context.Background())
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorText) {
+					t.Errorf("Expected error to contain %q, got %q", tt.errorText, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if viewer.Login != tt.expectedLogin {
+				t.Errorf("Expected login %q, got %q", tt.expectedLogin, viewer.Login)
+			}
+			if viewer.RepositoryPermission != tt.expectedPermission {
+				t.Errorf("Expected permission %q, got %q", tt.expectedPermission, viewer.RepositoryPermission)
+			}
+		})
+	}
+}
+
+// TestGetProjectV2ByNumber tests resolving a project number to a project
+func TestGetProjectV2ByNumber(t *testing.T) {
+	tests := []struct {
+		name        string
+		number      int
+		doFunc      func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error
+		expectError bool
+		errorText   string
+		expectedID  string
+	}{
+		{
+			name:   "project found",
+			number: 3,
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				if variables["owner"] != "testowner" || variables["number"] != 3 {
+					return fmt.Errorf("unexpected variables: %v", variables)
+				}
+				return json.Unmarshal([]byte(`{"repositoryOwner":{"projectV2":{"id":"PVT_3","number":3,"title":"Roadmap","url":"https://github.com/users/testowner/projects/3"}}}`), response)
+			},
+			expectedID: "PVT_3",
+		},
+		{
+			name:   "project not found",
+			number: 9,
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				return nil
+			},
+			expectError: true,
+			errorText:   "project #9 not found",
+		},
+		{
+			name:        "invalid number",
+			number:      0,
+			expectError: true,
+			errorText:   "project number must be positive",
+		},
+		{
+			name:   "API error",
+			number: 1,
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				return fmt.Errorf("network failure")
+			},
+			expectError: true,
+			errorText:   "failed to retrieve project",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner:     "testowner",
+				Repo:      "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: tt.doFunc},
+				logger:    &MockLogger{},
+			}
+
+			project, err := client.GetProjectV2ByNumber(context.Background(), tt.number)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorText) {
+					t.Errorf("Expected error to contain %q, got %q", tt.errorText, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if project.ID != tt.expectedID || project.Number != tt.number {
+				t.Errorf("Expected project %s (#%d), got %s (#%d)", tt.expectedID, tt.number, project.ID, project.Number)
+			}
+		})
+	}
+}
+
+// TestEnablePullRequestAutoMerge tests enabling auto-merge and the errors GitHub returns when it is unavailable
+func TestEnablePullRequestAutoMerge(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		doFunc         func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error
+		expectError    bool
+		errorText      string
+		expectedMethod interface{}
+	}{
+		{
+			name:   "auto-merge enabled with squash",
+			method: "squash",
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				if variables["pullRequestId"] != "PR_1" {
+					return fmt.Errorf("unexpected variables: %v", variables)
+				}
+				return json.Unmarshal([]byte(`{"enablePullRequestAutoMerge":{"pullRequest":{"id":"PR_1"}}}`), response)
+			},
+			expectedMethod: "SQUASH",
+		},
+		{
+			name:   "repository without branch protection",
+			method: "",
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				return fmt.Errorf("GraphQL: Pull request Pull request is in clean status (enablePullRequestAutoMerge)")
+			},
+			expectError: true,
+			errorText:   "base branch must be protected",
+		},
+		{
+			name:   "repository with auto-merge disabled",
+			method: "MERGE",
+			doFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+				return fmt.Errorf("GraphQL: Pull request Auto merge is not allowed for this repository (enablePullRequestAutoMerge)")
+			},
+			expectError: true,
+			errorText:   "repository must allow auto-merge",
+		},
+		{
+			name:        "invalid method",
+			method:      "fast-forward",
+			expectError: true,
+			errorText:   "invalid auto-merge method",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotVariables map[string]interface{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					gotVariables = variables
+					return tt.doFunc(ctx, query, variables, response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.EnablePullRequestAutoMerge(context.Background(), "PR_1", tt.method)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorText) {
+					t.Errorf("Expected error to contain %q, got %q", tt.errorText, err.Error())
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if gotVariables["mergeMethod"] != tt.expectedMethod {
+				t.Errorf("Expected merge method %v, got %v", tt.expectedMethod, gotVariables["mergeMethod"])
+			}
+		})
+	}
+}
+
+// TestFindProjectV2ByTitle tests that only an open project with an exact title match is returned
+func TestFindProjectV2ByTitle(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    string
+		doErr       error
+		expectError bool
+		expectedID  string
+	}{
+		{
+			name:       "exact match among fuzzy results",
+			response:   `{"repositoryOwner":{"projectsV2":{"nodes":[{"id":"PVT_1","title":"Demo Board v2"},{"id":"PVT_2","number":2,"title":"Demo Board"}]}}}`,
+			expectedID: "PVT_2",
+		},
+		{
+			name:     "closed project is ignored",
+			response: `{"repositoryOwner":{"projectsV2":{"nodes":[{"id":"PVT_1","title":"Demo Board","closed":true}]}}}`,
+		},
+		{
+			name:     "no projects",
+			response: `{"repositoryOwner":{"projectsV2":{"nodes":[]}}}`,
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					if variables["query"] != "Demo Board" {
+						return fmt.Errorf("unexpected variables: %v", variables)
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			project, err := client.FindProjectV2ByTitle(context.Background(), "Demo Board")
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.expectedID == "" {
+				if project != nil {
+					t.Errorf("Expected no project, got %+v", project)
+				}
+				return
+			}
+			if project == nil || project.ID != tt.expectedID {
+				t.Errorf("Expected project %s, got %+v", tt.expectedID, project)
+			}
+		})
+	}
+}
+
+// TestListProjectV2Fields tests converting project fields and their options
+func TestListProjectV2Fields(t *testing.T) {
+	client := &GHClient{
+		Owner: "testowner",
+		Repo:  "testrepo",
+		gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"node":{"fields":{"nodes":[
+				{"id":"F1","name":"Title","dataType":"TITLE"},
+				{"id":"F2","name":"Status","dataType":"SINGLE_SELECT","options":[{"id":"O1","name":"Todo","color":"GRAY"}]},
+				{}
+			]}}}`), response)
+		}},
+		logger: &MockLogger{},
+	}
+
+	fields, err := client.ListProjectV2Fields(context.Background(), "PVT_1")
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(fields) != 2 {
+		t.Fatalf("Expected 2 fields, got %v", fields)
+	}
+	if fields[1].Type != "single_select" || len(fields[1].Options) != 1 || fields[1].Options[0].ID != "O1" {
+		t.Errorf("Expected single select field with option O1, got %+v", fields[1])
+	}
+}
+
+// TestUpdateProjectV2FieldOptions tests that every option is sent with a valid color and description
+func TestUpdateProjectV2FieldOptions(t *testing.T) {
+	var sent []map[string]interface{}
+	client := &GHClient{
+		Owner: "testowner",
+		Repo:  "testrepo",
+		gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+			sent = variables["options"].([]map[string]interface{})
+			return nil
+		}},
+		logger: &MockLogger{},
+	}
+
+	err := client.UpdateProjectV2FieldOptions(context.Background(), "F2", []types.ProjectV2FieldOption{
+		{Name: "Todo", Color: "GRAY", Description: "Not started"},
+		{Name: "Done", Color: "not-a-color"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(sent) != 2 {
+		t.Fatalf("Expected 2 options sent, got %v", sent)
+	}
+	if sent[1]["color"] != "GRAY" || sent[1]["description"] != "Done" {
+		t.Errorf("Expected defaults applied to second option, got %v", sent[1])
+	}
+
+	if err := client.UpdateProjectV2FieldOptions(context.Background(), "F2", nil); err == nil {
+		t.Error("Expected error for empty options")
+	}
+}
+
+// TestGetRateLimit tests reading the remaining rate limit budget
+func TestGetRateLimit(t *testing.T) {
+	tests := []struct {
+		name              string
+		response          string
+		doErr             error
+		expectError       bool
+		expectedRemaining int
+	}{
+		{
+			name:              "rate limit returned",
+			response:          `{"rateLimit":{"limit":5000,"remaining":4321,"cost":1,"resetAt":"2025-01-01T12:00:00Z"}}`,
+			expectedRemaining: 4321,
+		},
+		{
+			name:        "rate limit missing from response",
+			response:    `{}`,
+			expectError: true,
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			rateLimit, err := client.GetRateLimit(context.Background())
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if rateLimit.Remaining != tt.expectedRemaining || rateLimit.Limit != 5000 {
+				t.Errorf("Expected %d/5000 remaining, got %+v", tt.expectedRemaining, rateLimit)
+			}
+			if rateLimit.ResetAt.IsZero() {
+				t.Error("Expected reset time to be parsed")
+			}
+		})
+	}
+}
+
+// TestClearProjectV2Items tests removing every item from a project across pages
+func TestClearProjectV2Items(t *testing.T) {
+	pages := map[string]string{
+		"":        `{"node":{"items":{"nodes":[{"id":"PVTI_1","content":{"title":"First"}},{"id":"PVTI_2","content":{"title":"Second"}}],"pageInfo":{"hasNextPage":true,"endCursor":"cursor1"}}}}`,
+		"cursor1": `{"node":{"items":{"nodes":[{"id":"PVTI_3","content":{"title":"Draft"}}],"pageInfo":{"hasNextPage":false}}}}`,
+	}
+
+	tests := []struct {
+		name            string
+		failItem        string
+		expectError     bool
+		expectedDeleted []string
+	}{
+		{
+			name:            "clears items from every page",
+			expectedDeleted: []string{"PVTI_1", "PVTI_2", "PVTI_3"},
+		},
+		{
+			name:            "per-item failure is collected and the rest are removed",
+			failItem:        "PVTI_2",
+			expectError:     true,
+			expectedDeleted: []string{"PVTI_1", "PVTI_3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var deleted []string
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if strings.Contains(query, "ListProjectV2Items") {
+						after, _ := variables["after"].(string)
+						return json.Unmarshal([]byte(pages[after]), response)
+					}
+					itemID := variables["itemId"].(string)
+					if itemID == tt.failItem {
+						return fmt.Errorf("item not found")
+					}
+					deleted = append(deleted, itemID)
+					return nil
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.ClearProjectV2Items(context.Background(), "PVT_1")
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), "failed to remove item from project") {
+					t.Errorf("Expected removal failure in error, got: %v", err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if strings.Join(deleted, ",") != strings.Join(tt.expectedDeleted, ",") {
+				t.Errorf("Expected items %v to be removed, got %v", tt.expectedDeleted, deleted)
+			}
+		})
+	}
+}
+
+// TestClearProjectV2Items_Validation tests input validation
+func TestClearProjectV2Items_Validation(t *testing.T) {
+	client := &GHClient{Owner: "testowner", Repo: "testrepo", gqlClient: &testutil.SimpleMockGraphQLClient{}, logger: &MockLogger{}}
+
+	if err := client.ClearProjectV2Items(context.Background(), " "); err == nil {
+		t.Error("Expected error for empty project ID")
+	}
+}
+
+// TestGetRepositoryFeatures tests reading which repository features are enabled
+func TestGetRepositoryFeatures(t *testing.T) {
+	tests := []struct {
+		name             string
+		response         string
+		doErr            error
+		expectError      bool
+		expectedFeatures types.RepositoryFeatures
+	}{
+		{
+			name:             "features returned",
+			response:         `{"repository":{"hasIssuesEnabled":true,"hasDiscussionsEnabled":false,"hasProjectsEnabled":true}}`,
+			expectedFeatures: types.RepositoryFeatures{HasIssuesEnabled: true, HasProjectsEnabled: true},
+		},
+		{
+			name:             "archived repository",
+			response:         `{"repository":{"hasIssuesEnabled":true,"hasDiscussionsEnabled":true,"hasProjectsEnabled":true,"isArchived":true,"isLocked":false}}`,
+			expectedFeatures: types.RepositoryFeatures{HasIssuesEnabled: true, HasDiscussionsEnabled: true, HasProjectsEnabled: true, IsArchived: true},
+		},
+		{
+			name:        "repository not found",
+			response:    `{"repository":null}`,
+			expectError: true,
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			features, err := client.GetRepositoryFeatures(context.Background())
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if *features != tt.expectedFeatures {
+				t.Errorf("Expected features %+v, got %+v", tt.expectedFeatures, *features)
+			}
+		})
+	}
+}
+
+// TestGetDefaultBranch tests reading the repository's default branch
+func TestGetDefaultBranch(t *testing.T) {
+	tests := []struct {
+		name           string
+		response       string
+		doErr          error
+		expectError    bool
+		errorText      string
+		expectedBranch string
+	}{
+		{
+			name:           "default branch returned",
+			response:       `{"repository":{"defaultBranchRef":{"name":"trunk"}}}`,
+			expectedBranch: "trunk",
+		},
+		{
+			name:        "empty repository has no default branch",
+			response:    `{"repository":{"defaultBranchRef":null}}`,
+			expectError: true,
+			errorText:   "has no default branch",
+		},
+		{
+			name:        "repository not found",
+			response:    `{"repository":null}`,
+			expectError: true,
+			errorText:   "not found",
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+			errorText:   "failed to fetch default branch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			branch, err := client.GetDefaultBranch(context.Background())
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorText) {
+					t.Errorf("Expected error to contain %q, got: %v", tt.errorText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if branch != tt.expectedBranch {
+				t.Errorf("Expected branch %q, got %q", tt.expectedBranch, branch)
+			}
+		})
+	}
+}
+
+// TestListLabelsDetailed tests that labels are returned with their color and description across pages
+func TestListLabelsDetailed(t *testing.T) {
+	pages := []string{
+		`{"repository":{"labels":{"nodes":[{"name":"bug","color":"d73a4a","description":"Something isn't working"}],"pageInfo":{"hasNextPage":true,"endCursor":"cursor1"}}}}`,
+		`{"repository":{"labels":{"nodes":[{"name":"docs","color":"0075ca","description":""}],"pageInfo":{"hasNextPage":false,"endCursor":null}}}}`,
+	}
+
+	tests := []struct {
+		name           string
+		doErr          error
+		maxPages       int
+		expectError    bool
+		expectedLabels []types.Label
+	}{
+		{
+			name: "all pages are fetched",
+			expectedLabels: []types.Label{
+				{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+				{Name: "docs", Color: "0075ca"},
+			},
+		},
+		{
+			name:           "page limit stops pagination",
+			maxPages:       1,
+			expectedLabels: []types.Label{{Name: "bug", Color: "d73a4a", Description: "Something isn't working"}},
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cursors []interface{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					cursors = append(cursors, variables["after"])
+					return json.Unmarshal([]byte(pages[len(cursors)-1]), response)
+				}},
+				logger: &MockLogger{},
+			}
+			client.SetMaxPages(tt.maxPages)
+
+			labels, err := client.ListLabelsDetailed(context.Background())
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(labels, tt.expectedLabels) {
+				t.Errorf("Expected labels %+v, got %+v", tt.expectedLabels, labels)
+			}
+			if len(cursors) > 1 && cursors[1] != "cursor1" {
+				t.Errorf("Expected second page to be requested after cursor1, got %v", cursors[1])
+			}
+		})
+	}
+}
+
+// TestListIssueTemplates tests reading Markdown issue templates from the repository
+func TestListIssueTemplates(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      string
+		doErr         error
+		expectError   bool
+		expectedFiles []string
+	}{
+		{
+			name: "markdown templates are parsed and other entries ignored",
+			response: `{"repository":{"object":{"entries":[
+				{"name":"bug_report.md","type":"blob","object":{"text":"---\nname: Bug\nlabels: bug\n---\n"}},
+				{"name":"config.yml","type":"blob","object":{"text":"blank_issues_enabled: false"}},
+				{"name":"form.yml","type":"blob","object":{"text":"name: Form"}},
+				{"name":"nested","type":"tree","object":{}},
+				{"name":"image.md","type":"blob","object":{"text":null}}
+			]}}}`,
+			expectedFiles: []string{"bug_report.md"},
+		},
+		{
+			name:          "missing directory has no templates",
+			response:      `{"repository":{"object":null}}`,
+			expectedFiles: nil,
+		},
+		{
+			name:        "repository not found",
+			response:    `{"repository":null}`,
+			expectError: true,
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			templates, err := client.ListIssueTemplates(context.Background())
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var files []string
+			for _, template := range templates {
+				files = append(files, template.File)
+			}
+			if !reflect.DeepEqual(files, tt.expectedFiles) {
+				t.Errorf("Expected templates %v, got %v", tt.expectedFiles, files)
+			}
+			if len(templates) == 1 && !reflect.DeepEqual(templates[0].Labels, []string{"bug"}) {
+				t.Errorf("Expected template labels [bug], got %v", templates[0].Labels)
+			}
+		})
+	}
+}
+
+// TestGetCodeowners tests reading the CODEOWNERS file from the locations GitHub looks in
+func TestGetCodeowners(t *testing.T) {
+	tests := []struct {
+		name           string
+		response       string
+		doErr          error
+		expectError    bool
+		expectedOwners []string
+	}{
+		{
+			name:           ".github takes precedence over the root",
+			response:       `{"repository":{"github":{"text":"* @octocat"},"root":{"text":"* @other"},"docs":null}}`,
+			expectedOwners: []string{"octocat"},
+		},
+		{
+			name:           "docs is used when it is the only file",
+			response:       `{"repository":{"github":null,"root":null,"docs":{"text":"* @writer"}}}`,
+			expectedOwners: []string{"writer"},
+		},
+		{
+			name:     "missing file has no rules",
+			response: `{"repository":{"github":null,"root":null,"docs":null}}`,
+		},
+		{
+			name:        "repository not found",
+			response:    `{"repository":null}`,
+			expectError: true,
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			rules, err := client.GetCodeowners(context.Background())
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var owners []string
+			for _, rule := range rules {
+				owners = append(owners, rule.Owners...)
+			}
+			if !reflect.DeepEqual(owners, tt.expectedOwners) {
+				t.Errorf("Expected owners %v, got %v", tt.expectedOwners, owners)
+			}
+		})
+	}
+}
+
+// TestRequestReviews tests that users and teams are resolved and requested as reviewers
+func TestRequestReviews(t *testing.T) {
+	tests := []struct {
+		name          string
+		reviewers     []string
+		expectError   string
+		expectedUsers []string
+		expectedTeams []string
+	}{
+		{
+			name:          "users and teams are requested",
+			reviewers:     []string{"octocat", "my-org/reviewers"},
+			expectedUsers: []string{"U_octocat"},
+			expectedTeams: []string{"T_my-org/reviewers"},
+		},
+		{
+			name:          "missing reviewers are skipped",
+			reviewers:     []string{"octocat", "ghost"},
+			expectedUsers: []string{"U_octocat"},
+		},
+		{
+			name:        "no reviewers found",
+			reviewers:   []string{"ghost", "my-org/ghosts"},
+			expectError: "none of the reviewers could be found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutationVariables map[string]interface{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					switch {
+					case strings.Contains(query, "GetUserId"):
+						if variables["login"] == "ghost" {
+							return json.Unmarshal([]byte(`{"user":{"id":""}}`), response)
+						}
+						return json.Unmarshal([]byte(fmt.Sprintf(`{"user":{"id":"U_%s"}}`, variables["login"])), response)
+					case strings.Contains(query, "GetTeamId"):
+						if variables["slug"] == "ghosts" {
+							return json.Unmarshal([]byte(`{"organization":{"team":null}}`), response)
+						}
+						return json.Unmarshal([]byte(fmt.Sprintf(`{"organization":{"team":{"id":"T_%s/%s"}}}`, variables["org"], variables["slug"])), response)
+					case strings.Contains(query, "RequestReviews"):
+						mutationVariables = variables
+						return json.Unmarshal([]byte(`{"requestReviews":{"pullRequest":{"id":"PR_1"}}}`), response)
+					}
+					return fmt.Errorf("unexpected query: %s", query)
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.RequestReviews(context.Background(), "PR_1", tt.reviewers)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if users, _ := mutationVariables["userIds"].([]string); !reflect.DeepEqual(users, tt.expectedUsers) {
+				t.Errorf("Expected user IDs %v, got %v", tt.expectedUsers, mutationVariables["userIds"])
+			}
+			if teams, _ := mutationVariables["teamIds"].([]string); !reflect.DeepEqual(teams, tt.expectedTeams) {
+				t.Errorf("Expected team IDs %v, got %v", tt.expectedTeams, mutationVariables["teamIds"])
+			}
+		})
+	}
+}
+
+// TestAddPullRequestReview tests submitting reviews and rejecting invalid events
+func TestAddPullRequestReview(t *testing.T) {
+	tests := []struct {
+		name          string
+		body          string
+		event         string
+		expectError   string
+		expectedEvent string
+	}{
+		{
+			name:          "comment review",
+			body:          "Looks like a good start",
+			event:         "COMMENT",
+			expectedEvent: "COMMENT",
+		},
+		{
+			name:          "approve review without a body",
+			event:         "approve",
+			expectedEvent: "APPROVE",
+		},
+		{
+			name:        "invalid event",
+			body:        "Ship it",
+			event:       "LGTM",
+			expectError: "invalid review event 'LGTM'",
+		},
+		{
+			name:        "comment review without a body",
+			event:       "COMMENT",
+			expectError: "a COMMENT review requires a body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutationVariables map[string]interface{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if !strings.Contains(query, "AddPullRequestReview") {
+						return fmt.Errorf("unexpected query: %s", query)
+					}
+					mutationVariables = variables
+					return json.Unmarshal([]byte(`{"addPullRequestReview":{"pullRequestReview":{"id":"PRR_1"}}}`), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.AddPullRequestReview(context.Background(), "PR_1", tt.body, tt.event)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				if mutationVariables != nil {
+					t.Error("Expected no mutation for an invalid review")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if mutationVariables["event"] != tt.expectedEvent || mutationVariables["body"] != tt.body || mutationVariables["pullRequestId"] != "PR_1" {
+				t.Errorf("Unexpected mutation variables: %v", mutationVariables)
+			}
+		})
+	}
+}
+
+// TestListIssuePages tests that each page is passed on as it is fetched and that an error from the callback stops listing
+func TestListIssuePages(t *testing.T) {
+	pages := []string{
+		`{"repository":{"issues":{"nodes":[{"id":"I_1","number":1,"title":"One"},{"id":"I_2","number":2,"title":"Two"}],"pageInfo":{"hasNextPage":true,"endCursor":"c1"}}}}`,
+		`{"repository":{"issues":{"nodes":[{"id":"I_3","number":3,"title":"Three"}],"pageInfo":{"hasNextPage":false}}}}`,
+	}
+	stop := fmt.Errorf("stop listing")
+
+	tests := []struct {
+		name          string
+		callbackErr   error
+		expectedPages [][]string
+		expectedCalls int
+	}{
+		{
+			name:          "every page is passed on",
+			expectedPages: [][]string{{"One", "Two"}, {"Three"}},
+			expectedCalls: 2,
+		},
+		{
+			name:          "callback error stops listing",
+			callbackErr:   stop,
+			expectedPages: [][]string{{"One", "Two"}},
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					calls++
+					return json.Unmarshal([]byte(pages[calls-1]), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			var received [][]string
+			err := client.ListIssuePages(context.Background(), func(issues []types.Issue) error {
+				var titles []string
+				for _, issue := range issues {
+					titles = append(titles, issue.Title)
+				}
+				received = append(received, titles)
+				return tt.callbackErr
+			})
+
+			if err != tt.callbackErr {
+				t.Errorf("Expected error %v, got %v", tt.callbackErr, err)
+			}
+			if calls != tt.expectedCalls {
+				t.Errorf("Expected %d queries, got %d", tt.expectedCalls, calls)
+			}
+			if !reflect.DeepEqual(received, tt.expectedPages) {
+				t.Errorf("Expected pages %v, got %v", tt.expectedPages, received)
+			}
+		})
+	}
+}
+
+// TestUpdateIssue tests that the body, labels and assignees of an existing issue are replaced
+func TestUpdateIssue(t *testing.T) {
+	tests := []struct {
+		name                string
+		nodeID              string
+		issue               types.Issue
+		expectError         string
+		expectedTitle       interface{}
+		expectedLabelIDs    []string
+		expectedAssigneeIDs []string
+	}{
+		{
+			name:                "labels and assignees are resolved",
+			nodeID:              "I_1",
+			issue:               types.Issue{Title: "Bug", Body: "Updated body", Labels: []string{"bug"}, Assignees: []string{"octocat"}},
+			expectedTitle:       "Bug",
+			expectedLabelIDs:    []string{"L_bug"},
+			expectedAssigneeIDs: []string{"U_octocat"},
+		},
+		{
+			name:                "labels replace the current labels instead of being appended",
+			nodeID:              "I_1",
+			issue:               types.Issue{Title: "Renamed bug", Body: "Updated body", Labels: []string{"enhancement", "ui"}},
+			expectedTitle:       "Renamed bug",
+			expectedLabelIDs:    []string{"L_enhancement", "L_ui"},
+			expectedAssigneeIDs: []string{},
+		},
+		{
+			name:                "empty lists clear labels and assignees",
+			nodeID:              "I_1",
+			issue:               types.Issue{Title: "Bug", Body: "Updated body"},
+			expectedTitle:       "Bug",
+			expectedLabelIDs:    []string{},
+			expectedAssigneeIDs: []string{},
+		},
+		{
+			name:                "empty title keeps the current title",
+			nodeID:              "I_1",
+			issue:               types.Issue{Body: "Updated body"},
+			expectedLabelIDs:    []string{},
+			expectedAssigneeIDs: []string{},
+		},
+		{
+			name:        "empty node ID",
+			issue:       types.Issue{Title: "Bug"},
+			expectError: "issue node ID cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutationVariables map[string]interface{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					switch operationName(query) {
+					case "GetLabelId":
+						return json.Unmarshal([]byte(fmt.Sprintf(`{"repository":{"label":{"id":"L_%s"}}}`, variables["labelName"])), response)
+					case "GetUserId":
+						return json.Unmarshal([]byte(fmt.Sprintf(`{"user":{"id":"U_%s"}}`, variables["login"])), response)
+					case "UpdateIssue":
+						mutationVariables = variables
+						return json.Unmarshal([]byte(`{"updateIssue":{"issue":{"id":"I_1","number":7,"title":"Bug","url":"https://github.com/testowner/testrepo/issues/7"}}}`), response)
+					}
+					return fmt.Errorf("unexpected query: %s", query)
+				}},
+				logger: &MockLogger{},
+			}
+
+			info, err := client.UpdateIssue(context.Background(), tt.nodeID, tt.issue)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if info.Number != 7 || info.NodeID != "I_1" {
+				t.Errorf("Unexpected item info: %+v", info)
+			}
+			if mutationVariables["id"] != tt.nodeID || mutationVariables["body"] != tt.issue.Body {
+				t.Errorf("Unexpected mutation variables: %v", mutationVariables)
+			}
+			if mutationVariables["title"] != tt.expectedTitle {
+				t.Errorf("Expected title %v, got %v", tt.expectedTitle, mutationVariables["title"])
+			}
+			if !reflect.DeepEqual(mutationVariables["labelIds"], tt.expectedLabelIDs) {
+				t.Errorf("Expected label IDs %v, got %v", tt.expectedLabelIDs, mutationVariables["labelIds"])
+			}
+			if !reflect.DeepEqual(mutationVariables["assigneeIds"], tt.expectedAssigneeIDs) {
+				t.Errorf("Expected assignee IDs %v, got %v", tt.expectedAssigneeIDs, mutationVariables["assigneeIds"])
+			}
+		})
+	}
+}
+
+// TestCheckProjectAccess tests the project scope probe
+func TestCheckProjectAccess(t *testing.T) {
+	tests := []struct {
+		name             string
+		response         string
+		doErr            error
+		expectError      bool
+		expectPermission bool
+	}{
+		{
+			name:     "token can access projects",
+			response: `{"repositoryOwner":{"projectsV2":{"totalCount":2}}}`,
+		},
+		{
+			name:             "missing scope is a permission error",
+			doErr:            fmt.Errorf("GraphQL: Your token has not been granted the required scopes to execute this query. The 'projectsV2' field requires one of the following scopes: ['read:project']"),
+			expectError:      true,
+			expectPermission: true,
+		},
+		{
+			name:        "other failures are API errors",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+		{
+			name:        "owner not found",
+			response:    `{"repositoryOwner":null}`,
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if variables["owner"] != "testowner" {
+						t.Errorf("Expected owner variable 'testowner', got %v", variables["owner"])
+					}
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.CheckProjectAccess(context.Background())
+
+			if !tt.expectError {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			layeredErr := customErrors.AsLayeredError(err)
+			isPermission := layeredErr != nil && layeredErr.Context["type"] == "permission"
+			if isPermission != tt.expectPermission {
+				t.Errorf("Expected permission error %v, got: %v", tt.expectPermission, err)
+			}
+			if tt.expectPermission && !strings.Contains(err.Error(), "gh auth refresh -s project") {
+				t.Errorf("Expected scope guidance, got: %v", err)
+			}
+		})
+	}
+}
+
+// TestMarkIssueAsDuplicate tests commenting on and closing a duplicate issue
+func TestMarkIssueAsDuplicate(t *testing.T) {
+	tests := []struct {
+		name          string
+		issueNodeID   string
+		number        int
+		failOperation string
+		expectError   bool
+		expectedCalls []string
+	}{
+		{
+			name:          "comments then closes as duplicate",
+			issueNodeID:   "I_2",
+			number:        1,
+			expectedCalls: []string{"AddComment", "CloseIssueAsDuplicate"},
+		},
+		{
+			name:          "comment failure does not close the issue",
+			issueNodeID:   "I_2",
+			number:        1,
+			failOperation: "AddComment",
+			expectError:   true,
+			expectedCalls: []string{"AddComment"},
+		},
+		{
+			name:        "empty node ID",
+			number:      1,
+			expectError: true,
+		},
+		{
+			name:        "invalid original number",
+			issueNodeID: "I_2",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					name := operationName(query)
+					calls = append(calls, name)
+					if name == "AddComment" && variables["body"] != "Duplicate of #1" {
+						return fmt.Errorf("unexpected comment body: %v", variables["body"])
+					}
+					if name == tt.failOperation {
+						return fmt.Errorf("forbidden")
+					}
+					return nil
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.MarkIssueAsDuplicate(context.Background(), tt.issueNodeID, tt.number)
+
+			if tt.expectError && err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if strings.Join(calls, ",") != strings.Join(tt.expectedCalls, ",") {
+				t.Errorf("Expected calls %v, got %v", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+// TestListOperations_MaxPages tests that list loops stop at the page cap with a warning
+func TestListOperations_MaxPages(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		list     func(*GHClient) (int, error)
+		itemType string
+	}{
+		{
+			name:     "issues",
+			response: `{"repository":{"issues":{"nodes":[{"id":"I_1","title":"Issue"}],"pageInfo":{"hasNextPage":true,"endCursor":"next"}}}}`,
+			list: func(c *GHClient) (int, error) {
+				items, err := c.ListIssues(context.Background())
+				return len(items), err
+			},
+			itemType: "issues",
+		},
+		{
+			name:     "discussions",
+			response: `{"repository":{"discussions":{"nodes":[{"id":"D_1","title":"Discussion"}],"pageInfo":{"hasNextPage":true,"endCursor":"next"}}}}`,
+			list: func(c *GHClient) (int, error) {
+				items, err := c.ListDiscussions(context.Background())
+				return len(items), err
+			},
+			itemType: "discussions",
+		},
+		{
+			name:     "pull requests",
+			response: `{"repository":{"pullRequests":{"nodes":[{"id":"PR_1","title":"PR"}],"pageInfo":{"hasNextPage":true,"endCursor":"next"}}}}`,
+			list: func(c *GHClient) (int, error) {
+				items, err := c.ListPRs(context.Background())
+				return len(items), err
+			},
+			itemType: "pull requests",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			logger := &testutil.MockLogger{}
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					calls++
+					if calls > 10 {
+						return fmt.Errorf("pagination did not stop")
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: logger,
+			}
+			client.SetMaxPages(3)
+
+			count, err := tt.list(client)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if calls != 3 || count != 3 {
+				t.Errorf("Expected 3 pages and 3 items, got %d pages and %d items", calls, count)
+			}
+
+			expected := "Warning: stopped listing " + tt.itemType + " after 3 pages"
+			if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), expected) {
+				t.Errorf("Expected warning containing %q, got: %v", expected, logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestPageLimit tests the default page cap
+func TestPageLimit(t *testing.T) {
+	client := &GHClient{}
+	if client.pageLimit() != config.DefaultMaxPages {
+		t.Errorf("Expected default page limit %d, got %d", config.DefaultMaxPages, client.pageLimit())
+	}
+	client.SetMaxPages(5)
+	if client.pageLimit() != 5 {
+		t.Errorf("Expected page limit 5, got %d", client.pageLimit())
+	}
+}
+
+// TestGetIssue tests retrieving an issue by number
+func TestGetIssue(t *testing.T) {
+	tests := []struct {
+		name          string
+		number        int
+		response      string
+		expectError   bool
+		errorContains string
+		expectedIssue types.Issue
+	}{
+		{
+			name:     "issue found",
+			number:   7,
+			response: `{"repository":{"issue":{"id":"I_7","number":7,"title":"Bug","body":"Details","state":"CLOSED","labels":{"nodes":[{"name":"bug"},{"name":"p1"}]},"assignees":{"nodes":[{"login":"octocat"}]}}}}`,
+			expectedIssue: types.Issue{
+				NodeID:    "I_7",
+				Number:    7,
+				Title:     "Bug",
+				Body:      "Details",
+				State:     "CLOSED",
+				Labels:    []string{"bug", "p1"},
+				Assignees: []string{"octocat"},
+			},
+		},
+		{
+			name:          "issue not found",
+			number:        404,
+			response:      `{"repository":{"issue":null}}`,
+			expectError:   true,
+			errorContains: "issue #404 not found in repository testowner/testrepo",
+		},
+		{
+			name:          "invalid number",
+			number:        0,
+			expectError:   true,
+			errorContains: "issue number must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if variables["number"] != tt.number {
+						return fmt.Errorf("unexpected number: %v", variables["number"])
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			issue, err := client.GetIssue(context.Background(), tt.number)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("Expected error containing %q, got: %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(*issue, tt.expectedIssue) {
+				t.Errorf("Expected issue %+v, got %+v", tt.expectedIssue, *issue)
+			}
+		})
+	}
+}
+
+// TestGetPullRequest tests retrieving a pull request by number
+func TestGetPullRequest(t *testing.T) {
+	tests := []struct {
+		name          string
+		number        int
+		response      string
+		expectError   bool
+		errorContains string
+		expectedPR    types.PullRequest
+	}{
+		{
+			name:     "pull request found",
+			number:   3,
+			response: `{"repository":{"pullRequest":{"id":"PR_3","number":3,"title":"Feature","body":"Adds it","state":"MERGED","headRefName":"feature","baseRefName":"main","labels":{"nodes":[{"name":"enhancement"}]},"assignees":{"nodes":[]}}}}`,
+			expectedPR: types.PullRequest{
+				NodeID:    "PR_3",
+				Number:    3,
+				Title:     "Feature",
+				Body:      "Adds it",
+				State:     "MERGED",
+				Head:      "feature",
+				Base:      "main",
+				Labels:    []string{"enhancement"},
+				Assignees: []string{},
+			},
+		},
+		{
+			name:          "pull request not found",
+			number:        404,
+			response:      `{"repository":{"pullRequest":null}}`,
+			expectError:   true,
+			errorContains: "pull request #404 not found in repository testowner/testrepo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			pullRequest, err := client.GetPullRequest(context.Background(), tt.number)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				if !strings.Contains(err.Error(), tt.errorContains) {
+					t.Errorf("Expected error containing %q, got: %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(*pullRequest, tt.expectedPR) {
+				t.Errorf("Expected pull request %+v, got %+v", tt.expectedPR, *pullRequest)
+			}
+		})
+	}
+}
+
+// TestListBranches tests that only branches starting with the prefix are returned, with their protection
+func TestListBranches(t *testing.T) {
+	tests := []struct {
+		name             string
+		response         string
+		doErr            error
+		expectError      bool
+		expectedBranches []types.Branch
+	}{
+		{
+			name: "branches containing the prefix elsewhere are ignored",
+			response: `{"repository":{"refs":{"nodes":[
+				{"id":"REF_1","name":"demo/feature","branchProtectionRule":null},
+				{"id":"REF_2","name":"demo/release","branchProtectionRule":{"id":"BPR_1"}},
+				{"id":"REF_3","name":"old-demo/feature","branchProtectionRule":null}
+			],"pageInfo":{"hasNextPage":false,"endCursor":null}}}}`,
+			expectedBranches: []types.Branch{
+				{NodeID: "REF_1", Name: "demo/feature"},
+				{NodeID: "REF_2", Name: "demo/release", Protected: true},
+			},
+		},
+		{
+			name:        "API error",
+			doErr:       fmt.Errorf("network failure"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					if variables["query"] != "demo/" {
+						t.Errorf("Expected query variable 'demo/', got %v", variables["query"])
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			branches, err := client.ListBranches(context.Background(), "demo/")
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(branches, tt.expectedBranches) {
+				t.Errorf("Expected branches %+v, got %+v", tt.expectedBranches, branches)
+			}
+		})
+	}
+}
+
+// TestDeleteBranch tests deleting a branch by its ref ID
+func TestDeleteBranch(t *testing.T) {
+	tests := []struct {
+		name        string
+		refID       string
+		doErr       error
+		expectError bool
+	}{
+		{name: "successful deletion", refID: "REF_1"},
+		{name: "empty ref ID", refID: " ", expectError: true},
+		{name: "API error", refID: "REF_1", doErr: fmt.Errorf("network failure"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if variables["refId"] != tt.refID {
+						t.Errorf("Expected refId %q, got %v", tt.refID, variables["refId"])
+					}
+					return tt.doErr
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.DeleteBranch(context.Background(), tt.refID)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestAddComment tests adding a comment to an issue or pull request by node ID
+func TestAddComment(t *testing.T) {
+	tests := []struct {
+		name        string
+		nodeID      string
+		body        string
+		doErr       error
+		expectError bool
+	}{
+		{name: "successful comment", nodeID: "I_1", body: "Closed by gh-demo cleanup"},
+		{name: "empty node ID", nodeID: " ", body: "Closed", expectError: true},
+		{name: "empty body", nodeID: "I_1", body: " ", expectError: true},
+		{name: "API error", nodeID: "PR_1", body: "Closed", doErr: fmt.Errorf("network failure"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if operationName(query) != "AddComment" {
+						t.Errorf("Expected AddComment, got %s", operationName(query))
+					}
+					if variables["subjectId"] != tt.nodeID || variables["body"] != tt.body {
+						t.Errorf("Unexpected variables: %v", variables)
+					}
+					return tt.doErr
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.AddComment(context.Background(), tt.nodeID, tt.body)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestCreateOrUpdateFile tests committing a new file and updating an existing one against the branch head
+func TestCreateOrUpdateFile(t *testing.T) {
+	tests := []struct {
+		name            string
+		content         string
+		message         string
+		fileResponse    string
+		commitErr       error
+		expectError     string
+		expectCommit    bool
+		expectedMessage string
+	}{
+		{
+			name:            "new file is created",
+			content:         "# Demo",
+			fileResponse:    `{"repository":{"defaultBranchRef":{"name":"main","target":{"oid":"head-sha"}},"object":null}}`,
+			expectCommit:    true,
+			expectedMessage: "Add README.md",
+		},
+		{
+			name:            "existing file is updated against the branch head",
+			content:         "# New demo",
+			message:         "Refresh the README",
+			fileResponse:    `{"repository":{"defaultBranchRef":{"name":"main","target":{"oid":"head-sha"}},"object":{"oid":"fbeedc42fed2bfc07da2c95383e52acb1e1f4751"}}}`,
+			expectCommit:    true,
+			expectedMessage: "Refresh the README",
+		},
+		{
+			name:         "unchanged file is not committed",
+			content:      "# Demo",
+			fileResponse: `{"repository":{"defaultBranchRef":{"name":"main","target":{"oid":"head-sha"}},"object":{"oid":"fbeedc42fed2bfc07da2c95383e52acb1e1f4751"}}}`,
+		},
+		{
+			name:         "empty repository has no default branch",
+			content:      "# Demo",
+			fileResponse: `{"repository":{"defaultBranchRef":null,"object":null}}`,
+			expectError:  "has no default branch",
+		},
+		{
+			name:            "commit rejected after the branch moved",
+			content:         "# Demo",
+			fileResponse:    `{"repository":{"defaultBranchRef":{"name":"main","target":{"oid":"head-sha"}},"object":null}}`,
+			commitErr:       fmt.Errorf("expected branch to point to head-sha"),
+			expectError:     "failed to commit file 'README.md'",
+			expectCommit:    true,
+			expectedMessage: "Add README.md",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			committed := false
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					switch operationName(query) {
+					case "GetFile":
+						if variables["expression"] != "HEAD:README.md" {
+							t.Errorf("Expected expression HEAD:README.md, got %v", variables["expression"])
+						}
+						return json.Unmarshal([]byte(tt.fileResponse), response)
+					case "CreateCommitOnBranch":
+						committed = true
+						if variables["repository"] != "testowner/testrepo" || variables["branch"] != "main" || variables["path"] != "README.md" {
+							t.Errorf("Unexpected variables: %v", variables)
+						}
+						if variables["expectedHeadOid"] != "head-sha" {
+							t.Errorf("Expected expectedHeadOid head-sha, got %v", variables["expectedHeadOid"])
+						}
+						if variables["message"] != tt.expectedMessage {
+							t.Errorf("Expected message %q, got %v", tt.expectedMessage, variables["message"])
+						}
+						if contents, _ := base64.StdEncoding.DecodeString(variables["contents"].(string)); string(contents) != tt.content {
+							t.Errorf("Expected contents %q, got %q", tt.content, contents)
+						}
+						return tt.commitErr
+					}
+					t.Errorf("Unexpected operation %s", operationName(query))
+					return nil
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.CreateOrUpdateFile(context.Background(), "README.md", tt.content, tt.message)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if committed != tt.expectCommit {
+				t.Errorf("Expected commit %v, got %v", tt.expectCommit, committed)
+			}
+		})
+	}
+}
+
+// TestDeleteProjectV2 tests deleting a project by its node ID
+func TestDeleteProjectV2(t *testing.T) {
+	tests := []struct {
+		name        string
+		projectID   string
+		doErr       error
+		expectError bool
+	}{
+		{name: "successful deletion", projectID: "PVT_1"},
+		{name: "empty project ID", projectID: " ", expectError: true},
+		{name: "API error", projectID: "PVT_1", doErr: fmt.Errorf("network failure"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if operationName(query) != "DeleteProjectV2" {
+						t.Errorf("Expected DeleteProjectV2, got %s", operationName(query))
+					}
+					if variables["projectId"] != tt.projectID {
+						t.Errorf("Unexpected variables: %v", variables)
+					}
+					return tt.doErr
+				}},
+				logger: &MockLogger{},
+			}
+
+			err := client.DeleteProjectV2(context.Background(), tt.projectID)
+
+			if tt.expectError && err == nil {
+				t.Error("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestUserExists tests that a missing user is reported as not existing rather than as an error
+func TestUserExists(t *testing.T) {
+	tests := []struct {
+		name        string
+		response    string
+		doErr       error
+		expected    bool
+		expectError bool
+	}{
+		{name: "existing user", response: `{"user":{"id":"U_1"}}`, expected: true},
+		{
+			name:     "missing user",
+			doErr:    &api.GraphQLError{Errors: []api.GraphQLErrorItem{{Type: "NOT_FOUND", Message: "Could not resolve to a User with the login of 'ghost'."}}},
+			expected: false,
+		},
+		{name: "API error", doErr: fmt.Errorf("network failure"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if tt.doErr != nil {
+						return tt.doErr
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+
+			exists, err := client.UserExists(context.Background(), "octocat")
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if exists != tt.expected {
+				t.Errorf("Expected exists %v, got %v", tt.expected, exists)
+			}
+		})
+	}
+}
+
+// TestListDiscussionCategories tests that the category names of the repository are returned
+func TestListDiscussionCategories(t *testing.T) {
+	client := &GHClient{
+		Owner: "testowner",
+		Repo:  "testrepo",
+		gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+			return json.Unmarshal([]byte(`{"repository":{"id":"R_1","discussionCategories":{"nodes":[{"id":"DC_1","name":"General"},{"id":"DC_2","name":"Ideas"}]}}}`), response)
+		}},
+		logger: &MockLogger{},
+	}
+
+	categories, err := client.ListDiscussionCategories(context.Background())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(categories, []string{"General", "Ideas"}) {
+		t.Errorf("Expected categories [General Ideas], got %v", categories)
+	}
+}
+
+// TestGetProjectOwnerID tests that the project owner's ID is resolved, defaulting to the repository owner
+func TestGetProjectOwnerID(t *testing.T) {
+	tests := []struct {
+		name          string
+		projectOwner  string
+		response      string
+		expectedOwner string
+		expectedID    string
+		expectError   string
+	}{
+		{
+			name:          "repository owner by default",
+			response:      `{"repositoryOwner":{"id":"U_owner"}}`,
+			expectedOwner: "testowner",
+			expectedID:    "U_owner",
+		},
+		{
+			name:          "distinct project owner",
+			projectOwner:  " other-org ",
+			response:      `{"repositoryOwner":{"id":"O_other"}}`,
+			expectedOwner: "other-org",
+			expectedID:    "O_other",
+		},
+		{
+			name:          "project owner not found",
+			projectOwner:  "ghost",
+			response:      `{"repositoryOwner":null}`,
+			expectedOwner: "ghost",
+			expectError:   "project owner ghost not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &GHClient{
+				Owner: "testowner",
+				Repo:  "testrepo",
+				gqlClient: &testutil.SimpleMockGraphQLClient{DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					if variables["owner"] != tt.expectedOwner {
+						t.Errorf("Expected owner variable %q, got %v", tt.expectedOwner, variables["owner"])
+					}
+					return json.Unmarshal([]byte(tt.response), response)
+				}},
+				logger: &MockLogger{},
+			}
+			client.SetProjectOwner(tt.projectOwner)
+
+			id, err := client.GetProjectOwnerID(context.Background())
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if id != tt.expectedID {
+				t.Errorf("Expected ID %q, got %q", tt.expectedID, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package githubapi
+
+import (
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// parseCodeowners reads the rules of a CODEOWNERS file in file order. Comments, blank lines and
+// patterns without owners are skipped, and the "@" prefix of user and team owners is removed.
+func parseCodeowners(text string) []types.CodeownersRule {
+	var rules []types.CodeownersRule
+	for _, line := range strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n") {
+		if index := strings.Index(line, "#"); index >= 0 {
+			line = line[:index]
+		}
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		rule := types.CodeownersRule{Pattern: fields[0]}
+		for _, owner := range fields[1:] {
+			rule.Owners = append(rule.Owners, strings.TrimPrefix(owner, "@"))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
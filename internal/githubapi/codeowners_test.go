@@ -0,0 +1,23 @@
+package githubapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestParseCodeowners tests reading patterns and owners from a CODEOWNERS file
+func TestParseCodeowners(t *testing.T) {
+	text := "# Default owners\r\n* @octocat @my-org/reviewers\r\n\r\n/docs/ docs@example.com # writers\r\n*.go @gopher\r\n/unowned/\r\n"
+
+	expected := []types.CodeownersRule{
+		{Pattern: "*", Owners: []string{"octocat", "my-org/reviewers"}},
+		{Pattern: "/docs/", Owners: []string{"docs@example.com"}},
+		{Pattern: "*.go", Owners: []string{"gopher"}},
+	}
+
+	if rules := parseCodeowners(text); !reflect.DeepEqual(rules, expected) {
+		t.Errorf("Expected rules %+v, got %+v", expected, rules)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
 	"github.com/chrisreddington/gh-demo/internal/types"
 )
 
@@ -14,6 +15,8 @@ type GitHubClient interface {
 	// Creation operations
 	// ListLabels retrieves all existing labels from the repository
 	ListLabels(ctx context.Context) ([]string, error)
+	// ListLabelsDetailed retrieves all existing labels with their color and description
+	ListLabelsDetailed(ctx context.Context) ([]types.Label, error)
 	// CreateLabel creates a new label in the repository using the provided label data
 	CreateLabel(ctx context.Context, label types.Label) error
 	// CreateIssue creates a new issue and returns detailed information about the created item
@@ -22,27 +25,57 @@ type GitHubClient interface {
 	CreateDiscussion(ctx context.Context, discussion types.Discussion) (*types.CreatedItemInfo, error)
 	// CreatePR creates a new pull request and returns detailed information about the created item
 	CreatePR(ctx context.Context, pullRequest types.PullRequest) (*types.CreatedItemInfo, error)
+	// EnablePullRequestAutoMerge enables auto-merge on a pull request using the given merge method
+	EnablePullRequestAutoMerge(ctx context.Context, prNodeID, method string) error
+	// RequestReviews requests reviews on a pull request from users and "org/team" teams
+	RequestReviews(ctx context.Context, prNodeID string, reviewers []string) error
+	// AddPullRequestReview submits a COMMENT, APPROVE or REQUEST_CHANGES review on a pull request
+	AddPullRequestReview(ctx context.Context, prNodeID, body, event string) error
+	// MarkIssueAsDuplicate comments "Duplicate of #N" on an issue and closes it as a duplicate
+	MarkIssueAsDuplicate(ctx context.Context, issueNodeID string, originalNumber int) error
 
 	// Listing operations for cleanup
 	// ListIssues retrieves all existing issues from the repository
 	ListIssues(ctx context.Context) ([]types.Issue, error)
+	// ListIssuePages retrieves existing issues a page at a time, calling fn with each page as it is fetched
+	ListIssuePages(ctx context.Context, fn func([]types.Issue) error) error
+	// UpdateIssue replaces the title, body, labels and assignees of an existing issue
+	UpdateIssue(ctx context.Context, nodeID string, issue types.Issue) (*types.CreatedItemInfo, error)
 	// ListDiscussions retrieves all existing discussions from the repository
 	ListDiscussions(ctx context.Context) ([]types.Discussion, error)
+	// ListDiscussionPages retrieves existing discussions a page at a time, calling fn with each page as it is fetched
+	ListDiscussionPages(ctx context.Context, fn func([]types.Discussion) error) error
 	// ListPRs retrieves all existing pull requests from the repository
 	ListPRs(ctx context.Context) ([]types.PullRequest, error)
+	// ListPRPages retrieves existing pull requests a page at a time, calling fn with each page as it is fetched
+	ListPRPages(ctx context.Context, fn func([]types.PullRequest) error) error
+	// GetIssue retrieves a single issue by number, including its state, labels and assignees
+	GetIssue(ctx context.Context, number int) (*types.Issue, error)
+	// GetPullRequest retrieves a single pull request by number, including its state, labels and assignees
+	GetPullRequest(ctx context.Context, number int) (*types.PullRequest, error)
 
 	// Deletion operations for cleanup
+	// AddComment adds a comment to an issue or pull request by its node ID
+	AddComment(ctx context.Context, subjectNodeID, body string) error
 	// DeleteIssue deletes an issue by its node ID
 	DeleteIssue(ctx context.Context, nodeID string) error
 	// DeleteDiscussion deletes a discussion by its node ID
 	DeleteDiscussion(ctx context.Context, nodeID string) error
+	// CloseDiscussion closes a discussion by its node ID with reason RESOLVED, OUTDATED or DUPLICATE
+	CloseDiscussion(ctx context.Context, nodeID, reason string) error
 	// DeletePR deletes a pull request by its node ID
 	DeletePR(ctx context.Context, nodeID string) error
 	// DeleteLabel deletes a label by its name
 	DeleteLabel(ctx context.Context, name string) error
+	// UpdateLabel updates the color and description of the label with the given name
+	UpdateLabel(ctx context.Context, name string, label types.Label) error
+	// ListBranches retrieves the branches whose name starts with prefix
+	ListBranches(ctx context.Context, prefix string) ([]types.Branch, error)
+	// DeleteBranch deletes a branch by the node ID of its ref
+	DeleteBranch(ctx context.Context, refID string) error
 
 	// ProjectV2 operations
-	// CreateProjectV2 creates a new ProjectV2 for the repository owner
+	// CreateProjectV2 creates a new ProjectV2 for the project owner
 	CreateProjectV2(ctx context.Context, config types.ProjectV2Configuration) (*types.ProjectV2, error)
 	// ConfigureProjectV2Fields creates custom fields for a ProjectV2 based on the configuration
 	ConfigureProjectV2Fields(ctx context.Context, projectID string, fields []types.ProjectV2Field) error
@@ -52,7 +85,57 @@ type GitHubClient interface {
 	AddItemToProjectV2(ctx context.Context, projectID, itemNodeID string) error
 	// GetProjectV2 retrieves project information by ID
 	GetProjectV2(ctx context.Context, projectID string) (*types.ProjectV2, error)
+	// GetProjectV2ByNumber retrieves a project owned by the project owner by its number
+	GetProjectV2ByNumber(ctx context.Context, number int) (*types.ProjectV2, error)
+	// FindProjectV2ByTitle finds an open project owned by the project owner by exact title, returning nil when none matches
+	FindProjectV2ByTitle(ctx context.Context, title string) (*types.ProjectV2, error)
+	// GetProjectOwnerID retrieves the node ID of the project owner, failing when the owner does not exist
+	GetProjectOwnerID(ctx context.Context) (string, error)
+	// ListProjectV2Fields lists the fields of a ProjectV2, including single select options
+	ListProjectV2Fields(ctx context.Context, projectID string) ([]types.ProjectV2Field, error)
+	// UpdateProjectV2FieldOptions replaces the options of a single select field
+	UpdateProjectV2FieldOptions(ctx context.Context, fieldID string, options []types.ProjectV2FieldOption) error
+	// ListProjectV2Items lists every item on a ProjectV2 board
+	ListProjectV2Items(ctx context.Context, projectID string) ([]types.ProjectV2Item, error)
+	// ClearProjectV2Items removes every item from a ProjectV2 board, keeping the project, its fields and the item content
+	ClearProjectV2Items(ctx context.Context, projectID string) error
+	// DeleteProjectV2 deletes a ProjectV2 board, keeping the issues and pull requests it tracked
+	DeleteProjectV2(ctx context.Context, projectID string) error
+
+	// Identity operations
+	// GetViewer retrieves the authenticated user's login and their permission on the repository
+	GetViewer(ctx context.Context) (*types.ViewerInfo, error)
+	// GetRepositoryFeatures reports whether issues, discussions and projects are enabled on the repository
+	GetRepositoryFeatures(ctx context.Context) (*types.RepositoryFeatures, error)
+	// ListDiscussionCategories returns the names of the repository's discussion categories
+	ListDiscussionCategories(ctx context.Context) ([]string, error)
+	// UserExists reports whether a GitHub user with the given login exists
+	UserExists(ctx context.Context, login string) (bool, error)
+	// GetDefaultBranch returns the name of the repository's default branch
+	GetDefaultBranch(ctx context.Context) (string, error)
+	// ListIssueTemplates reads the Markdown issue templates of the repository
+	ListIssueTemplates(ctx context.Context) ([]types.IssueTemplate, error)
+	// GetCodeowners reads the rules of the repository's CODEOWNERS file
+	GetCodeowners(ctx context.Context) ([]types.CodeownersRule, error)
+
+	// CreateOrUpdateFile commits a file with content to the repository's default branch
+	CreateOrUpdateFile(ctx context.Context, path, content, message string) error
+	// CheckProjectAccess reports a project permission error when the token cannot use projects
+	CheckProjectAccess(ctx context.Context) error
+
+	// APICallCounts returns the number of GraphQL calls made so far, keyed by operation name
+	APICallCounts() map[string]int
+	// GetRateLimit retrieves the remaining GraphQL rate limit budget
+	GetRateLimit(ctx context.Context) (*types.RateLimit, error)
 
 	// SetLogger sets the logger for debug output during API operations
 	SetLogger(logger common.Logger)
+	// SetMaxPages caps the number of pages a list operation fetches; zero or less uses the default
+	SetMaxPages(maxPages int)
+	// SetProjectOwner makes project operations use another user's or organization's projects; empty uses the repository owner
+	SetProjectOwner(owner string)
+	// SetNetworkProfile applies the API timeout and retry settings of a network profile
+	SetNetworkProfile(profile config.NetworkProfile)
+	// SetScheduler paces every later call with a request scheduler that may be shared with other clients; nil stops pacing
+	SetScheduler(scheduler *RequestScheduler)
 }
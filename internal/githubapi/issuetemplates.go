@@ -0,0 +1,77 @@
+package githubapi
+
+import (
+	"path"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// parseIssueTemplate reads the name, title and labels from the YAML front matter of a Markdown
+// issue template. Only the flat keys GitHub documents for templates are understood; labels may be
+// a comma-separated string, a flow sequence or a block sequence. The name defaults to the file name.
+func parseIssueTemplate(file, text string) types.IssueTemplate {
+	template := types.IssueTemplate{File: file}
+
+	lines := strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		template.Name = strings.TrimSuffix(file, path.Ext(file))
+		return template
+	}
+
+	key := ""
+	for _, line := range lines[1:] {
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "---" {
+			break
+		}
+
+		// Items of a block sequence belong to the most recent key
+		if item, isItem := strings.CutPrefix(trimmed, "- "); isItem {
+			if key == "labels" {
+				template.Labels = appendTemplateLabels(template.Labels, item)
+			}
+			continue
+		}
+
+		name, value, found := strings.Cut(trimmed, ":")
+		if !found {
+			continue
+		}
+		key = strings.TrimSpace(name)
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "name":
+			template.Name = unquoteTemplateValue(value)
+		case "title":
+			template.Title = unquoteTemplateValue(value)
+		case "labels":
+			value = strings.TrimSuffix(strings.TrimPrefix(value, "["), "]")
+			for _, label := range strings.Split(value, ",") {
+				template.Labels = appendTemplateLabels(template.Labels, label)
+			}
+		}
+	}
+
+	if template.Name == "" {
+		template.Name = strings.TrimSuffix(file, path.Ext(file))
+	}
+	return template
+}
+
+// appendTemplateLabels appends label to labels after removing quotes, skipping empty values
+func appendTemplateLabels(labels []string, label string) []string {
+	if label = strings.TrimSpace(unquoteTemplateValue(strings.TrimSpace(label))); label != "" {
+		labels = append(labels, label)
+	}
+	return labels
+}
+
+// unquoteTemplateValue removes one pair of matching single or double quotes around value
+func unquoteTemplateValue(value string) string {
+	if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1]
+	}
+	return value
+}
@@ -0,0 +1,52 @@
+package githubapi
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestParseIssueTemplate tests reading the name, title and labels from template front matter
+func TestParseIssueTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		text     string
+		expected types.IssueTemplate
+	}{
+		{
+			name:     "comma-separated labels",
+			file:     "bug_report.md",
+			text:     "---\nname: Bug report\nabout: Report a bug\ntitle: \"[BUG] \"\nlabels: bug, needs-triage\nassignees: ''\n---\n\n**Describe the bug**\n",
+			expected: types.IssueTemplate{File: "bug_report.md", Name: "Bug report", Title: "[BUG] ", Labels: []string{"bug", "needs-triage"}},
+		},
+		{
+			name:     "flow sequence labels",
+			file:     "feature.md",
+			text:     "---\nname: 'Feature'\ntitle: '[FEAT]'\nlabels: [\"enhancement\", 'ui']\n---\n",
+			expected: types.IssueTemplate{File: "feature.md", Name: "Feature", Title: "[FEAT]", Labels: []string{"enhancement", "ui"}},
+		},
+		{
+			name:     "block sequence labels",
+			file:     "docs.md",
+			text:     "---\r\nname: Docs\r\nlabels:\r\n  - documentation\r\n  - \"good first issue\"\r\n---\r\n",
+			expected: types.IssueTemplate{File: "docs.md", Name: "Docs", Labels: []string{"documentation", "good first issue"}},
+		},
+		{
+			name:     "no front matter",
+			file:     "plain.md",
+			text:     "Just a body\n",
+			expected: types.IssueTemplate{File: "plain.md", Name: "plain"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := parseIssueTemplate(tt.file, tt.text)
+			if !reflect.DeepEqual(template, tt.expected) {
+				t.Errorf("Expected %+v, got %+v", tt.expected, template)
+			}
+		})
+	}
+}
@@ -41,6 +41,26 @@ const createIssueMutation = `
 	}
 `
 
+// updateIssueMutation replaces the title, body, labels and assignees of an existing issue
+const updateIssueMutation = `
+	mutation UpdateIssue($id: ID!, $title: String, $body: String, $labelIds: [ID!], $assigneeIds: [ID!]) {
+		updateIssue(input: {
+			id: $id
+			title: $title
+			body: $body
+			labelIds: $labelIds
+			assigneeIds: $assigneeIds
+		}) {
+			issue {
+				id
+				number
+				title
+				url
+			}
+		}
+	}
+`
+
 // createPullRequestMutation creates a new pull request in a repository
 const createPullRequestMutation = `
 	mutation CreatePullRequest($repositoryId: ID!, $title: String!, $body: String, $headRefName: String!, $baseRefName: String!) {
@@ -90,9 +110,30 @@ const getUserIdQuery = `
 	}
 `
 
+// getViewerIdQuery gets the node ID and login of the authenticated user, for the @me login
+const getViewerIdQuery = `
+	query GetViewerId {
+		viewer {
+			id
+			login
+		}
+	}
+`
+
+// getTeamIdQuery gets the node ID of an organization team by its slug
+const getTeamIdQuery = `
+	query GetTeamId($org: String!, $slug: String!) {
+		organization(login: $org) {
+			team(slug: $slug) {
+				id
+			}
+		}
+	}
+`
+
 // listLabelsQuery lists all labels in a repository with pagination support
 const listLabelsQuery = `
-	query($owner: String!, $name: String!) {
+	query ListLabels($owner: String!, $name: String!) {
 		repository(owner: $owner, name: $name) {
 			labels(first: 100) {
 				nodes {
@@ -107,9 +148,28 @@ const listLabelsQuery = `
 	}
 `
 
+// listLabelsDetailedQuery lists labels with their color and description, one page at a time
+const listLabelsDetailedQuery = `
+	query ListLabelsDetailed($owner: String!, $name: String!, $first: Int!, $after: String) {
+		repository(owner: $owner, name: $name) {
+			labels(first: $first, after: $after) {
+				nodes {
+					name
+					color
+					description
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}
+`
+
 // repositoryWithDiscussionCategoriesQuery gets repository ID and discussion categories
 const repositoryWithDiscussionCategoriesQuery = `
-	query($owner: String!, $name: String!) {
+	query GetDiscussionCategories($owner: String!, $name: String!) {
 		repository(owner: $owner, name: $name) {
 			id
 			discussionCategories(first: 50) {
@@ -124,7 +184,7 @@ const repositoryWithDiscussionCategoriesQuery = `
 
 // createDiscussionMutation creates a new discussion in a repository
 const createDiscussionMutation = `
-	mutation($input: CreateDiscussionInput!) {
+	mutation CreateDiscussion($input: CreateDiscussionInput!) {
 		createDiscussion(input: $input) {
 			discussion {
 				id
@@ -149,12 +209,13 @@ const deleteDiscussionMutation = `
 	}
 `
 
-// labelByNameQuery gets label ID by name for discussion/labelable operations
-const labelByNameQuery = `
-	query($owner: String!, $repo: String!, $name: String!) {
-		repository(owner: $owner, name: $repo) {
-			label(name: $name) {
+// closeDiscussionMutation closes a discussion with a reason, keeping its content
+const closeDiscussionMutation = `
+	mutation CloseDiscussion($discussionId: ID!, $reason: DiscussionCloseReason!) {
+		closeDiscussion(input: {discussionId: $discussionId, reason: $reason}) {
+			discussion {
 				id
+				title
 			}
 		}
 	}
@@ -162,7 +223,7 @@ const labelByNameQuery = `
 
 // addLabelsToLabelableMutation adds labels to any labelable object (issues, PRs, discussions)
 const addLabelsToLabelableMutation = `
-	mutation($input: AddLabelsToLabelableInput!) {
+	mutation AddLabelsToLabelable($input: AddLabelsToLabelableInput!) {
 		addLabelsToLabelable(input: $input) {
 			clientMutationId
 		}
@@ -195,7 +256,7 @@ const addAssigneesToAssignableMutation = `
 
 // listIssuesQuery lists all issues in a repository with pagination support
 const listIssuesQuery = `
-	query($owner: String!, $name: String!, $first: Int!, $after: String) {
+	query ListIssues($owner: String!, $name: String!, $first: Int!, $after: String) {
 		repository(owner: $owner, name: $name) {
 			issues(first: $first, after: $after, states: [OPEN]) {
 				nodes {
@@ -208,6 +269,11 @@ const listIssuesQuery = `
 							name
 						}
 					}
+					assignees(first: 20) {
+						nodes {
+							login
+						}
+					}
 				}
 				pageInfo {
 					hasNextPage
@@ -220,7 +286,7 @@ const listIssuesQuery = `
 
 // listDiscussionsQuery lists all discussions in a repository with pagination support
 const listDiscussionsQuery = `
-	query($owner: String!, $name: String!, $first: Int!, $after: String) {
+	query ListDiscussions($owner: String!, $name: String!, $first: Int!, $after: String) {
 		repository(owner: $owner, name: $name) {
 			discussions(first: $first, after: $after) {
 				nodes {
@@ -228,9 +294,15 @@ const listDiscussionsQuery = `
 					number
 					title
 					body
+					closed
 					category {
 						name
 					}
+					labels(first: 20) {
+						nodes {
+							name
+						}
+					}
 				}
 				pageInfo {
 					hasNextPage
@@ -243,7 +315,7 @@ const listDiscussionsQuery = `
 
 // listPullRequestsQuery lists all pull requests in a repository with pagination support
 const listPullRequestsQuery = `
-	query($owner: String!, $name: String!, $first: Int!, $after: String) {
+	query ListPullRequests($owner: String!, $name: String!, $first: Int!, $after: String) {
 		repository(owner: $owner, name: $name) {
 			pullRequests(first: $first, after: $after, states: [OPEN]) {
 				nodes {
@@ -282,6 +354,37 @@ const deleteIssueMutation = `
 	}
 `
 
+// addCommentMutation adds a comment to an issue, pull request or discussion
+const addCommentMutation = `
+	mutation AddComment($subjectId: ID!, $body: String!) {
+		addComment(input: {
+			subjectId: $subjectId
+			body: $body
+		}) {
+			commentEdge {
+				node {
+					id
+				}
+			}
+		}
+	}
+`
+
+// closeIssueAsDuplicateMutation closes an issue with the DUPLICATE state reason
+const closeIssueAsDuplicateMutation = `
+	mutation CloseIssueAsDuplicate($issueId: ID!) {
+		closeIssue(input: {
+			issueId: $issueId
+			stateReason: DUPLICATE
+		}) {
+			issue {
+				id
+				state
+			}
+		}
+	}
+`
+
 // deletePullRequestMutation deletes a pull request by closing it
 const deletePullRequestMutation = `
 	mutation DeletePullRequest($pullRequestId: ID!) {
@@ -307,9 +410,27 @@ const deleteLabelMutation = `
 	}
 `
 
-// getLabelByNameQuery gets a label ID by name for deletion
+// updateLabelMutation updates the color and description of a label by ID
+const updateLabelMutation = `
+	mutation UpdateLabel($labelId: ID!, $color: String, $description: String) {
+		updateLabel(input: {
+			id: $labelId
+			color: $color
+			description: $description
+		}) {
+			label {
+				id
+				name
+				color
+				description
+			}
+		}
+	}
+`
+
+// getLabelByNameQuery gets a label ID by name for deletion and updates
 const getLabelByNameQuery = `
-	query($owner: String!, $name: String!, $labelName: String!) {
+	query GetLabelByName($owner: String!, $name: String!, $labelName: String!) {
 		repository(owner: $owner, name: $name) {
 			label(name: $labelName) {
 				id
@@ -337,6 +458,54 @@ const createProjectV2Mutation = `
 	}
 `
 
+// addPullRequestReviewMutation submits a review on a pull request
+const addPullRequestReviewMutation = `
+	mutation AddPullRequestReview($pullRequestId: ID!, $body: String, $event: PullRequestReviewEvent!) {
+		addPullRequestReview(input: {
+			pullRequestId: $pullRequestId
+			body: $body
+			event: $event
+		}) {
+			pullRequestReview {
+				id
+			}
+		}
+	}
+`
+
+// requestReviewsMutation requests reviews on a pull request, keeping any reviewers already requested
+const requestReviewsMutation = `
+	mutation RequestReviews($pullRequestId: ID!, $userIds: [ID!], $teamIds: [ID!]) {
+		requestReviews(input: {
+			pullRequestId: $pullRequestId
+			userIds: $userIds
+			teamIds: $teamIds
+			union: true
+		}) {
+			pullRequest {
+				id
+			}
+		}
+	}
+`
+
+// enablePullRequestAutoMergeMutation enables auto-merge on a pull request
+const enablePullRequestAutoMergeMutation = `
+	mutation EnablePullRequestAutoMerge($pullRequestId: ID!, $mergeMethod: PullRequestMergeMethod) {
+		enablePullRequestAutoMerge(input: {
+			pullRequestId: $pullRequestId
+			mergeMethod: $mergeMethod
+		}) {
+			pullRequest {
+				id
+				autoMergeRequest {
+					mergeMethod
+				}
+			}
+		}
+	}
+`
+
 // addProjectV2ItemByIdMutation adds an item to a ProjectV2 by content ID
 const addProjectV2ItemByIdMutation = `
 	mutation AddProjectV2ItemById($projectId: ID!, $contentId: ID!) {
@@ -351,6 +520,61 @@ const addProjectV2ItemByIdMutation = `
 	}
 `
 
+// listProjectV2ItemsQuery lists the items on a ProjectV2 with the title of their content
+const listProjectV2ItemsQuery = `
+	query ListProjectV2Items($projectId: ID!, $first: Int!, $after: String) {
+		node(id: $projectId) {
+			... on ProjectV2 {
+				items(first: $first, after: $after) {
+					nodes {
+						id
+						content {
+							... on Issue {
+								title
+							}
+							... on PullRequest {
+								title
+							}
+							... on DraftIssue {
+								title
+							}
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	}
+`
+
+// deleteProjectV2ItemMutation removes an item from a ProjectV2 without deleting its content
+const deleteProjectV2ItemMutation = `
+	mutation DeleteProjectV2Item($projectId: ID!, $itemId: ID!) {
+		deleteProjectV2Item(input: {
+			projectId: $projectId
+			itemId: $itemId
+		}) {
+			deletedItemId
+		}
+	}
+`
+
+// deleteProjectV2Mutation deletes a ProjectV2 with its fields and items, keeping the item content
+const deleteProjectV2Mutation = `
+	mutation DeleteProjectV2($projectId: ID!) {
+		deleteProjectV2(input: {
+			projectId: $projectId
+		}) {
+			projectV2 {
+				id
+			}
+		}
+	}
+`
+
 // getProjectV2Query retrieves a ProjectV2 by ID
 const getProjectV2Query = `
 	query GetProjectV2($projectId: ID!) {
@@ -359,13 +583,106 @@ const getProjectV2Query = `
 				id
 				number
 				title
-				description
+				description: shortDescription
 				url
 			}
 		}
 	}
 `
 
+// getProjectV2ByNumberQuery gets a ProjectV2 owned by a user or organization by its number
+const getProjectV2ByNumberQuery = `
+	query GetProjectV2ByNumber($owner: String!, $number: Int!) {
+		repositoryOwner(login: $owner) {
+			... on User {
+				projectV2(number: $number) {
+					id
+					number
+					title
+					description: shortDescription
+					url
+				}
+			}
+			... on Organization {
+				projectV2(number: $number) {
+					id
+					number
+					title
+					description: shortDescription
+					url
+				}
+			}
+		}
+	}
+`
+
+// findProjectsV2Query searches the open and closed ProjectV2s of a user or organization by title
+const findProjectsV2Query = `
+	query FindProjectsV2($owner: String!, $query: String!) {
+		repositoryOwner(login: $owner) {
+			... on User {
+				projectsV2(first: 20, query: $query) {
+					nodes {
+						id
+						number
+						title
+						description: shortDescription
+						url
+						closed
+					}
+				}
+			}
+			... on Organization {
+				projectsV2(first: 20, query: $query) {
+					nodes {
+						id
+						number
+						title
+						description: shortDescription
+						url
+						closed
+					}
+				}
+			}
+		}
+	}
+`
+
+// listProjectV2FieldsQuery lists the fields of a ProjectV2, including single select options
+const listProjectV2FieldsQuery = `
+	query ListProjectV2Fields($projectId: ID!) {
+		node(id: $projectId) {
+			... on ProjectV2 {
+				fields(first: 100) {
+					nodes {
+						... on ProjectV2Field {
+							id
+							name
+							dataType
+						}
+						... on ProjectV2IterationField {
+							id
+							name
+							dataType
+						}
+						... on ProjectV2SingleSelectField {
+							id
+							name
+							dataType
+							options {
+								id
+								name
+								description
+								color
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+`
+
 // getRepositoryOwnerIdQuery gets the owner ID for creating projects
 const getRepositoryOwnerIdQuery = `
 	query GetRepositoryOwnerId($owner: String!) {
@@ -375,6 +692,238 @@ const getRepositoryOwnerIdQuery = `
 	}
 `
 
+// checkProjectAccessQuery reads the project count of the repository owner, which fails when the
+// token lacks the project scope
+const checkProjectAccessQuery = `
+	query CheckProjectAccess($owner: String!) {
+		repositoryOwner(login: $owner) {
+			... on User {
+				projectsV2(first: 1) {
+					totalCount
+				}
+			}
+			... on Organization {
+				projectsV2(first: 1) {
+					totalCount
+				}
+			}
+		}
+	}
+`
+
+// getRateLimitQuery gets the remaining GraphQL rate limit budget
+const getRateLimitQuery = `
+	query GetRateLimit {
+		rateLimit {
+			limit
+			remaining
+			cost
+			resetAt
+		}
+	}
+`
+
+// getViewerQuery gets the authenticated user's login and their permission on the repository
+const getViewerQuery = `
+	query GetViewer($owner: String!, $name: String!) {
+		viewer {
+			login
+		}
+		repository(owner: $owner, name: $name) {
+			viewerPermission
+		}
+	}
+`
+
+// getRepositoryFeaturesQuery gets which features used by hydration are enabled on the repository,
+// and whether the repository is archived or locked
+const getRepositoryFeaturesQuery = `
+	query GetRepositoryFeatures($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			hasIssuesEnabled
+			hasDiscussionsEnabled
+			hasProjectsEnabled
+			isArchived
+			isLocked
+		}
+	}
+`
+
+// getDefaultBranchQuery gets the name of the repository's default branch
+const getDefaultBranchQuery = `
+	query GetDefaultBranch($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			defaultBranchRef {
+				name
+			}
+		}
+	}
+`
+
+// listBranchesQuery lists branches whose name contains the query, with their protection rule
+const listBranchesQuery = `
+	query ListBranches($owner: String!, $name: String!, $query: String!, $first: Int!, $after: String) {
+		repository(owner: $owner, name: $name) {
+			refs(refPrefix: "refs/heads/", query: $query, first: $first, after: $after) {
+				nodes {
+					id
+					name
+					branchProtectionRule {
+						id
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	}
+`
+
+// deleteRefMutation deletes a branch by the node ID of its ref
+const deleteRefMutation = `
+	mutation DeleteRef($refId: ID!) {
+		deleteRef(input: {
+			refId: $refId
+		}) {
+			clientMutationId
+		}
+	}
+`
+
+// getFileQuery gets the default branch with its head commit and a file of the default branch
+const getFileQuery = `
+	query GetFile($owner: String!, $name: String!, $expression: String!) {
+		repository(owner: $owner, name: $name) {
+			defaultBranchRef {
+				name
+				target {
+					oid
+				}
+			}
+			object(expression: $expression) {
+				... on Blob {
+					oid
+				}
+			}
+		}
+	}
+`
+
+// createCommitOnBranchMutation commits a file to a branch, failing when the branch head has moved
+// past expectedHeadOid
+const createCommitOnBranchMutation = `
+	mutation CreateCommitOnBranch($repository: String!, $branch: String!, $message: String!, $path: String!, $contents: Base64String!, $expectedHeadOid: GitObjectID!) {
+		createCommitOnBranch(input: {
+			branch: {repositoryNameWithOwner: $repository, branchName: $branch}
+			message: {headline: $message}
+			fileChanges: {additions: [{path: $path, contents: $contents}]}
+			expectedHeadOid: $expectedHeadOid
+		}) {
+			commit {
+				oid
+			}
+		}
+	}
+`
+
+// listIssueTemplatesQuery gets the files in the .github/ISSUE_TEMPLATE directory of the default branch
+const listIssueTemplatesQuery = `
+	query ListIssueTemplates($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			object(expression: "HEAD:.github/ISSUE_TEMPLATE") {
+				... on Tree {
+					entries {
+						name
+						type
+						object {
+							... on Blob {
+								text
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+`
+
+// getCodeownersQuery gets the CODEOWNERS file of the default branch from each location GitHub reads it from
+const getCodeownersQuery = `
+	query GetCodeowners($owner: String!, $name: String!) {
+		repository(owner: $owner, name: $name) {
+			github: object(expression: "HEAD:.github/CODEOWNERS") {
+				... on Blob {
+					text
+				}
+			}
+			root: object(expression: "HEAD:CODEOWNERS") {
+				... on Blob {
+					text
+				}
+			}
+			docs: object(expression: "HEAD:docs/CODEOWNERS") {
+				... on Blob {
+					text
+				}
+			}
+		}
+	}
+`
+
+// getIssueQuery gets a single issue by number with its labels and assignees
+const getIssueQuery = `
+	query GetIssue($owner: String!, $name: String!, $number: Int!) {
+		repository(owner: $owner, name: $name) {
+			issue(number: $number) {
+				id
+				number
+				title
+				body
+				state
+				labels(first: 100) {
+					nodes {
+						name
+					}
+				}
+				assignees(first: 100) {
+					nodes {
+						login
+					}
+				}
+			}
+		}
+	}
+`
+
+// getPullRequestQuery gets a single pull request by number with its branches, labels and assignees
+const getPullRequestQuery = `
+	query GetPullRequest($owner: String!, $name: String!, $number: Int!) {
+		repository(owner: $owner, name: $name) {
+			pullRequest(number: $number) {
+				id
+				number
+				title
+				body
+				state
+				headRefName
+				baseRefName
+				labels(first: 100) {
+					nodes {
+						name
+					}
+				}
+				assignees(first: 100) {
+					nodes {
+						login
+					}
+				}
+			}
+		}
+	}
+`
+
 // createProjectV2FieldMutation creates a custom field in a ProjectV2
 const createProjectV2FieldMutation = `
 	mutation CreateProjectV2Field($projectId: ID!, $dataType: ProjectV2CustomFieldType!, $name: String!) {
@@ -429,16 +978,33 @@ const createProjectV2SingleSelectFieldMutation = `
 	}
 `
 
+// updateProjectV2FieldOptionsMutation replaces the options of a single select ProjectV2 field
+const updateProjectV2FieldOptionsMutation = `
+	mutation UpdateProjectV2FieldOptions($fieldId: ID!, $options: [ProjectV2SingleSelectFieldOptionInput!]!) {
+		updateProjectV2Field(input: {
+			fieldId: $fieldId
+			singleSelectOptions: $options
+		}) {
+			projectV2Field {
+				... on ProjectV2SingleSelectField {
+					id
+					name
+				}
+			}
+		}
+	}
+`
+
 // updateProjectV2Mutation updates a ProjectV2 with description
 const updateProjectV2Mutation = `
 	mutation UpdateProjectV2($projectId: ID!, $description: String) {
 		updateProjectV2(input: {
 			projectId: $projectId
-			description: $description
+			shortDescription: $description
 		}) {
 			projectV2 {
 				id
-				description
+				description: shortDescription
 			}
 		}
 	}
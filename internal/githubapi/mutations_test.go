@@ -51,6 +51,10 @@ func TestGraphQLMutationSyntax(t *testing.T) {
 			name:     "deleteLabelMutation",
 			mutation: deleteLabelMutation,
 		},
+		{
+			name:     "updateLabelMutation",
+			mutation: updateLabelMutation,
+		},
 		{
 			name:     "createProjectV2Mutation",
 			mutation: createProjectV2Mutation,
@@ -59,6 +63,54 @@ func TestGraphQLMutationSyntax(t *testing.T) {
 			name:     "addProjectV2ItemByIdMutation",
 			mutation: addProjectV2ItemByIdMutation,
 		},
+		{
+			name:     "enablePullRequestAutoMergeMutation",
+			mutation: enablePullRequestAutoMergeMutation,
+		},
+		{
+			name:     "requestReviewsMutation",
+			mutation: requestReviewsMutation,
+		},
+		{
+			name:     "addPullRequestReviewMutation",
+			mutation: addPullRequestReviewMutation,
+		},
+		{
+			name:     "updateIssueMutation",
+			mutation: updateIssueMutation,
+		},
+		{
+			name:     "updateProjectV2FieldOptionsMutation",
+			mutation: updateProjectV2FieldOptionsMutation,
+		},
+		{
+			name:     "deleteProjectV2Mutation",
+			mutation: deleteProjectV2Mutation,
+		},
+		{
+			name:     "deleteProjectV2ItemMutation",
+			mutation: deleteProjectV2ItemMutation,
+		},
+		{
+			name:     "addCommentMutation",
+			mutation: addCommentMutation,
+		},
+		{
+			name:     "closeIssueAsDuplicateMutation",
+			mutation: closeIssueAsDuplicateMutation,
+		},
+		{
+			name:     "closeDiscussionMutation",
+			mutation: closeDiscussionMutation,
+		},
+		{
+			name:     "deleteRefMutation",
+			mutation: deleteRefMutation,
+		},
+		{
+			name:     "createCommitOnBranchMutation",
+			mutation: createCommitOnBranchMutation,
+		},
 		{
 			name:     "createProjectV2FieldMutation",
 			mutation: createProjectV2FieldMutation,
@@ -109,6 +161,10 @@ func TestGraphQLQuerySyntax(t *testing.T) {
 			name:  "listLabelsQuery",
 			query: listLabelsQuery,
 		},
+		{
+			name:  "listLabelsDetailedQuery",
+			query: listLabelsDetailedQuery,
+		},
 		{
 			name:  "repositoryWithDiscussionCategoriesQuery",
 			query: repositoryWithDiscussionCategoriesQuery,
@@ -137,6 +193,74 @@ func TestGraphQLQuerySyntax(t *testing.T) {
 			name:  "getRepositoryOwnerIdQuery",
 			query: getRepositoryOwnerIdQuery,
 		},
+		{
+			name:  "checkProjectAccessQuery",
+			query: checkProjectAccessQuery,
+		},
+		{
+			name:  "getViewerQuery",
+			query: getViewerQuery,
+		},
+		{
+			name:  "getProjectV2ByNumberQuery",
+			query: getProjectV2ByNumberQuery,
+		},
+		{
+			name:  "findProjectsV2Query",
+			query: findProjectsV2Query,
+		},
+		{
+			name:  "getRateLimitQuery",
+			query: getRateLimitQuery,
+		},
+		{
+			name:  "listProjectV2FieldsQuery",
+			query: listProjectV2FieldsQuery,
+		},
+		{
+			name:  "listProjectV2ItemsQuery",
+			query: listProjectV2ItemsQuery,
+		},
+		{
+			name:  "getRepositoryFeaturesQuery",
+			query: getRepositoryFeaturesQuery,
+		},
+		{
+			name:  "getDefaultBranchQuery",
+			query: getDefaultBranchQuery,
+		},
+		{
+			name:  "listBranchesQuery",
+			query: listBranchesQuery,
+		},
+		{
+			name:  "listIssueTemplatesQuery",
+			query: listIssueTemplatesQuery,
+		},
+		{
+			name:  "getCodeownersQuery",
+			query: getCodeownersQuery,
+		},
+		{
+			name:  "getFileQuery",
+			query: getFileQuery,
+		},
+		{
+			name:  "getTeamIdQuery",
+			query: getTeamIdQuery,
+		},
+		{
+			name:  "getViewerIdQuery",
+			query: getViewerIdQuery,
+		},
+		{
+			name:  "getIssueQuery",
+			query: getIssueQuery,
+		},
+		{
+			name:  "getPullRequestQuery",
+			query: getPullRequestQuery,
+		},
 	}
 
 	for _, tt := range queries {
@@ -202,6 +326,11 @@ func TestMutationVariableSubstitution(t *testing.T) {
 			mutation:     deleteLabelMutation,
 			expectedVars: []string{"$labelId"},
 		},
+		{
+			name:         "updateLabelMutation variables",
+			mutation:     updateLabelMutation,
+			expectedVars: []string{"$labelId", "$color", "$description"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -233,6 +362,11 @@ func TestQueryVariableSubstitution(t *testing.T) {
 			query:        listLabelsQuery,
 			expectedVars: []string{"$owner", "$name"},
 		},
+		{
+			name:         "listLabelsDetailedQuery variables",
+			query:        listLabelsDetailedQuery,
+			expectedVars: []string{"$owner", "$name", "$first", "$after"},
+		},
 		{
 			name:         "repositoryWithDiscussionCategoriesQuery variables",
 			query:        repositoryWithDiscussionCategoriesQuery,
@@ -326,12 +460,40 @@ func TestGraphQLConstantsExist(t *testing.T) {
 		{"deleteIssueMutation", deleteIssueMutation},
 		{"deletePullRequestMutation", deletePullRequestMutation},
 		{"deleteLabelMutation", deleteLabelMutation},
+		{"updateLabelMutation", updateLabelMutation},
 		{"listLabelsQuery", listLabelsQuery},
+		{"listLabelsDetailedQuery", listLabelsDetailedQuery},
 		{"repositoryWithDiscussionCategoriesQuery", repositoryWithDiscussionCategoriesQuery},
 		{"getLabelByNameQuery", getLabelByNameQuery},
 		{"listIssuesQuery", listIssuesQuery},
 		{"listDiscussionsQuery", listDiscussionsQuery},
 		{"listPullRequestsQuery", listPullRequestsQuery},
+		{"getViewerQuery", getViewerQuery},
+		{"getProjectV2ByNumberQuery", getProjectV2ByNumberQuery},
+		{"enablePullRequestAutoMergeMutation", enablePullRequestAutoMergeMutation},
+		{"requestReviewsMutation", requestReviewsMutation},
+		{"addPullRequestReviewMutation", addPullRequestReviewMutation},
+		{"updateIssueMutation", updateIssueMutation},
+		{"getCodeownersQuery", getCodeownersQuery},
+		{"getTeamIdQuery", getTeamIdQuery},
+		{"getViewerIdQuery", getViewerIdQuery},
+		{"findProjectsV2Query", findProjectsV2Query},
+		{"getRateLimitQuery", getRateLimitQuery},
+		{"checkProjectAccessQuery", checkProjectAccessQuery},
+		{"listProjectV2FieldsQuery", listProjectV2FieldsQuery},
+		{"updateProjectV2FieldOptionsMutation", updateProjectV2FieldOptionsMutation},
+		{"listProjectV2ItemsQuery", listProjectV2ItemsQuery},
+		{"getRepositoryFeaturesQuery", getRepositoryFeaturesQuery},
+		{"getDefaultBranchQuery", getDefaultBranchQuery},
+		{"listBranchesQuery", listBranchesQuery},
+		{"deleteRefMutation", deleteRefMutation},
+		{"listIssueTemplatesQuery", listIssueTemplatesQuery},
+		{"getIssueQuery", getIssueQuery},
+		{"getPullRequestQuery", getPullRequestQuery},
+		{"addCommentMutation", addCommentMutation},
+		{"closeIssueAsDuplicateMutation", closeIssueAsDuplicateMutation},
+		{"closeDiscussionMutation", closeDiscussionMutation},
+		{"deleteProjectV2ItemMutation", deleteProjectV2ItemMutation},
 	}
 
 	for _, constant := range constants {
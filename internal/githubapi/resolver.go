@@ -0,0 +1,241 @@
+package githubapi
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+)
+
+// Resolver resolves label names, user logins and team names to GitHub node IDs.
+// Results are cached, including names that could not be found, so each name
+// is looked up at most once no matter how many items reference it.
+type Resolver struct {
+	gqlClient GraphQLClient
+	owner     string
+	repo      string
+	logger    common.Logger
+	timeout   time.Duration // Time allowed for each lookup; 0 uses config.APITimeout
+
+	mu       sync.Mutex
+	labelIDs map[string]string // label name -> node ID ("" when the label does not exist)
+	userIDs  map[string]string // user login -> node ID ("" when the user does not exist)
+	teamIDs  map[string]string // "org/team" -> node ID ("" when the team does not exist)
+}
+
+// NewResolver creates a Resolver for the given repository with empty caches.
+func NewResolver(gqlClient GraphQLClient, owner, repo string) *Resolver {
+	return &Resolver{
+		gqlClient: gqlClient,
+		owner:     owner,
+		repo:      repo,
+		labelIDs:  make(map[string]string),
+		userIDs:   make(map[string]string),
+		teamIDs:   make(map[string]string),
+	}
+}
+
+// SetLogger sets the logger for debug output
+func (r *Resolver) SetLogger(logger common.Logger) {
+	r.logger = logger
+}
+
+// requestTimeout returns the time allowed for each lookup
+func (r *Resolver) requestTimeout() time.Duration {
+	if r.timeout > 0 {
+		return r.timeout
+	}
+	return config.APITimeout
+}
+
+// debugLog logs a debug message if logger is available
+func (r *Resolver) debugLog(format string, args ...interface{}) {
+	if r.logger != nil {
+		r.logger.Debug(format, args...)
+	}
+}
+
+// RememberLabel records the node ID of a label, typically one that was just created.
+func (r *Resolver) RememberLabel(name, id string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.labelIDs[name] = id
+}
+
+// LabelIDs resolves label names to node IDs. Labels that do not exist are skipped.
+// A failed lookup is skipped without being cached so that it can be retried later.
+func (r *Resolver) LabelIDs(ctx context.Context, names []string) ([]string, error) {
+	return r.resolve(ctx, names, r.labelIDs, "label", r.lookupLabelID)
+}
+
+// UserIDs resolves user logins to node IDs, resolving config.ViewerLogin to the authenticated user.
+// Users that do not exist are skipped. A failed lookup is skipped without being cached so that it
+// can be retried later.
+func (r *Resolver) UserIDs(ctx context.Context, logins []string) ([]string, error) {
+	return r.resolve(ctx, logins, r.userIDs, "user", r.lookupUserID)
+}
+
+// TeamIDs resolves teams named "org/team" to node IDs. Teams that do not exist are skipped.
+// A failed lookup is skipped without being cached so that it can be retried later.
+func (r *Resolver) TeamIDs(ctx context.Context, teams []string) ([]string, error) {
+	return r.resolve(ctx, teams, r.teamIDs, "team", r.lookupTeamID)
+}
+
+// resolve returns the IDs for keys, consulting cache first and calling lookup on a miss.
+func (r *Resolver) resolve(ctx context.Context, keys []string, cache map[string]string, kind string, lookup func(context.Context, string) (string, error)) ([]string, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(keys))
+	seen := make(map[string]bool)
+	for _, key := range keys {
+		if err := ctx.Err(); err != nil {
+			return ids, errors.ContextError("resolve_"+kind+"s", err)
+		}
+
+		r.mu.Lock()
+		id, cached := cache[key]
+		r.mu.Unlock()
+
+		if !cached {
+			var err error
+			id, err = lookup(ctx, key)
+			if err != nil {
+				r.debugLog("Failed to find %s '%s': %v", kind, key, err)
+				// Continue with other entries even if one fails
+				continue
+			}
+			r.mu.Lock()
+			cache[key] = id
+			r.mu.Unlock()
+		}
+
+		if id == "" {
+			r.debugLog("The %s '%s' was not found", kind, key)
+			continue
+		}
+		if cached {
+			r.debugLog("Using cached ID for %s '%s': %s", kind, key, id)
+		} else {
+			r.debugLog("Resolved %s '%s' to ID: %s", kind, key, id)
+		}
+		// A login given both by name and as @me resolves to the same ID, which is only sent once
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// lookupLabelID queries the node ID of a repository label
+func (r *Resolver) lookupLabelID(ctx context.Context, name string) (string, error) {
+	var labelResponse struct {
+		Repository struct {
+			Label struct {
+				ID string `json:"id"`
+			} `json:"label"`
+		} `json:"repository"`
+	}
+
+	labelVariables := map[string]interface{}{
+		"owner":     r.owner,
+		"name":      r.repo,
+		"labelName": name,
+	}
+
+	labelCtx, cancel := context.WithTimeout(ctx, r.requestTimeout())
+	defer cancel()
+
+	if err := r.gqlClient.Do(labelCtx, getLabelIdQuery, labelVariables, &labelResponse); err != nil {
+		return "", err
+	}
+
+	return labelResponse.Repository.Label.ID, nil
+}
+
+// lookupUserID queries the node ID of a user, or of the authenticated user for config.ViewerLogin
+func (r *Resolver) lookupUserID(ctx context.Context, login string) (string, error) {
+	if login == config.ViewerLogin {
+		return r.lookupViewerID(ctx)
+	}
+
+	var userResponse struct {
+		User struct {
+			ID string `json:"id"`
+		} `json:"user"`
+	}
+
+	userVariables := map[string]interface{}{
+		"login": login,
+	}
+
+	userCtx, cancel := context.WithTimeout(ctx, r.requestTimeout())
+	defer cancel()
+
+	if err := r.gqlClient.Do(userCtx, getUserIdQuery, userVariables, &userResponse); err != nil {
+		return "", err
+	}
+
+	return userResponse.User.ID, nil
+}
+
+// lookupViewerID queries the node ID of the authenticated user
+func (r *Resolver) lookupViewerID(ctx context.Context) (string, error) {
+	var viewerResponse struct {
+		Viewer struct {
+			ID    string `json:"id"`
+			Login string `json:"login"`
+		} `json:"viewer"`
+	}
+
+	viewerCtx, cancel := context.WithTimeout(ctx, r.requestTimeout())
+	defer cancel()
+
+	if err := r.gqlClient.Do(viewerCtx, getViewerIdQuery, nil, &viewerResponse); err != nil {
+		return "", err
+	}
+
+	r.debugLog("Resolved %s to the authenticated user %s", config.ViewerLogin, viewerResponse.Viewer.Login)
+	return viewerResponse.Viewer.ID, nil
+}
+
+// lookupTeamID queries the node ID of a team named "org/team"
+func (r *Resolver) lookupTeamID(ctx context.Context, team string) (string, error) {
+	org, slug, found := strings.Cut(team, "/")
+	if !found {
+		return "", nil
+	}
+
+	var teamResponse struct {
+		Organization *struct {
+			Team *struct {
+				ID string `json:"id"`
+			} `json:"team"`
+		} `json:"organization"`
+	}
+
+	teamVariables := map[string]interface{}{
+		"org":  org,
+		"slug": slug,
+	}
+
+	teamCtx, cancel := context.WithTimeout(ctx, r.requestTimeout())
+	defer cancel()
+
+	if err := r.gqlClient.Do(teamCtx, getTeamIdQuery, teamVariables, &teamResponse); err != nil {
+		return "", err
+	}
+
+	if teamResponse.Organization == nil || teamResponse.Organization.Team == nil {
+		return "", nil
+	}
+	return teamResponse.Organization.Team.ID, nil
+}
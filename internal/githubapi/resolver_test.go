@@ -0,0 +1,238 @@
+package githubapi
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// newCountingResolverMock returns a GraphQL mock that resolves known labels and users, with octocat as the
+// authenticated user, and counts lookups per name
+func newCountingResolverMock(knownLabels, knownUsers map[string]string, failing map[string]bool, lookups map[string]int) *testutil.SimpleMockGraphQLClient {
+	return &testutil.SimpleMockGraphQLClient{
+		DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+			switch {
+			case strings.Contains(query, "GetLabelId"):
+				name := variables["labelName"].(string)
+				lookups["label:"+name]++
+				if failing[name] {
+					return fmt.Errorf("transient failure for %s", name)
+				}
+				resp := response.(*struct {
+					Repository struct {
+						Label struct {
+							ID string `json:"id"`
+						} `json:"label"`
+					} `json:"repository"`
+				})
+				resp.Repository.Label.ID = knownLabels[name]
+				return nil
+			case strings.Contains(query, "GetUserId"):
+				login := variables["login"].(string)
+				lookups["user:"+login]++
+				resp := response.(*struct {
+					User struct {
+						ID string `json:"id"`
+					} `json:"user"`
+				})
+				resp.User.ID = knownUsers[login]
+				return nil
+			case strings.Contains(query, "GetViewerId"):
+				// The authenticated user is always octocat
+				lookups["viewer"]++
+				resp := response.(*struct {
+					Viewer struct {
+						ID    string `json:"id"`
+						Login string `json:"login"`
+					} `json:"viewer"`
+				})
+				resp.Viewer.ID, resp.Viewer.Login = knownUsers["octocat"], "octocat"
+				return nil
+			}
+			return fmt.Errorf("unexpected query: %s", query)
+		},
+	}
+}
+
+// TestResolver_LabelIDs tests label resolution with cache hits, misses, and unknown labels
+func TestResolver_LabelIDs(t *testing.T) {
+	knownLabels := map[string]string{"bug": "LA_bug", "enhancement": "LA_enhancement"}
+
+	tests := []struct {
+		name            string
+		calls           [][]string
+		failing         map[string]bool
+		expectedIDs     []string
+		expectedLookups map[string]int
+	}{
+		{
+			name:            "cache miss queries each label once",
+			calls:           [][]string{{"bug", "enhancement"}},
+			expectedIDs:     []string{"LA_bug", "LA_enhancement"},
+			expectedLookups: map[string]int{"label:bug": 1, "label:enhancement": 1},
+		},
+		{
+			name:            "cache hit avoids repeated queries",
+			calls:           [][]string{{"bug"}, {"bug", "enhancement"}, {"bug"}},
+			expectedIDs:     []string{"LA_bug"},
+			expectedLookups: map[string]int{"label:bug": 1, "label:enhancement": 1},
+		},
+		{
+			name:            "unknown label is skipped and not queried again",
+			calls:           [][]string{{"missing", "bug"}, {"missing"}},
+			expectedIDs:     []string{},
+			expectedLookups: map[string]int{"label:missing": 1, "label:bug": 1},
+		},
+		{
+			name:            "failed lookup is retried on next call",
+			calls:           [][]string{{"bug"}, {"bug"}},
+			failing:         map[string]bool{"bug": true},
+			expectedIDs:     []string{},
+			expectedLookups: map[string]int{"label:bug": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookups := make(map[string]int)
+			resolver := NewResolver(newCountingResolverMock(knownLabels, nil, tt.failing, lookups), "owner", "repo")
+
+			var ids []string
+			for _, names := range tt.calls {
+				var err error
+				ids, err = resolver.LabelIDs(context.Background(), names)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("Expected IDs %v from last call, got %v", tt.expectedIDs, ids)
+			}
+			if !reflect.DeepEqual(lookups, tt.expectedLookups) {
+				t.Errorf("Expected lookups %v, got %v", tt.expectedLookups, lookups)
+			}
+		})
+	}
+}
+
+// TestResolver_UserIDs tests user resolution with cache hits and unknown users
+func TestResolver_UserIDs(t *testing.T) {
+	lookups := make(map[string]int)
+	knownUsers := map[string]string{"octocat": "U_octocat"}
+	resolver := NewResolver(newCountingResolverMock(nil, knownUsers, nil, lookups), "owner", "repo")
+
+	for i := 0; i < 3; i++ {
+		ids, err := resolver.UserIDs(context.Background(), []string{"octocat", "ghost"})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !reflect.DeepEqual(ids, []string{"U_octocat"}) {
+			t.Errorf("Expected [U_octocat], got %v", ids)
+		}
+	}
+
+	expectedLookups := map[string]int{"user:octocat": 1, "user:ghost": 1}
+	if !reflect.DeepEqual(lookups, expectedLookups) {
+		t.Errorf("Expected lookups %v, got %v", expectedLookups, lookups)
+	}
+}
+
+// TestResolver_UserIDs_Viewer tests that @me resolves to the authenticated user, alone or mixed with explicit logins
+func TestResolver_UserIDs_Viewer(t *testing.T) {
+	knownUsers := map[string]string{"octocat": "U_octocat", "hubot": "U_hubot"}
+
+	tests := []struct {
+		name            string
+		calls           [][]string
+		expectedIDs     []string
+		expectedLookups map[string]int
+	}{
+		{
+			name:            "@me resolves to the viewer",
+			calls:           [][]string{{"@me"}},
+			expectedIDs:     []string{"U_octocat"},
+			expectedLookups: map[string]int{"viewer": 1},
+		},
+		{
+			name:            "@me mixed with explicit logins",
+			calls:           [][]string{{"hubot", "@me"}},
+			expectedIDs:     []string{"U_hubot", "U_octocat"},
+			expectedLookups: map[string]int{"user:hubot": 1, "viewer": 1},
+		},
+		{
+			name:            "viewer named explicitly and as @me is assigned once",
+			calls:           [][]string{{"octocat", "@me"}},
+			expectedIDs:     []string{"U_octocat"},
+			expectedLookups: map[string]int{"user:octocat": 1, "viewer": 1},
+		},
+		{
+			name:            "viewer is cached",
+			calls:           [][]string{{"@me"}, {"@me", "hubot"}},
+			expectedIDs:     []string{"U_octocat", "U_hubot"},
+			expectedLookups: map[string]int{"user:hubot": 1, "viewer": 1},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lookups := make(map[string]int)
+			resolver := NewResolver(newCountingResolverMock(nil, knownUsers, nil, lookups), "owner", "repo")
+
+			var ids []string
+			for _, logins := range tt.calls {
+				var err error
+				ids, err = resolver.UserIDs(context.Background(), logins)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(ids, tt.expectedIDs) {
+				t.Errorf("Expected IDs %v, got %v", tt.expectedIDs, ids)
+			}
+			if !reflect.DeepEqual(lookups, tt.expectedLookups) {
+				t.Errorf("Expected lookups %v, got %v", tt.expectedLookups, lookups)
+			}
+		})
+	}
+}
+
+// TestResolver_RememberLabel tests that created labels are served from the cache
+func TestResolver_RememberLabel(t *testing.T) {
+	lookups := make(map[string]int)
+	resolver := NewResolver(newCountingResolverMock(nil, nil, nil, lookups), "owner", "repo")
+	resolver.RememberLabel("new-label", "LA_new")
+
+	ids, err := resolver.LabelIDs(context.Background(), []string{"new-label"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(ids, []string{"LA_new"}) {
+		t.Errorf("Expected [LA_new], got %v", ids)
+	}
+	if len(lookups) != 0 {
+		t.Errorf("Expected no lookups for a remembered label, got %v", lookups)
+	}
+}
+
+// TestResolver_ContextCancellation tests that resolution stops when the context is cancelled
+func TestResolver_ContextCancellation(t *testing.T) {
+	lookups := make(map[string]int)
+	resolver := NewResolver(newCountingResolverMock(nil, nil, nil, lookups), "owner", "repo")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := resolver.LabelIDs(ctx, []string{"bug"})
+	if err == nil {
+		t.Fatal("Expected error for cancelled context")
+	}
+	if len(lookups) != 0 {
+		t.Errorf("Expected no lookups after cancellation, got %v", lookups)
+	}
+}
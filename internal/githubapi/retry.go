@@ -0,0 +1,91 @@
+package githubapi
+
+import (
+	"context"
+	stderrors "errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// RetryingGraphQLClient wraps a GraphQLClient and retries read queries that fail with a transient
+// server error, waiting backoff before the first retry and twice as long before each further one.
+// Mutations are never retried, since a mutation that failed on the server side may still have been applied.
+type RetryingGraphQLClient struct {
+	client  GraphQLClient
+	retries int
+	backoff time.Duration
+	logger  common.Logger
+}
+
+// NewRetryingGraphQLClient returns a GraphQLClient that retries read queries up to retries times.
+func NewRetryingGraphQLClient(client GraphQLClient, retries int, backoff time.Duration, logger common.Logger) *RetryingGraphQLClient {
+	return &RetryingGraphQLClient{
+		client:  client,
+		retries: retries,
+		backoff: backoff,
+		logger:  logger,
+	}
+}
+
+// Do delegates to the wrapped client, retrying read queries after transient errors until the
+// retries are used up or ctx is done.
+func (c *RetryingGraphQLClient) Do(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+	err := c.client.Do(ctx, query, variables, response)
+	if !isReadQuery(query) {
+		return err
+	}
+
+	delay := c.backoff
+	for attempt := 1; attempt <= c.retries && isTransientError(err); attempt++ {
+		if c.logger != nil {
+			c.logger.Debug("%s failed with a transient error, retrying in %s (%d/%d): %v", operationName(query), delay, attempt, c.retries, err)
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		err = c.client.Do(ctx, query, variables, response)
+		delay *= 2
+	}
+	return err
+}
+
+// isReadQuery reports whether query is a GraphQL query rather than a mutation
+func isReadQuery(query string) bool {
+	return !strings.HasPrefix(strings.TrimSpace(query), "mutation")
+}
+
+// isTransientError reports whether err is a server error or rate limit response that may succeed when retried
+func isTransientError(err error) bool {
+	var httpErr *api.HTTPError
+	if !stderrors.As(err, &httpErr) {
+		return false
+	}
+	return httpErr.StatusCode >= http.StatusInternalServerError || httpErr.StatusCode == http.StatusTooManyRequests
+}
+
+// IsRateLimitError reports whether err was caused by GitHub rejecting a request because a rate limit
+// was exceeded. GraphQL reports the primary rate limit as a RATE_LIMITED error; REST-style responses
+// use 403 or 429 with a rate limit message. Rate-limited requests are rejected before they run.
+func IsRateLimitError(err error) bool {
+	var gqlErr *api.GraphQLError
+	if stderrors.As(err, &gqlErr) {
+		for _, item := range gqlErr.Errors {
+			if item.Type == "RATE_LIMITED" {
+				return true
+			}
+		}
+	}
+
+	var httpErr *api.HTTPError
+	if !stderrors.As(err, &httpErr) {
+		return false
+	}
+	return (httpErr.StatusCode == http.StatusForbidden || httpErr.StatusCode == http.StatusTooManyRequests) &&
+		strings.Contains(strings.ToLower(httpErr.Message), "rate limit")
+}
@@ -0,0 +1,166 @@
+package githubapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// TestRetryingGraphQLClient tests which failures are retried and how often
+func TestRetryingGraphQLClient(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		errors        []error
+		retries       int
+		expectedCalls int
+		expectError   bool
+	}{
+		{
+			name:          "query succeeds after a transient error",
+			query:         "query GetLabelId($owner: String!) { repository }",
+			errors:        []error{&api.HTTPError{StatusCode: http.StatusBadGateway}, nil},
+			retries:       2,
+			expectedCalls: 2,
+		},
+		{
+			name:          "query fails once the retries are used up",
+			query:         "query GetLabelId($owner: String!) { repository }",
+			errors:        []error{&api.HTTPError{StatusCode: http.StatusServiceUnavailable}, &api.HTTPError{StatusCode: http.StatusTooManyRequests}, &api.HTTPError{StatusCode: http.StatusBadGateway}},
+			retries:       2,
+			expectedCalls: 3,
+			expectError:   true,
+		},
+		{
+			name:          "mutation is not retried",
+			query:         "mutation CreateIssue($input: CreateIssueInput!) { createIssue }",
+			errors:        []error{&api.HTTPError{StatusCode: http.StatusBadGateway}, nil},
+			retries:       2,
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		{
+			name:          "client error is not retried",
+			query:         "query GetLabelId($owner: String!) { repository }",
+			errors:        []error{&api.HTTPError{StatusCode: http.StatusUnauthorized}, nil},
+			retries:       2,
+			expectedCalls: 1,
+			expectError:   true,
+		},
+		{
+			name:          "GraphQL error is not retried",
+			query:         "query GetLabelId($owner: String!) { repository }",
+			errors:        []error{fmt.Errorf("NOT_FOUND"), nil},
+			retries:       2,
+			expectedCalls: 1,
+			expectError:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			inner := &testutil.SimpleMockGraphQLClient{
+				DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+					err := tt.errors[calls]
+					calls++
+					return err
+				},
+			}
+
+			client := NewRetryingGraphQLClient(inner, tt.retries, time.Millisecond, &testutil.MockLogger{})
+			err := client.Do(context.Background(), tt.query, nil, nil)
+
+			if tt.expectError != (err != nil) {
+				t.Errorf("Expected error: %v, got: %v", tt.expectError, err)
+			}
+			if calls != tt.expectedCalls {
+				t.Errorf("Expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+		})
+	}
+}
+
+// TestSetNetworkProfile tests that a profile sets the timeout and that retries are counted once per operation
+func TestSetNetworkProfile(t *testing.T) {
+	calls := 0
+	client, err := NewGHClientWithClients("owner", "repo", &testutil.SimpleMockGraphQLClient{
+		DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+			calls++
+			if calls == 1 {
+				return &api.HTTPError{StatusCode: http.StatusBadGateway}
+			}
+			return nil
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+
+	client.SetNetworkProfile(config.NetworkProfile{APITimeout: 5 * time.Second, Retries: 1, RetryBackoff: time.Millisecond})
+
+	if timeout := client.requestTimeout(); timeout != 5*time.Second {
+		t.Errorf("Expected a 5s timeout, got %s", timeout)
+	}
+	if _, err := client.ListLabels(context.Background()); err != nil {
+		t.Fatalf("Expected the transient error to be retried, got: %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+	if counts := client.APICallCounts(); !reflect.DeepEqual(counts, map[string]int{"ListLabels": 1}) {
+		t.Errorf("Expected one counted operation, got %v", counts)
+	}
+
+	client.SetNetworkProfile(config.NetworkProfile{})
+	if timeout := client.requestTimeout(); timeout != config.APITimeout {
+		t.Errorf("Expected the default timeout, got %s", timeout)
+	}
+}
+
+// TestIsRateLimitError tests which errors are recognized as rate limit rejections
+func TestIsRateLimitError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "GraphQL rate limited error",
+			err:      fmt.Errorf("create issue: %w", &api.GraphQLError{Errors: []api.GraphQLErrorItem{{Type: "RATE_LIMITED"}}}),
+			expected: true,
+		},
+		{
+			name:     "forbidden with a rate limit message",
+			err:      &api.HTTPError{StatusCode: http.StatusForbidden, Message: "API rate limit exceeded for user"},
+			expected: true,
+		},
+		{
+			name: "forbidden for another reason",
+			err:  &api.HTTPError{StatusCode: http.StatusForbidden, Message: "Resource not accessible by integration"},
+		},
+		{
+			name: "other GraphQL error",
+			err:  &api.GraphQLError{Errors: []api.GraphQLErrorItem{{Type: "NOT_FOUND"}}},
+		},
+		{
+			name: "plain error",
+			err:  fmt.Errorf("rate limit"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRateLimitError(tt.err); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
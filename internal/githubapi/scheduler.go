@@ -0,0 +1,89 @@
+package githubapi
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RequestScheduler paces GitHub API requests with a token bucket: up to burst requests are sent at
+// once, and after that one request every interval. A scheduler can be shared by the clients of several
+// repositories so that together they stay below the rate limit of the account they all use.
+// It is safe for concurrent use.
+type RequestScheduler struct {
+	interval time.Duration
+	burst    float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+
+	// now and sleep are replaced by tests to pace requests on a fake clock
+	now   func() time.Time
+	sleep func(ctx context.Context, d time.Duration) error
+}
+
+// NewRequestScheduler returns a scheduler allowing requestsPerMinute requests per minute with bursts
+// of up to burst requests. A burst below one allows a single request at a time.
+func NewRequestScheduler(requestsPerMinute, burst int) *RequestScheduler {
+	if burst < 1 {
+		burst = 1
+	}
+	return &RequestScheduler{
+		interval: time.Minute / time.Duration(requestsPerMinute),
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		now:      time.Now,
+		sleep: func(ctx context.Context, d time.Duration) error {
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-timer.C:
+				return nil
+			}
+		},
+	}
+}
+
+// Wait blocks until the next request may be sent or ctx is done. Each call reserves its turn before
+// waiting, so concurrent callers are spaced out rather than released together.
+func (s *RequestScheduler) Wait(ctx context.Context) error {
+	s.mu.Lock()
+	current := s.now()
+	if !s.last.IsZero() {
+		s.tokens += float64(current.Sub(s.last)) / float64(s.interval)
+		if s.tokens > s.burst {
+			s.tokens = s.burst
+		}
+	}
+	s.last = current
+	s.tokens--
+	wait := time.Duration(-s.tokens * float64(s.interval))
+	s.mu.Unlock()
+
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	return s.sleep(ctx, wait)
+}
+
+// SchedulingGraphQLClient wraps a GraphQLClient and waits for its scheduler before every call
+type SchedulingGraphQLClient struct {
+	client    GraphQLClient
+	scheduler *RequestScheduler
+}
+
+// NewSchedulingGraphQLClient returns a GraphQLClient whose calls are paced by scheduler
+func NewSchedulingGraphQLClient(client GraphQLClient, scheduler *RequestScheduler) *SchedulingGraphQLClient {
+	return &SchedulingGraphQLClient{client: client, scheduler: scheduler}
+}
+
+// Do waits for the scheduler and delegates to the wrapped client
+func (c *SchedulingGraphQLClient) Do(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+	if err := c.scheduler.Wait(ctx); err != nil {
+		return err
+	}
+	return c.client.Do(ctx, query, variables, response)
+}
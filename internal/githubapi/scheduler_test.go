@@ -0,0 +1,126 @@
+package githubapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// fakeClock is a clock that only moves when a scheduler sleeps on it
+type fakeClock struct {
+	current time.Time
+	slept   []time.Duration
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.current
+}
+
+func (c *fakeClock) sleep(ctx context.Context, d time.Duration) error {
+	c.slept = append(c.slept, d)
+	c.current = c.current.Add(d)
+	return nil
+}
+
+// TestRequestScheduler_SharedAcrossClients tests that clients of two repositories sharing a scheduler are paced together
+func TestRequestScheduler_SharedAcrossClients(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestsPerMinute int
+		burst             int
+		requests          int
+		expectedElapsed   time.Duration
+		expectedSleeps    int
+	}{
+		{
+			name:              "requests within the burst are not paced",
+			requestsPerMinute: 60,
+			burst:             4,
+			requests:          4,
+		},
+		{
+			name:              "requests beyond the burst are paced to the shared rate",
+			requestsPerMinute: 60,
+			burst:             2,
+			requests:          10,
+			expectedElapsed:   8 * time.Second,
+			expectedSleeps:    8,
+		},
+		{
+			name:              "tight budget",
+			requestsPerMinute: 6,
+			burst:             1,
+			requests:          4,
+			expectedElapsed:   30 * time.Second,
+			expectedSleeps:    3,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := &fakeClock{current: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			scheduler := NewRequestScheduler(tt.requestsPerMinute, tt.burst)
+			scheduler.now, scheduler.sleep = clock.now, clock.sleep
+			start := clock.current
+
+			calls := map[string]int{}
+			var clients []*GHClient
+			for _, repo := range []string{"first", "second"} {
+				client, err := NewGHClientWithClients("owner", repo, &testutil.SimpleMockGraphQLClient{
+					DoFunc: func(ctx context.Context, query string, variables map[string]interface{}, response interface{}) error {
+						calls[repo]++
+						return nil
+					},
+				})
+				if err != nil {
+					t.Fatalf("Failed to create client: %v", err)
+				}
+				client.SetScheduler(scheduler)
+				clients = append(clients, client)
+			}
+
+			for i := 0; i < tt.requests; i++ {
+				if _, err := clients[i%2].ListLabels(context.Background()); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			if calls["first"]+calls["second"] != tt.requests {
+				t.Errorf("Expected %d requests, got %v", tt.requests, calls)
+			}
+			if elapsed := clock.current.Sub(start); elapsed != tt.expectedElapsed {
+				t.Errorf("Expected the requests to take %s, got %s", tt.expectedElapsed, elapsed)
+			}
+			if len(clock.slept) != tt.expectedSleeps {
+				t.Errorf("Expected %d waits, got %v", tt.expectedSleeps, clock.slept)
+			}
+		})
+	}
+}
+
+// TestSetScheduler tests that pacing sits beneath the retries and can be removed again
+func TestSetScheduler(t *testing.T) {
+	client, err := NewGHClientWithClients("owner", "repo", &testutil.SimpleMockGraphQLClient{})
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	counter := client.gqlClient.(*CountingGraphQLClient)
+
+	client.SetScheduler(NewRequestScheduler(60, 1))
+	client.SetNetworkProfile(config.NetworkProfile{Retries: 1})
+	retrying, ok := counter.client.(*RetryingGraphQLClient)
+	if !ok {
+		t.Fatalf("Expected retries beneath the counter, got %T", counter.client)
+	}
+	if _, ok := retrying.client.(*SchedulingGraphQLClient); !ok {
+		t.Errorf("Expected pacing beneath the retries, got %T", retrying.client)
+	}
+
+	client.SetScheduler(nil)
+	if _, ok := retrying.client.(*SchedulingGraphQLClient); ok {
+		t.Error("Expected pacing to be removed")
+	}
+}
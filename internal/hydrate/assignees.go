@@ -0,0 +1,67 @@
+package hydrate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// assigneeGroupPrefix marks an assignee as a reference to a group in the assignee groups file
+const assigneeGroupPrefix = "@"
+
+// expandAssigneeGroups replaces each "@name" assignee of the issues and pull requests with the
+// members of the named group. A reference to a group that is not defined is a configuration error.
+func expandAssigneeGroups(issues []types.Issue, pullRequests []types.PullRequest, groups map[string][]string) error {
+	for i := range issues {
+		assignees, unknown := expandAssignees(issues[i].Assignees, groups)
+		if unknown != "" {
+			return errors.ConfigError("expand_assignee_groups", fmt.Sprintf("issue '%s' references unknown assignee group '%s'", issues[i].Title, unknown), nil)
+		}
+		issues[i].Assignees = assignees
+	}
+	for i := range pullRequests {
+		assignees, unknown := expandAssignees(pullRequests[i].Assignees, groups)
+		if unknown != "" {
+			return errors.ConfigError("expand_assignee_groups", fmt.Sprintf("pull request '%s' references unknown assignee group '%s'", pullRequests[i].Title, unknown), nil)
+		}
+		pullRequests[i].Assignees = assignees
+	}
+	return nil
+}
+
+// expandAssignees returns the assignees with every group reference replaced by its members,
+// keeping the first occurrence of each login. config.ViewerLogin is kept for the client to resolve. It returns the first group reference that is not
+// defined, if any.
+func expandAssignees(assignees []string, groups map[string][]string) ([]string, string) {
+	if len(assignees) == 0 {
+		return assignees, ""
+	}
+
+	expanded := make([]string, 0, len(assignees))
+	seen := make(map[string]bool)
+	add := func(login string) {
+		if !seen[login] {
+			seen[login] = true
+			expanded = append(expanded, login)
+		}
+	}
+
+	for _, assignee := range assignees {
+		name, isGroup := strings.CutPrefix(assignee, assigneeGroupPrefix)
+		if !isGroup || assignee == config.ViewerLogin {
+			add(assignee)
+			continue
+		}
+		members, found := groups[name]
+		if !found {
+			return nil, assignee
+		}
+		for _, member := range members {
+			add(member)
+		}
+	}
+	return expanded, ""
+}
@@ -0,0 +1,74 @@
+package hydrate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestExpandAssigneeGroups tests that group references are replaced by their members
+func TestExpandAssigneeGroups(t *testing.T) {
+	groups := map[string][]string{
+		"triage": {"alice", "bob"},
+		"docs":   {"bob", "carol"},
+	}
+
+	tests := []struct {
+		name           string
+		issueAssignees []string
+		prAssignees    []string
+		expectedIssue  []string
+		expectedPR     []string
+		expectError    string
+	}{
+		{
+			name:           "group is expanded",
+			issueAssignees: []string{"@triage"},
+			expectedIssue:  []string{"alice", "bob"},
+		},
+		{
+			name:           "group mixed with individual logins",
+			issueAssignees: []string{"dave", "@triage", "alice"},
+			prAssignees:    []string{"@triage", "@docs"},
+			expectedIssue:  []string{"dave", "alice", "bob"},
+			expectedPR:     []string{"alice", "bob", "carol"},
+		},
+		{
+			name:           "@me is kept alongside groups",
+			issueAssignees: []string{"@me", "@triage"},
+			expectedIssue:  []string{"@me", "alice", "bob"},
+		},
+		{
+			name:        "unknown group",
+			prAssignees: []string{"alice", "@reviewers"},
+			expectError: "pull request 'Feature' references unknown assignee group '@reviewers'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := []types.Issue{{Title: "Bug", Assignees: tt.issueAssignees}}
+			pullRequests := []types.PullRequest{{Title: "Feature", Head: "feature", Assignees: tt.prAssignees}}
+
+			err := expandAssigneeGroups(issues, pullRequests, groups)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(tt.expectedIssue) > 0 && !reflect.DeepEqual(issues[0].Assignees, tt.expectedIssue) {
+				t.Errorf("Expected issue assignees %v, got %v", tt.expectedIssue, issues[0].Assignees)
+			}
+			if len(tt.expectedPR) > 0 && !reflect.DeepEqual(pullRequests[0].Assignees, tt.expectedPR) {
+				t.Errorf("Expected pull request assignees %v, got %v", tt.expectedPR, pullRequests[0].Assignees)
+			}
+		})
+	}
+}
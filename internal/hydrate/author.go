@@ -0,0 +1,76 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// withAuthorNote starts body with a line crediting author, e.g. "_Reported by @octocat_". GitHub
+// attributes every item to the token's user, so the note is what makes a multi-author demo readable.
+// An empty author leaves body unchanged.
+func withAuthorNote(body, author string) string {
+	author = strings.TrimPrefix(strings.TrimSpace(author), "@")
+	if author == "" {
+		return body
+	}
+	note := "_Reported by @" + author + "_"
+	if body == "" {
+		return note
+	}
+	return note + "\n\n" + body
+}
+
+// annotateAuthors adds the author note to the body of every item that names an author
+func annotateAuthors(issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) {
+	for i := range issues {
+		issues[i].Body = withAuthorNote(issues[i].Body, issues[i].Author)
+	}
+	for i := range discussions {
+		discussions[i].Body = withAuthorNote(discussions[i].Body, discussions[i].Author)
+	}
+	for i := range pullRequests {
+		pullRequests[i].Body = withAuthorNote(pullRequests[i].Body, pullRequests[i].Author)
+	}
+}
+
+// resolveViewerAuthors replaces the config.ViewerLogin author of every item with the authenticated
+// user's login, looking it up once and only when an item uses it. The note is cosmetic, so a failed
+// lookup is warned about and the items keep the placeholder.
+func resolveViewerAuthors(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, logger common.Logger) error {
+	var authors []*string
+	for i := range issues {
+		authors = append(authors, &issues[i].Author)
+	}
+	for i := range discussions {
+		authors = append(authors, &discussions[i].Author)
+	}
+	for i := range pullRequests {
+		authors = append(authors, &pullRequests[i].Author)
+	}
+
+	login := ""
+	for _, author := range authors {
+		if strings.TrimSpace(*author) != config.ViewerLogin {
+			continue
+		}
+		if login == "" {
+			viewer, err := client.GetViewer(ctx)
+			if err != nil {
+				if errors.IsContextError(err) {
+					return err
+				}
+				logger.Info("Warning: could not resolve %s author to the authenticated user: %v", config.ViewerLogin, err)
+				return nil
+			}
+			login = viewer.Login
+		}
+		*author = login
+	}
+	return nil
+}
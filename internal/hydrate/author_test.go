@@ -0,0 +1,130 @@
+package hydrate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestWithAuthorNote tests that the author note starts the body and that an empty author changes nothing
+func TestWithAuthorNote(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		author   string
+		expected string
+	}{
+		{
+			name:     "note starts the body",
+			body:     "Steps to reproduce",
+			author:   "octocat",
+			expected: "_Reported by @octocat_\n\nSteps to reproduce",
+		},
+		{
+			name:     "leading at sign is not doubled",
+			body:     "Body",
+			author:   "@hubot",
+			expected: "_Reported by @hubot_\n\nBody",
+		},
+		{
+			name:     "empty body",
+			author:   "octocat",
+			expected: "_Reported by @octocat_",
+		},
+		{
+			name:     "empty author is a no-op",
+			body:     "Body",
+			author:   " ",
+			expected: "Body",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withAuthorNote(tt.body, tt.author); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestAnnotateAuthors tests that only items naming an author are annotated
+func TestAnnotateAuthors(t *testing.T) {
+	issues := []types.Issue{{Title: "Bug", Body: "Crash", Author: "alice"}, {Title: "Other", Body: "Unchanged"}}
+	discussions := []types.Discussion{{Title: "Idea", Body: "Dark mode", Author: "bob"}}
+	pullRequests := []types.PullRequest{{Title: "Fix", Body: "Fixes the crash", Author: "carol"}}
+
+	annotateAuthors(issues, discussions, pullRequests)
+
+	if issues[0].Body != "_Reported by @alice_\n\nCrash" || issues[1].Body != "Unchanged" {
+		t.Errorf("Unexpected issue bodies: %q, %q", issues[0].Body, issues[1].Body)
+	}
+	if discussions[0].Body != "_Reported by @bob_\n\nDark mode" {
+		t.Errorf("Unexpected discussion body: %q", discussions[0].Body)
+	}
+	if pullRequests[0].Body != "_Reported by @carol_\n\nFixes the crash" {
+		t.Errorf("Unexpected pull request body: %q", pullRequests[0].Body)
+	}
+}
+
+// TestResolveViewerAuthors tests that @me authors become the authenticated user's login, looked up only when used
+func TestResolveViewerAuthors(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           MockConfig
+		issueAuthors     []string
+		discussionAuthor string
+		expected         []string
+		expectWarning    bool
+	}{
+		{
+			name:             "@me mixed with explicit authors",
+			config:           MockConfig{ViewerLogin: "octocat"},
+			issueAuthors:     []string{"@me", "alice", ""},
+			discussionAuthor: "@me",
+			expected:         []string{"octocat", "alice", ""},
+		},
+		{
+			name:         "viewer is not looked up without @me",
+			config:       MockConfig{Viewer: testutil.ErrorConfig{ShouldError: true}},
+			issueAuthors: []string{"alice"},
+			expected:     []string{"alice"},
+		},
+		{
+			name:          "failed lookup keeps the placeholder",
+			config:        MockConfig{Viewer: testutil.ErrorConfig{ShouldError: true}},
+			issueAuthors:  []string{"@me"},
+			expected:      []string{"@me"},
+			expectWarning: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var issues []types.Issue
+			for _, author := range tt.issueAuthors {
+				issues = append(issues, types.Issue{Title: "Bug", Author: author})
+			}
+			discussions := []types.Discussion{{Title: "Idea", Author: tt.discussionAuthor}}
+			logger := &testutil.MockLogger{}
+
+			if err := resolveViewerAuthors(context.Background(), NewFailingMockGitHubClient(tt.config), issues, discussions, nil, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			for i, expected := range tt.expected {
+				if issues[i].Author != expected {
+					t.Errorf("Expected author %d to be %q, got %q", i, expected, issues[i].Author)
+				}
+			}
+			if tt.discussionAuthor == "@me" && discussions[0].Author != "octocat" {
+				t.Errorf("Expected the discussion author to be resolved, got %q", discussions[0].Author)
+			}
+			if warned := len(logger.InfoCalls) > 0; warned != tt.expectWarning {
+				t.Errorf("Expected warning %v, got: %v", tt.expectWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// truncationNote is appended to bodies that were shortened to fit within config.MaxBodyLength
+const truncationNote = "\n\n… (truncated by gh-demo: body exceeded GitHub's length limit)"
+
+// truncateBody shortens body so that, including the truncation note, it fits within maxLength characters.
+func truncateBody(body string, maxLength int) string {
+	keep := maxLength - utf8.RuneCountInString(truncationNote)
+	if keep < 0 {
+		keep = 0
+	}
+	runes := []rune(body)
+	return string(runes[:keep]) + truncationNote
+}
+
+// checkBodyLengths validates every body against GitHub's length limit before anything is created,
+// so an oversized body fails up front instead of partway through hydration. When truncate is true,
+// oversized bodies are truncated in place with a note and a warning is logged instead.
+func checkBodyLengths(ctx context.Context, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, truncate bool, logger common.Logger) error {
+	var violations []string
+
+	check := func(itemType string, index int, title string, body *string) {
+		length := utf8.RuneCountInString(*body)
+		if length <= config.MaxBodyLength {
+			return
+		}
+		if truncate {
+			*body = truncateBody(*body, config.MaxBodyLength)
+			logger.Info("Warning: truncated body of %s %d (%s) from %d to %d characters", itemType, index+1, title, length, config.MaxBodyLength)
+			return
+		}
+		violations = append(violations, fmt.Sprintf("%s %d (%s): body is %d characters", itemType, index+1, title, length))
+	}
+
+	for i := range issues {
+		check("issue", i, issues[i].Title, &issues[i].Body)
+	}
+	for i := range discussions {
+		check("discussion", i, discussions[i].Title, &discussions[i].Body)
+	}
+	for i := range pullRequests {
+		check("pull request", i, pullRequests[i].Title, &pullRequests[i].Body)
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	message := fmt.Sprintf("%d bodies exceed the %d character limit (use --truncate-bodies to truncate them): %s",
+		len(violations), config.MaxBodyLength, strings.Join(violations, "; "))
+	return errors.ValidationError("validate_body_length", message)
+}
+
+// previewBody collapses body onto a single line and truncates it to maxLength characters for dry-run output.
+func previewBody(body string, maxLength int) string {
+	preview := strings.Join(strings.Fields(body), " ")
+	runes := []rune(preview)
+	if len(runes) <= maxLength {
+		return preview
+	}
+	return string(runes[:maxLength]) + "…"
+}
+
+// logBodyPreview logs a truncated preview of the body of item when maxLength is positive.
+func logBodyPreview(item any, maxLength int, logger common.Logger) {
+	if maxLength <= 0 {
+		return
+	}
+
+	var body string
+	switch v := item.(type) {
+	case types.Issue:
+		body = v.Body
+	case types.Discussion:
+		body = v.Body
+	case types.PullRequest:
+		body = v.Body
+	}
+
+	logger.Info("  Body: %s", previewBody(body, maxLength))
+}
@@ -0,0 +1,138 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestCheckBodyLengths tests the body length guard in error and truncation modes
+func TestCheckBodyLengths(t *testing.T) {
+	longBody := strings.Repeat("é", config.MaxBodyLength+10)
+
+	tests := []struct {
+		name          string
+		truncate      bool
+		expectError   bool
+		errorContains []string
+	}{
+		{
+			name:          "over-limit body errors with item index",
+			truncate:      false,
+			expectError:   true,
+			errorContains: []string{"issue 2 (Too Long)", "--truncate-bodies"},
+		},
+		{
+			name:     "over-limit body is truncated",
+			truncate: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := []types.Issue{
+				{Title: "Short", Body: "fine"},
+				{Title: "Too Long", Body: longBody},
+			}
+			logger := &testutil.MockLogger{}
+
+			err := checkBodyLengths(context.Background(), issues, nil, nil, tt.truncate, logger)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				for _, expected := range tt.errorContains {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("Expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				if issues[1].Body != longBody {
+					t.Error("Expected body to be left unchanged when not truncating")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if issues[0].Body != "fine" {
+				t.Errorf("Expected short body to be unchanged, got %q", issues[0].Body)
+			}
+			if length := utf8.RuneCountInString(issues[1].Body); length != config.MaxBodyLength {
+				t.Errorf("Expected truncated body of %d characters, got %d", config.MaxBodyLength, length)
+			}
+			if !strings.HasSuffix(issues[1].Body, truncationNote) {
+				t.Error("Expected truncated body to end with the truncation note")
+			}
+			if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Warning: truncated body of issue 2 (Too Long)") {
+				t.Errorf("Expected truncation warning, got: %v", logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestCreateItems_DryRunBodyPreview tests that dry-run shows body previews only when enabled
+func TestCreateItems_DryRunBodyPreview(t *testing.T) {
+	tests := []struct {
+		name            string
+		body            string
+		previewLength   int
+		expectedPreview string
+	}{
+		{
+			name:            "short body shown in full on one line",
+			body:            "Steps to reproduce:\n\n1. Log in",
+			previewLength:   50,
+			expectedPreview: "  Body: Steps to reproduce: 1. Log in",
+		},
+		{
+			name:            "long body truncated at configured length",
+			body:            "abcdefghijklmnopqrstuvwxyz",
+			previewLength:   10,
+			expectedPreview: "  Body: abcdefghij…",
+		},
+		{
+			name:          "preview disabled",
+			body:          "Hidden body",
+			previewLength: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{DryRun: true, BodyPreviewLength: tt.previewLength}
+
+			if _, _, err := createIssues(context.Background(), client, []types.Issue{{Title: "Bug", Body: tt.body}}, logger, options); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var previews []string
+			for _, call := range logger.InfoCalls {
+				if strings.HasPrefix(call, "  Body:") {
+					previews = append(previews, call)
+				}
+			}
+
+			if tt.expectedPreview == "" {
+				if len(previews) != 0 {
+					t.Errorf("Expected no body preview, got %v", previews)
+				}
+				return
+			}
+			if len(previews) != 1 || previews[0] != tt.expectedPreview {
+				t.Errorf("Expected preview %q, got %v", tt.expectedPreview, previews)
+			}
+			if len(client.CreatedIssues) != 0 {
+				t.Errorf("Expected no issues created in dry-run, got %d", len(client.CreatedIssues))
+			}
+		})
+	}
+}
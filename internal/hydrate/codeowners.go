@@ -0,0 +1,96 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// globalCodeownersPatterns are the CODEOWNERS patterns that match every path in the repository
+var globalCodeownersPatterns = map[string]bool{"*": true, "**": true, "/**": true}
+
+// globalCodeowners returns the owners of every path: those of the last rule whose pattern matches
+// everything, since later rules take precedence. Email owners are left out, as reviews can only be
+// requested from users and teams.
+func globalCodeowners(rules []types.CodeownersRule) []string {
+	for i := len(rules) - 1; i >= 0; i-- {
+		if !globalCodeownersPatterns[rules[i].Pattern] {
+			continue
+		}
+		var owners []string
+		for _, owner := range rules[i].Owners {
+			if !strings.Contains(owner, "@") {
+				owners = append(owners, owner)
+			}
+		}
+		return owners
+	}
+	return nil
+}
+
+// withCodeownerReviewers wraps a pull request create function so that the repository's global code
+// owners are requested as reviewers on each created pull request. Demo pull requests have no diff to
+// match path rules against, so only the rule that matches every path applies. The CODEOWNERS file and
+// the authenticated user, who cannot review their own pull request, are looked up once per run. Review
+// requests that fail are warned about and skipped, since the pull request itself was created.
+func withCodeownerReviewers(create createFunc[types.PullRequest], client githubapi.GitHubClient, logger common.Logger) createFunc[types.PullRequest] {
+	var reviewers []string
+	loaded := false
+
+	load := func(ctx context.Context) error {
+		rules, err := client.GetCodeowners(ctx)
+		if err != nil {
+			return err
+		}
+		owners := globalCodeowners(rules)
+		if len(owners) == 0 {
+			logger.Debug("CODEOWNERS has no owners for every path; no reviewers will be requested")
+			return nil
+		}
+
+		author := ""
+		if viewer, err := client.GetViewer(ctx); err == nil {
+			author = viewer.Login
+		} else if errors.IsContextError(err) {
+			return err
+		}
+		for _, owner := range owners {
+			if !strings.EqualFold(owner, author) {
+				reviewers = append(reviewers, owner)
+			}
+		}
+		logger.Debug("Requesting reviews from code owners: %s", strings.Join(reviewers, ", "))
+		return nil
+	}
+
+	return func(ctx context.Context, pullRequest types.PullRequest) (*types.CreatedItemInfo, error) {
+		info, err := create(ctx, pullRequest)
+		if err != nil || info == nil {
+			return info, err
+		}
+
+		if !loaded {
+			if err := load(ctx); err != nil {
+				if errors.IsContextError(err) {
+					return info, nil
+				}
+				logger.Info("Warning: could not read CODEOWNERS, no reviewers will be requested: %v", err)
+			}
+			loaded = true
+		}
+		if len(reviewers) == 0 {
+			return info, nil
+		}
+
+		if err := client.RequestReviews(ctx, info.NodeID, reviewers); err != nil {
+			logger.Info("Warning: could not request reviews on pull request '%s': %v", info.Title, err)
+			return info, nil
+		}
+		logger.Debug("Requested reviews on pull request '%s' from %s", info.Title, strings.Join(reviewers, ", "))
+		return info, nil
+	}
+}
@@ -0,0 +1,120 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestGlobalCodeowners tests that the last rule matching every path supplies the owners
+func TestGlobalCodeowners(t *testing.T) {
+	tests := []struct {
+		name     string
+		rules    []types.CodeownersRule
+		expected []string
+	}{
+		{
+			name: "last global rule wins and emails are left out",
+			rules: []types.CodeownersRule{
+				{Pattern: "*", Owners: []string{"old-owner"}},
+				{Pattern: "*.go", Owners: []string{"gopher"}},
+				{Pattern: "*", Owners: []string{"octocat", "my-org/reviewers", "team@example.com"}},
+				{Pattern: "/docs/", Owners: []string{"writer"}},
+			},
+			expected: []string{"octocat", "my-org/reviewers"},
+		},
+		{
+			name:  "no global rule",
+			rules: []types.CodeownersRule{{Pattern: "/docs/", Owners: []string{"writer"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if owners := globalCodeowners(tt.rules); !reflect.DeepEqual(owners, tt.expected) {
+				t.Errorf("Expected owners %v, got %v", tt.expected, owners)
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_UseCodeowners tests that the global code owners are requested as reviewers
+// on created pull requests, except the authenticated user
+func TestHydrateWithOptions_UseCodeowners(t *testing.T) {
+	tempDir := t.TempDir()
+	prsJSON := `[
+		{"title": "First", "body": "Body", "head": "feature-1", "base": "main"},
+		{"title": "Second", "body": "Body", "head": "feature-2", "base": "main"}
+	]`
+	if err := os.WriteFile(filepath.Join(tempDir, "prs.json"), []byte(prsJSON), 0644); err != nil {
+		t.Fatalf("Failed to create prs.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	tests := []struct {
+		name              string
+		config            MockConfig
+		useCodeowners     bool
+		expectedReviewers []string
+		expectedWarning   string
+	}{
+		{
+			name: "global owners are requested",
+			config: MockConfig{
+				ViewerLogin: "demo-bot",
+				Codeowners:  []types.CodeownersRule{{Pattern: "*", Owners: []string{"octocat", "demo-bot", "my-org/reviewers"}}},
+			},
+			useCodeowners:     true,
+			expectedReviewers: []string{"octocat", "my-org/reviewers"},
+		},
+		{
+			name:          "disabled by default",
+			config:        MockConfig{Codeowners: []types.CodeownersRule{{Pattern: "*", Owners: []string{"octocat"}}}},
+			useCodeowners: false,
+		},
+		{
+			name:            "failed review requests are warned about",
+			config:          MockConfig{Codeowners: []types.CodeownersRule{{Pattern: "*", Owners: []string{"octocat"}}}, RequestReviews: testutil.ErrorConfig{ShouldError: true}},
+			useCodeowners:   true,
+			expectedWarning: "Warning: could not request reviews on pull request 'First'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.config)
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludePullRequests: true, UseCodeowners: tt.useCodeowners}
+
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(client.CreatedPRs) != 2 {
+				t.Fatalf("Expected 2 pull requests, got %d", len(client.CreatedPRs))
+			}
+			if tt.expectedReviewers == nil {
+				if len(client.ReviewRequests) != 0 {
+					t.Errorf("Expected no review requests, got %v", client.ReviewRequests)
+				}
+			} else if len(client.ReviewRequests) != 2 {
+				t.Errorf("Expected reviews requested on both pull requests, got %v", client.ReviewRequests)
+			}
+			for nodeID, reviewers := range client.ReviewRequests {
+				if !reflect.DeepEqual(reviewers, tt.expectedReviewers) {
+					t.Errorf("Expected reviewers %v on %s, got %v", tt.expectedReviewers, nodeID, reviewers)
+				}
+			}
+			if tt.expectedWarning != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedWarning) {
+				t.Errorf("Expected warning %q, got: %v", tt.expectedWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
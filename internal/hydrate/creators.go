@@ -0,0 +1,219 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// issueCreator returns the function used to create issues, wrapped with the rate limit, timeout
+// recovery, manifest, upsert, dependency, project and delay steps that options enable.
+func issueCreator(client githubapi.GitHubClient, logger common.Logger, options HydrateOptions) createFunc[types.Issue] {
+	create := createFunc[types.Issue](client.CreateIssue)
+	create = withRateLimitWait(create, client, options.RateLimitWait, logger)
+	if options.Idempotent {
+		create = withTimeoutRecovery(create, findIssueByTitle(client),
+			func(issue types.Issue) string { return issue.Title }, "issue", logger)
+	}
+	create = withManifestRecording(create, options.Manifest)
+	if options.Upsert {
+		create = withUpsert(create, client, logger)
+	}
+	if options.BlockedByLines {
+		create = withBlockedByLines(create, options.Manifest, logger)
+	}
+	create = withProjectAssociation(create, func(issue types.Issue) []int { return issue.Projects }, client, logger)
+	return withDelay(create, options.Delay)
+}
+
+// discussionCreator returns the function used to create discussions, wrapped with the default
+// category and the rate limit, timeout recovery, manifest and delay steps that options enable.
+func discussionCreator(client githubapi.GitHubClient, logger common.Logger, options HydrateOptions) createFunc[types.Discussion] {
+	create := createFunc[types.Discussion](client.CreateDiscussion)
+	create = withRateLimitWait(create, client, options.RateLimitWait, logger)
+	if options.Idempotent {
+		create = withTimeoutRecovery(create, findDiscussionByTitle(client),
+			func(discussion types.Discussion) string { return discussion.Title }, "discussion", logger)
+	}
+	create = withManifestRecording(create, options.Manifest)
+	create = withDefaultCategory(create, options.DefaultDiscussionCategory)
+	return withDelay(create, options.Delay)
+}
+
+// pullRequestCreator returns the function used to create pull requests, wrapped with the default
+// base branch, auto-merge and reviews, and the rate limit, timeout recovery, manifest, code owner,
+// project and delay steps that options enable.
+func pullRequestCreator(client githubapi.GitHubClient, logger common.Logger, options HydrateOptions) createFunc[types.PullRequest] {
+	create := createFunc[types.PullRequest](client.CreatePR)
+	create = withRateLimitWait(create, client, options.RateLimitWait, logger)
+	if options.Idempotent {
+		create = withTimeoutRecovery(create, findPullRequestByTitle(client),
+			func(pullRequest types.PullRequest) string { return pullRequest.Title }, "pull request", logger)
+	}
+	create = withManifestRecording(create, options.Manifest)
+	create = withAutoMerge(create, client, logger)
+	create = withReviews(create, client, logger)
+	if options.UseCodeowners {
+		create = withCodeownerReviewers(create, client, logger)
+	}
+	create = withProjectAssociation(create, func(pullRequest types.PullRequest) []int { return pullRequest.Projects }, client, logger)
+	defaultBase := staticBase(options.DefaultBase)
+	if strings.TrimSpace(options.DefaultBase) == "" && options.DetectDefaultBase {
+		defaultBase = detectedDefaultBranch(client, logger)
+	}
+	create = withDefaultBase(create, defaultBase)
+	return withDelay(create, options.Delay)
+}
+
+// withDefaultCategory wraps a discussion create function so that discussions without a
+// category use defaultCategory. A discussion with neither is rejected before any API call.
+func withDefaultCategory(create createFunc[types.Discussion], defaultCategory string) createFunc[types.Discussion] {
+	return func(ctx context.Context, discussion types.Discussion) (*types.CreatedItemInfo, error) {
+		if strings.TrimSpace(discussion.Category) == "" {
+			if defaultCategory == "" {
+				err := errors.ValidationError("validate_discussion_category", "discussion has no category and no default discussion category is configured")
+				return nil, errors.WithContextSafe(err, "title", discussion.Title)
+			}
+			discussion.Category = defaultCategory
+		}
+		return create(ctx, discussion)
+	}
+}
+
+// baseResolver returns the base branch for pull requests that do not specify one, or "" when there is none
+type baseResolver func(ctx context.Context) (string, error)
+
+// staticBase resolves to the configured default base branch
+func staticBase(defaultBase string) baseResolver {
+	defaultBase = strings.TrimSpace(defaultBase)
+	return func(context.Context) (string, error) { return defaultBase, nil }
+}
+
+// detectedDefaultBranch resolves to the repository's default branch. The branch is looked up the
+// first time a pull request needs it and cached for the rest of the run.
+func detectedDefaultBranch(client githubapi.GitHubClient, logger common.Logger) baseResolver {
+	var branch string
+	return func(ctx context.Context) (string, error) {
+		if branch != "" {
+			return branch, nil
+		}
+		detected, err := client.GetDefaultBranch(ctx)
+		if err != nil {
+			return "", err
+		}
+		branch = detected
+		logger.Debug("Using default branch '%s' as the base for pull requests without one", branch)
+		return branch, nil
+	}
+}
+
+// withDefaultBase wraps a pull request create function so that pull requests without a base
+// branch use the branch from defaultBase. A pull request with neither is rejected before any API call.
+func withDefaultBase(create createFunc[types.PullRequest], defaultBase baseResolver) createFunc[types.PullRequest] {
+	return func(ctx context.Context, pullRequest types.PullRequest) (*types.CreatedItemInfo, error) {
+		if strings.TrimSpace(pullRequest.Base) == "" {
+			base, err := defaultBase(ctx)
+			if err != nil {
+				if errors.IsContextError(err) {
+					return nil, err
+				}
+				err = errors.WrapWithOperation(err, "validation", "validate_pr", "pull request has no base branch and the default branch could not be detected")
+				return nil, errors.WithContextSafe(err, "title", pullRequest.Title)
+			}
+			if base == "" {
+				err := errors.ValidationError("validate_pr", "pull request has no base branch and no default base is configured")
+				return nil, errors.WithContextSafe(err, "title", pullRequest.Title)
+			}
+			pullRequest.Base = base
+		}
+		return create(ctx, pullRequest)
+	}
+}
+
+// withProjectAssociation wraps a create function so that each created item is added to the
+// projects listed by getProjects. Project numbers are resolved once per run; a project that
+// cannot be found or an item that cannot be added is warned about and skipped, since the
+// item itself was created successfully.
+func withProjectAssociation[T any](create createFunc[T], getProjects func(T) []int, client githubapi.GitHubClient, logger common.Logger) createFunc[T] {
+	projectIDs := make(map[int]string) // project number -> node ID ("" when it could not be resolved)
+
+	return func(ctx context.Context, item T) (*types.CreatedItemInfo, error) {
+		info, err := create(ctx, item)
+		if err != nil || info == nil {
+			return info, err
+		}
+
+		for _, number := range getProjects(item) {
+			projectID, resolved := projectIDs[number]
+			if !resolved {
+				project, err := client.GetProjectV2ByNumber(ctx, number)
+				if err != nil {
+					logger.Info("Warning: project #%d not found, skipping: %v", number, err)
+				} else {
+					projectID = project.ID
+				}
+				projectIDs[number] = projectID
+			}
+			if projectID == "" {
+				logger.Debug("Skipping unresolved project #%d for %s '%s'", number, info.Type, info.Title)
+				continue
+			}
+
+			if err := client.AddItemToProjectV2(ctx, projectID, info.NodeID); err != nil {
+				logger.Info("Warning: failed to add %s '%s' to project #%d: %v", info.Type, info.Title, number, err)
+				continue
+			}
+			logger.Debug("Added %s '%s' to project #%d", info.Type, info.Title, number)
+		}
+
+		return info, nil
+	}
+}
+
+// withAutoMerge wraps a pull request create function so that auto-merge is enabled on pull
+// requests that ask for it. The pull request already exists at that point, so a failure is
+// logged as a warning with GitHub's reason rather than reported as a failed creation.
+func withAutoMerge(create createFunc[types.PullRequest], client githubapi.GitHubClient, logger common.Logger) createFunc[types.PullRequest] {
+	return func(ctx context.Context, pullRequest types.PullRequest) (*types.CreatedItemInfo, error) {
+		info, err := create(ctx, pullRequest)
+		if err != nil || info == nil || !pullRequest.AutoMerge {
+			return info, err
+		}
+
+		if err := client.EnablePullRequestAutoMerge(ctx, info.NodeID, pullRequest.AutoMergeMethod); err != nil {
+			logger.Info("Warning: could not enable auto-merge on pull request '%s': %v", info.Title, err)
+			return info, nil
+		}
+		logger.Debug("Enabled auto-merge on pull request '%s'", info.Title)
+		return info, nil
+	}
+}
+
+// withReviews wraps a pull request create function so that the reviews a pull request lists are
+// submitted once it exists, in order, as COMMENT when no event is given. GitHub does not let the
+// author approve or request changes on their own pull request, so a failed review is only warned about.
+func withReviews(create createFunc[types.PullRequest], client githubapi.GitHubClient, logger common.Logger) createFunc[types.PullRequest] {
+	return func(ctx context.Context, pullRequest types.PullRequest) (*types.CreatedItemInfo, error) {
+		info, err := create(ctx, pullRequest)
+		if err != nil || info == nil {
+			return info, err
+		}
+
+		for _, review := range pullRequest.Reviews {
+			event := review.Event
+			if event == "" {
+				event = "COMMENT"
+			}
+			if err := client.AddPullRequestReview(ctx, info.NodeID, review.Body, event); err != nil {
+				logger.Info("Warning: could not add %s review to pull request '%s': %v", strings.ToUpper(event), info.Title, err)
+				continue
+			}
+			logger.Debug("Added %s review to pull request '%s'", strings.ToUpper(event), info.Title)
+		}
+		return info, nil
+	}
+}
@@ -0,0 +1,380 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestDiscussionCreator_DefaultCategory tests how the default discussion category is applied
+func TestDiscussionCreator_DefaultCategory(t *testing.T) {
+	tests := []struct {
+		name             string
+		itemCategory     string
+		defaultCategory  string
+		expectError      bool
+		expectedCategory string
+	}{
+		{
+			name:             "default applied when item omits category",
+			itemCategory:     "",
+			defaultCategory:  "General",
+			expectedCategory: "General",
+		},
+		{
+			name:             "item category overrides default",
+			itemCategory:     "Ideas",
+			defaultCategory:  "General",
+			expectedCategory: "Ideas",
+		},
+		{
+			name:            "neither item nor default specifies category",
+			itemCategory:    "  ",
+			defaultCategory: "",
+			expectError:     true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			options := HydrateOptions{DefaultDiscussionCategory: tt.defaultCategory}
+			create := discussionCreator(client, &testutil.MockLogger{}, options)
+
+			_, err := create(context.Background(), types.Discussion{Title: "Welcome", Category: tt.itemCategory})
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected validation error but got none")
+				}
+				if !strings.Contains(err.Error(), "no default discussion category") {
+					t.Errorf("Expected clear category error, got: %v", err)
+				}
+				if len(client.CreatedDiscussions) != 0 {
+					t.Errorf("Expected no discussion to be created, got %d", len(client.CreatedDiscussions))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(client.CreatedDiscussions) != 1 {
+				t.Fatalf("Expected 1 discussion created, got %d", len(client.CreatedDiscussions))
+			}
+			if got := client.CreatedDiscussions[0].Category; got != tt.expectedCategory {
+				t.Errorf("Expected category %q, got %q", tt.expectedCategory, got)
+			}
+		})
+	}
+}
+
+// TestIssueCreator_ProjectAssociation tests adding created issues to the projects they reference
+func TestIssueCreator_ProjectAssociation(t *testing.T) {
+	tests := []struct {
+		name            string
+		projects        []int
+		existing        []int
+		expectedItems   map[string]int
+		expectedWarning string
+	}{
+		{
+			name:          "item added to one project",
+			projects:      []int{2},
+			existing:      []int{2},
+			expectedItems: map[string]int{"mock-project-2": 1},
+		},
+		{
+			name:            "missing project number is warned and skipped",
+			projects:        []int{7, 2},
+			existing:        []int{2},
+			expectedItems:   map[string]int{"mock-project-2": 1},
+			expectedWarning: "project #7 not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(MockConfig{ExistingProjectNumbers: tt.existing})
+			logger := &testutil.MockLogger{}
+			create := issueCreator(client, logger, HydrateOptions{})
+
+			info, err := create(context.Background(), types.Issue{Title: "Roadmap item", Projects: tt.projects})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if info == nil || len(client.CreatedIssues) != 1 {
+				t.Fatalf("Expected the issue to be created, got %d created", len(client.CreatedIssues))
+			}
+
+			if len(client.ProjectItems) != len(tt.expectedItems) {
+				t.Errorf("Expected items in %d projects, got %v", len(tt.expectedItems), client.ProjectItems)
+			}
+			for projectID, count := range tt.expectedItems {
+				if got := len(client.ProjectItems[projectID]); got != count {
+					t.Errorf("Expected %d items in %s, got %d", count, projectID, got)
+				}
+			}
+
+			if tt.expectedWarning != "" {
+				found := false
+				for _, call := range logger.InfoCalls {
+					if strings.Contains(call, tt.expectedWarning) {
+						found = true
+					}
+				}
+				if !found {
+					t.Errorf("Expected warning containing %q, got: %v", tt.expectedWarning, logger.InfoCalls)
+				}
+			}
+		})
+	}
+}
+
+// TestPullRequestCreator_AutoMerge tests enabling auto-merge on created pull requests
+func TestPullRequestCreator_AutoMerge(t *testing.T) {
+	tests := []struct {
+		name            string
+		pullRequest     types.PullRequest
+		mockConfig      MockConfig
+		expectedMethods map[string]string
+		expectedWarning string
+	}{
+		{
+			name:            "auto-merge enabled when requested",
+			pullRequest:     types.PullRequest{Title: "Queue me", Head: "feature", Base: "main", AutoMerge: true, AutoMergeMethod: "SQUASH"},
+			expectedMethods: map[string]string{"mock-pr-id-1": "SQUASH"},
+		},
+		{
+			name:            "auto-merge not requested",
+			pullRequest:     types.PullRequest{Title: "Plain PR", Head: "feature", Base: "main"},
+			expectedMethods: map[string]string{},
+		},
+		{
+			name:        "unsupported repository is warned without failing the pull request",
+			pullRequest: types.PullRequest{Title: "Queue me", Head: "feature", Base: "main", AutoMerge: true},
+			mockConfig: MockConfig{
+				AutoMerge: testutil.ErrorConfig{ShouldError: true, ErrorMessage: "auto-merge is not available"},
+			},
+			expectedMethods: map[string]string{},
+			expectedWarning: "could not enable auto-merge on pull request 'Queue me'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.mockConfig)
+			logger := &testutil.MockLogger{}
+			create := pullRequestCreator(client, logger, HydrateOptions{})
+
+			if _, err := create(context.Background(), tt.pullRequest); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(client.CreatedPRs) != 1 {
+				t.Fatalf("Expected 1 pull request created, got %d", len(client.CreatedPRs))
+			}
+
+			if len(client.AutoMergePRs) != len(tt.expectedMethods) {
+				t.Errorf("Expected auto-merge on %v, got %v", tt.expectedMethods, client.AutoMergePRs)
+			}
+			for nodeID, method := range tt.expectedMethods {
+				if got, ok := client.AutoMergePRs[nodeID]; !ok || got != method {
+					t.Errorf("Expected auto-merge method %q on %s, got %q", method, nodeID, got)
+				}
+			}
+
+			if tt.expectedWarning != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedWarning) {
+				t.Errorf("Expected warning containing %q, got: %v", tt.expectedWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestPullRequestCreator_Reviews tests submitting the configured reviews on created pull requests
+func TestPullRequestCreator_Reviews(t *testing.T) {
+	tests := []struct {
+		name            string
+		reviews         []types.PRReview
+		mockConfig      MockConfig
+		expectedReviews []types.PRReview
+		expectedWarning string
+	}{
+		{
+			name:            "comment review",
+			reviews:         []types.PRReview{{Body: "Looks like a good start"}},
+			expectedReviews: []types.PRReview{{Body: "Looks like a good start", Event: "COMMENT"}},
+		},
+		{
+			name:            "reviews submitted in order",
+			reviews:         []types.PRReview{{Body: "Please add tests", Event: "REQUEST_CHANGES"}, {Event: "APPROVE"}},
+			expectedReviews: []types.PRReview{{Body: "Please add tests", Event: "REQUEST_CHANGES"}, {Event: "APPROVE"}},
+		},
+		{
+			name:            "failed review is warned without failing the pull request",
+			reviews:         []types.PRReview{{Event: "APPROVE"}},
+			mockConfig:      MockConfig{AddPullRequestReview: testutil.ErrorConfig{ShouldError: true, ErrorMessage: "can not approve your own pull request"}},
+			expectedWarning: "could not add APPROVE review to pull request 'Review me'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.mockConfig)
+			logger := &testutil.MockLogger{}
+			create := pullRequestCreator(client, logger, HydrateOptions{})
+
+			pullRequest := types.PullRequest{Title: "Review me", Head: "feature", Base: "main", Reviews: tt.reviews}
+			if _, err := create(context.Background(), pullRequest); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if reviews := client.Reviews["mock-pr-id-1"]; !reflect.DeepEqual(reviews, tt.expectedReviews) {
+				t.Errorf("Expected reviews %+v, got %+v", tt.expectedReviews, reviews)
+			}
+			if tt.expectedWarning != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedWarning) {
+				t.Errorf("Expected warning containing %q, got: %v", tt.expectedWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestPullRequestCreator_DefaultBase tests how the default base branch is applied
+func TestPullRequestCreator_DefaultBase(t *testing.T) {
+	tests := []struct {
+		name         string
+		itemBase     string
+		defaultBase  string
+		expectError  bool
+		expectedBase string
+	}{
+		{
+			name:         "default applied when item omits base",
+			itemBase:     "",
+			defaultBase:  "main",
+			expectedBase: "main",
+		},
+		{
+			name:         "item base overrides default",
+			itemBase:     "develop",
+			defaultBase:  "main",
+			expectedBase: "develop",
+		},
+		{
+			name:        "neither item nor default specifies base",
+			itemBase:    "",
+			defaultBase: " ",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			create := pullRequestCreator(client, &testutil.MockLogger{}, HydrateOptions{DefaultBase: tt.defaultBase})
+
+			_, err := create(context.Background(), types.PullRequest{Title: "Feature", Head: "feature", Base: tt.itemBase})
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected validation error but got none")
+				}
+				if !strings.Contains(err.Error(), "no default base") {
+					t.Errorf("Expected clear base error, got: %v", err)
+				}
+				if len(client.CreatedPRs) != 0 {
+					t.Errorf("Expected no pull request to be created, got %d", len(client.CreatedPRs))
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(client.CreatedPRs) != 1 {
+				t.Fatalf("Expected 1 pull request created, got %d", len(client.CreatedPRs))
+			}
+			if got := client.CreatedPRs[0].Base; got != tt.expectedBase {
+				t.Errorf("Expected base %q, got %q", tt.expectedBase, got)
+			}
+		})
+	}
+}
+
+// TestPullRequestCreator_DetectedDefaultBase tests that the repository's default branch is detected once and applied
+func TestPullRequestCreator_DetectedDefaultBase(t *testing.T) {
+	tests := []struct {
+		name          string
+		defaultBase   string
+		itemBases     []string
+		lookupFails   bool
+		expectError   bool
+		expectedBases []string
+		expectedCalls int
+	}{
+		{
+			name:          "detected base applied and cached across pull requests",
+			itemBases:     []string{"", "", ""},
+			expectedBases: []string{"trunk", "trunk", "trunk"},
+			expectedCalls: 1,
+		},
+		{
+			name:          "item base does not trigger detection",
+			itemBases:     []string{"develop"},
+			expectedBases: []string{"develop"},
+			expectedCalls: 0,
+		},
+		{
+			name:          "configured default wins over detection",
+			defaultBase:   "main",
+			itemBases:     []string{""},
+			expectedBases: []string{"main"},
+			expectedCalls: 0,
+		},
+		{
+			name:          "detection failure rejects the pull request",
+			itemBases:     []string{""},
+			lookupFails:   true,
+			expectError:   true,
+			expectedCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(MockConfig{
+				DefaultBranch:    "trunk",
+				GetDefaultBranch: testutil.ErrorConfig{ShouldError: tt.lookupFails},
+			})
+			create := pullRequestCreator(client, &testutil.MockLogger{}, HydrateOptions{DefaultBase: tt.defaultBase, DetectDefaultBase: true})
+
+			for i, base := range tt.itemBases {
+				_, err := create(context.Background(), types.PullRequest{Title: fmt.Sprintf("Feature %d", i), Head: "feature", Base: base})
+				if tt.expectError {
+					if err == nil || !strings.Contains(err.Error(), "default branch could not be detected") {
+						t.Errorf("Expected detection error, got: %v", err)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			var bases []string
+			for _, pr := range client.CreatedPRs {
+				bases = append(bases, pr.Base)
+			}
+			if !reflect.DeepEqual(bases, tt.expectedBases) {
+				t.Errorf("Expected bases %v, got %v", tt.expectedBases, bases)
+			}
+			if client.DefaultBranchCalls != tt.expectedCalls {
+				t.Errorf("Expected %d default branch lookups, got %d", tt.expectedCalls, client.DefaultBranchCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// defaultCrossReferenceBody is the comment posted for a cross-reference without a body
+const defaultCrossReferenceBody = "Related to " + types.CrossReferencePlaceholder
+
+// referenceTarget is an issue or pull request a cross-reference can name
+type referenceTarget struct {
+	NodeID string
+	Number int
+}
+
+// referenceableTitles returns the titles of the items a cross-reference can name: issues followed by
+// pull requests
+func referenceableTitles(issues []types.Issue, pullRequests []types.PullRequest) []string {
+	titles := make([]string, 0, len(issues)+len(pullRequests))
+	for _, issue := range issues {
+		titles = append(titles, issue.Title)
+	}
+	for _, pullRequest := range pullRequests {
+		titles = append(titles, pullRequest.Title)
+	}
+	return titles
+}
+
+// renameCrossReferences replaces the titles references name with the titles the items are created
+// with, so that issue templates, the title prefix and emoji rendering apply to cross-references the
+// same way they apply to the items. loaded and final are the referenceableTitles before and after
+// the titles were rewritten. A title that names none of the items is left as it is.
+func renameCrossReferences(references []types.CrossReference, loaded, final []string) {
+	renamed := make(map[string]string, len(loaded))
+	for i, title := range loaded {
+		if _, exists := renamed[title]; !exists {
+			renamed[title] = final[i]
+		}
+	}
+	for i := range references {
+		if title, found := renamed[references[i].From]; found {
+			references[i].From = title
+		}
+		if title, found := renamed[references[i].To]; found {
+			references[i].To = title
+		}
+	}
+}
+
+// postCrossReferences comments on each item a cross-reference starts from with the number of the item
+// it references. It runs after all content is created so that either item can come from the same
+// run. Titles are matched against the issues and then the pull requests created in this run, so
+// items left by an earlier run with the same titles are never commented on; only with
+// options.LinkExisting can a title fall back to an open issue or pull request this run did not
+// create. A reference whose items cannot be found, or whose comment fails, is skipped with a
+// warning: like duplicate links, cross-references are optional extras of items that were created.
+func postCrossReferences(ctx context.Context, client githubapi.GitHubClient, references []types.CrossReference, created []CreatedItem, options HydrateOptions, logger common.Logger) error {
+	if len(references) == 0 {
+		return nil
+	}
+
+	if options.DryRun {
+		for _, reference := range references {
+			itemLogger(logger, options.DryRunSummary != nil).Info("Would comment on '%s' referencing '%s'", reference.From, reference.To)
+		}
+		return nil
+	}
+
+	targets, err := referenceTargets(ctx, client, created, options.LinkExisting, logger)
+	if err != nil {
+		return err
+	}
+
+	for _, reference := range references {
+		if err := ctx.Err(); err != nil {
+			return errors.ContextError("post_cross_references", err)
+		}
+
+		from, fromFound := targets[reference.From]
+		to, toFound := targets[reference.To]
+		if !fromFound || !toFound {
+			logger.Info("Warning: cross-reference from '%s' to '%s' names an issue or pull request that was not created; skipping it", reference.From, reference.To)
+			continue
+		}
+
+		body := reference.Body
+		if body == "" {
+			body = defaultCrossReferenceBody
+		}
+		body = strings.ReplaceAll(body, types.CrossReferencePlaceholder, fmt.Sprintf("#%d", to.Number))
+		if err := client.AddComment(ctx, from.NodeID, body); err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			logger.Info("Warning: could not comment on '%s' referencing #%d: %v", reference.From, to.Number, err)
+			continue
+		}
+		logger.Info("Commented on #%d referencing #%d", from.Number, to.Number)
+	}
+
+	return nil
+}
+
+// referenceTargets maps the titles of the issues and pull requests created in this run to the items,
+// keeping the first match so that an issue wins over a pull request with the same title. With
+// linkExisting, titles no created item has fall back to the repository's open issues and pull
+// requests; when those cannot be listed, only the created items are used.
+func referenceTargets(ctx context.Context, client githubapi.GitHubClient, created []CreatedItem, linkExisting bool, logger common.Logger) (map[string]referenceTarget, error) {
+	targets := make(map[string]referenceTarget, len(created))
+	for _, itemType := range []string{"issue", "pull_request"} {
+		for _, item := range created {
+			if _, exists := targets[item.Title]; !exists && item.Type == itemType && item.Number > 0 {
+				targets[item.Title] = referenceTarget{NodeID: item.NodeID, Number: item.Number}
+			}
+		}
+	}
+	if !linkExisting {
+		return targets, nil
+	}
+
+	existing, err := listReferenceTargets(ctx, client)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return nil, err
+		}
+		logger.Info("Warning: could not list issues and pull requests to link cross-references to existing items: %v", err)
+		return targets, nil
+	}
+	for title, target := range existing {
+		if _, exists := targets[title]; !exists {
+			targets[title] = target
+		}
+	}
+	return targets, nil
+}
+
+// listReferenceTargets maps the titles of the repository's issues and pull requests to the items.
+// The first match is kept, and an issue wins over a pull request with the same title.
+func listReferenceTargets(ctx context.Context, client githubapi.GitHubClient) (map[string]referenceTarget, error) {
+	issues, err := client.ListIssues(ctx)
+	if err != nil {
+		return nil, err
+	}
+	pullRequests, err := client.ListPRs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]referenceTarget, len(issues)+len(pullRequests))
+	add := func(title string, target referenceTarget) {
+		if _, exists := targets[title]; !exists {
+			targets[title] = target
+		}
+	}
+	for _, issue := range issues {
+		add(issue.Title, referenceTarget{NodeID: issue.NodeID, Number: issue.Number})
+	}
+	for _, pullRequest := range pullRequests {
+		add(pullRequest.Title, referenceTarget{NodeID: pullRequest.NodeID, Number: pullRequest.Number})
+	}
+	return targets, nil
+}
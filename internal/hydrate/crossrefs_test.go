@@ -0,0 +1,116 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestHydrateWithOptions_CrossReferences tests that cross-reference comments are posted once all content exists and unresolvable ones are skipped
+func TestHydrateWithOptions_CrossReferences(t *testing.T) {
+	tests := []struct {
+		name             string
+		issues           string // issues.json; defaults to a single "Bug" issue
+		references       string
+		existing         []types.Issue // open issues left by an earlier run
+		templates        []types.IssueTemplate
+		options          HydrateOptions
+		expectedComments map[string][]string
+		expectedWarning  string
+	}{
+		{
+			name:             "issue references a pull request",
+			references:       `[{"from": "Bug", "to": "Fix bug", "body": "Fixed by {ref}"}]`,
+			expectedComments: map[string][]string{"mock-issue-id-1": {"Fixed by #1"}},
+		},
+		{
+			name:             "pull request references an issue with the default body",
+			references:       `[{"from": "Fix bug", "to": "Bug"}]`,
+			expectedComments: map[string][]string{"mock-pr-id-1": {"Related to #1"}},
+		},
+		{
+			name:            "unresolvable target is skipped",
+			references:      `[{"from": "Bug", "to": "Missing feature"}]`,
+			expectedWarning: "cross-reference from 'Bug' to 'Missing feature' names an issue or pull request that was not created",
+		},
+		{
+			name:             "titles are rendered with emoji",
+			issues:           `[{"title": ":bug: Bug"}]`,
+			references:       `[{"from": ":bug: Bug", "to": "Fix bug"}]`,
+			options:          HydrateOptions{RenderEmoji: true},
+			expectedComments: map[string][]string{"mock-issue-id-1": {"Related to #1"}},
+		},
+		{
+			name:             "titles are rewritten by issue templates and the title prefix",
+			issues:           `[{"title": "Bug", "template": "bug report"}]`,
+			references:       `[{"from": "Fix bug", "to": "Bug"}]`,
+			templates:        []types.IssueTemplate{{File: "bug_report.md", Name: "Bug report", Title: "[BUG] "}},
+			options:          HydrateOptions{UseIssueTemplates: true, TitlePrefix: "[DEMO] "},
+			expectedComments: map[string][]string{"mock-pr-id-1": {"Related to #1"}},
+		},
+		{
+			name:             "issues from an earlier run are left untouched",
+			references:       `[{"from": "Bug", "to": "Fix bug"}]`,
+			existing:         []types.Issue{{Title: "Bug", NodeID: "old-bug", Number: 1}},
+			expectedComments: map[string][]string{"mock-issue-id-2": {"Related to #1"}},
+		},
+		{
+			name:            "existing items are not referenced without opting in",
+			references:      `[{"from": "Bug", "to": "Old feature"}]`,
+			existing:        []types.Issue{{Title: "Old feature", NodeID: "old-feature", Number: 1}},
+			expectedWarning: "cross-reference from 'Bug' to 'Old feature' names an issue or pull request that was not created",
+		},
+		{
+			name:             "existing items are referenced when opted in",
+			references:       `[{"from": "Bug", "to": "Old feature"}]`,
+			existing:         []types.Issue{{Title: "Old feature", NodeID: "old-feature", Number: 1}},
+			options:          HydrateOptions{LinkExisting: true},
+			expectedComments: map[string][]string{"mock-issue-id-2": {"Related to #1"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			issues := tt.issues
+			if issues == "" {
+				issues = `[{"title": "Bug"}]`
+			}
+			files := map[string]string{
+				config.IssuesFilename:          issues,
+				config.PullRequestsFilename:    `[{"title": "Fix bug", "head": "fix", "base": "main"}]`,
+				config.CrossReferencesFilename: tt.references,
+			}
+			for name, content := range files {
+				if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to create %s: %v", name, err)
+				}
+			}
+			cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, config.IssuesFilename),
+				filepath.Join(tempDir, config.DiscussionsFilename), filepath.Join(tempDir, config.PullRequestsFilename))
+
+			client := NewFailingMockGitHubClient(MockConfig{IssueTemplates: tt.templates})
+			client.CreatedIssues = append(client.CreatedIssues, tt.existing...)
+			logger := &testutil.MockLogger{}
+			options := tt.options
+			options.IncludeIssues, options.IncludePullRequests = true, true
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(client.Comments) != len(tt.expectedComments) || (len(tt.expectedComments) > 0 && !reflect.DeepEqual(client.Comments, tt.expectedComments)) {
+				t.Errorf("Expected comments %v, got %v", tt.expectedComments, client.Comments)
+			}
+			if tt.expectedWarning != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedWarning) {
+				t.Errorf("Expected warning %q, got: %v", tt.expectedWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// githubDefaultLabels is the label set GitHub gives new repositories
+var githubDefaultLabels = []types.Label{
+	{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+	{Name: "documentation", Color: "0075ca", Description: "Improvements or additions to documentation"},
+	{Name: "duplicate", Color: "cfd3d7", Description: "This issue or pull request already exists"},
+	{Name: "enhancement", Color: "a2eeef", Description: "New feature or request"},
+	{Name: "good first issue", Color: "7057ff", Description: "Good for newcomers"},
+	{Name: "help wanted", Color: "008672", Description: "Extra attention is needed"},
+	{Name: "invalid", Color: "e4e669", Description: "This doesn't seem right"},
+	{Name: "question", Color: "d876e3", Description: "Further information is requested"},
+	{Name: "wontfix", Color: "ffffff", Description: "This will not be worked on"},
+}
+
+// withDefaultLabels adds GitHub's default labels to the labels defined in labels.json. A label
+// defined in labels.json replaces the default with the same name, compared case-insensitively as
+// GitHub does.
+func withDefaultLabels(explicitLabels []types.Label) []types.Label {
+	labels := make([]types.Label, 0, len(githubDefaultLabels)+len(explicitLabels))
+	for _, label := range githubDefaultLabels {
+		overridden := false
+		for _, explicit := range explicitLabels {
+			if strings.EqualFold(explicit.Name, label.Name) {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			labels = append(labels, label)
+		}
+	}
+	return append(labels, explicitLabels...)
+}
+
+// clearDefaultLabels deletes the repository labels named like one of GitHub's default labels, so that
+// a new repository ends up with only the labels the demo defines. Labels are matched by exact name, so
+// custom labels are never deleted, and defaults that are in keep, because labels.json defines them or
+// content references them, are left alone. It returns the number of labels deleted, or that would be
+// deleted in a dry run.
+func clearDefaultLabels(ctx context.Context, client githubapi.GitHubClient, keep []types.Label, preserveConfig *config.PreserveConfig, logger common.Logger, dryRun bool) (int, error) {
+	existing, err := client.ListLabels(ctx)
+	if err != nil {
+		return 0, errors.APIError("clear_default_labels", "failed to list labels", err)
+	}
+
+	defaults := make(map[string]bool, len(githubDefaultLabels))
+	for _, label := range githubDefaultLabels {
+		defaults[label.Name] = true
+	}
+	for _, label := range keep {
+		delete(defaults, label.Name)
+	}
+
+	collector := errors.NewErrorCollector("clear_default_labels")
+	cleared := 0
+	for _, labelName := range existing {
+		if err := ctx.Err(); err != nil {
+			return cleared, errors.ContextError("clear_default_labels", err)
+		}
+		if !defaults[labelName] {
+			continue
+		}
+
+		if preserveConfig != nil {
+			if reason := LabelPreservationReason(ctx, preserveConfig, labelName); reason != "" {
+				logPreservation(logger, dryRun, "label", labelName, reason)
+				continue
+			}
+		}
+
+		if dryRun {
+			logger.Info("Would delete default label: %s", labelName)
+		} else {
+			if err := client.DeleteLabel(ctx, labelName); err != nil {
+				if cancelled(ctx, err) {
+					continue
+				}
+				handleLabelDeleteError(err, collector, logger, labelName)
+				continue
+			}
+			logger.Debug("Deleted default label: %s", labelName)
+		}
+		cleared++
+	}
+
+	if dryRun {
+		logger.Info("Default labels (dry-run): %d labels would be deleted", cleared)
+	} else {
+		logger.Info("Default labels: %d labels deleted", cleared)
+	}
+	return cleared, collector.Result()
+}
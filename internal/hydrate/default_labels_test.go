@@ -0,0 +1,126 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestHydrateWithOptions_DefaultLabels tests that GitHub's default labels are ensured and that labels.json overrides them
+func TestHydrateWithOptions_DefaultLabels(t *testing.T) {
+	tempDir := t.TempDir()
+	labelsJSON := `[{"name": "Bug", "color": "000000", "description": "Our own bug label"}, {"name": "demo", "color": "ededed"}]`
+	if err := os.WriteFile(filepath.Join(tempDir, "labels.json"), []byte(labelsJSON), 0644); err != nil {
+		t.Fatalf("Failed to create labels.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	tests := []struct {
+		name           string
+		defaultLabels  bool
+		expectedLabels map[string]string
+		missingLabels  []string
+	}{
+		{
+			name:          "defaults are ensured and labels.json takes precedence",
+			defaultLabels: true,
+			expectedLabels: map[string]string{
+				"Bug":           "000000",
+				"demo":          "ededed",
+				"documentation": "0075ca",
+				"wontfix":       "ffffff",
+			},
+			missingLabels: []string{"bug"},
+		},
+		{
+			name:           "only labels.json without the flag",
+			expectedLabels: map[string]string{"Bug": "000000", "demo": "ededed"},
+			missingLabels:  []string{"documentation", "wontfix"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			options := HydrateOptions{DryRun: true, DefaultLabels: tt.defaultLabels, Plan: NewPlan()}
+
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			planned := make(map[string]types.Label, len(options.Plan.Labels))
+			for _, label := range options.Plan.Labels {
+				planned[label.Name] = label
+			}
+			for name, color := range tt.expectedLabels {
+				if planned[name].Color != color {
+					t.Errorf("Expected label %q with color %s, got %+v", name, color, planned[name])
+				}
+			}
+			for _, name := range tt.missingLabels {
+				if _, found := planned[name]; found {
+					t.Errorf("Expected label %q not to be ensured", name)
+				}
+			}
+			if tt.defaultLabels && len(options.Plan.Labels) != len(githubDefaultLabels)+1 {
+				t.Errorf("Expected %d labels, got %d: %+v", len(githubDefaultLabels)+1, len(options.Plan.Labels), options.Plan.Labels)
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_ClearDefaultLabels tests that only default-named labels that are not defined or used are deleted
+func TestHydrateWithOptions_ClearDefaultLabels(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"labels.json": `[{"name": "demo", "color": "ededed"}, {"name": "question", "color": "d876e3"}]`,
+		"issues.json": `[{"title": "Issue", "body": "Body", "labels": ["bug"]}]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	tests := []struct {
+		name           string
+		clear          bool
+		expectedLabels []string
+	}{
+		{
+			name:           "defaults cleared",
+			clear:          true,
+			expectedLabels: []string{"Documentation", "bug", "custom", "demo", "question"},
+		},
+		{
+			name:           "defaults kept without the flag",
+			expectedLabels: []string{"Documentation", "bug", "custom", "demo", "documentation", "question", "wontfix"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient("bug", "documentation", "wontfix", "question", "Documentation", "custom")
+			options := HydrateOptions{IncludeIssues: true, ClearDefaultLabels: tt.clear}
+
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			labels, _ := client.ListLabels(context.Background())
+			sort.Strings(labels)
+			if !reflect.DeepEqual(labels, tt.expectedLabels) {
+				t.Errorf("Expected labels %v, got %v", tt.expectedLabels, labels)
+			}
+		})
+	}
+}
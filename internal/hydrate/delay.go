@@ -0,0 +1,51 @@
+package hydrate
+
+import (
+	"context"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// sleep waits for d or until ctx is done. Tests replace it to observe delays without waiting.
+var sleep = func(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pause waits for delay between two operations, returning straight away when delay is not positive
+func pause(ctx context.Context, delay time.Duration) error {
+	if delay <= 0 {
+		return nil
+	}
+	if err := sleep(ctx, delay); err != nil {
+		return errors.ContextError("delay", err)
+	}
+	return nil
+}
+
+// withDelay wraps a create function so that each create after the first waits for delay,
+// spacing out requests to stay below GitHub's secondary rate limits
+func withDelay[T any](create createFunc[T], delay time.Duration) createFunc[T] {
+	if delay <= 0 {
+		return create
+	}
+
+	started := false
+	return func(ctx context.Context, item T) (*types.CreatedItemInfo, error) {
+		if started {
+			if err := pause(ctx, delay); err != nil {
+				return nil, err
+			}
+		}
+		started = true
+		return create(ctx, item)
+	}
+}
@@ -0,0 +1,107 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// recordSleeps replaces the sleep hook for the duration of a test, appending "sleep <d>" to events instead of waiting
+func recordSleeps(t *testing.T, events *[]string) {
+	t.Helper()
+	original := sleep
+	sleep = func(ctx context.Context, d time.Duration) error {
+		*events = append(*events, fmt.Sprintf("sleep %s", d))
+		return nil
+	}
+	t.Cleanup(func() { sleep = original })
+}
+
+// TestWithDelay tests that the delay is waited between sequential creates and that no delay waits at all
+func TestWithDelay(t *testing.T) {
+	tests := []struct {
+		name           string
+		delay          time.Duration
+		expectedEvents []string
+	}{
+		{
+			name:           "delay between creates",
+			delay:          50 * time.Millisecond,
+			expectedEvents: []string{"create A", "sleep 50ms", "create B", "sleep 50ms", "create C"},
+		},
+		{
+			name:           "zero delay",
+			expectedEvents: []string{"create A", "create B", "create C"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []string
+			recordSleeps(t, &events)
+			create := withDelay(func(ctx context.Context, issue types.Issue) (*types.CreatedItemInfo, error) {
+				events = append(events, "create "+issue.Title)
+				return &types.CreatedItemInfo{Title: issue.Title}, nil
+			}, tt.delay)
+
+			for _, title := range []string{"A", "B", "C"} {
+				if _, err := create(context.Background(), types.Issue{Title: title}); err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+			}
+
+			if !reflect.DeepEqual(events, tt.expectedEvents) {
+				t.Errorf("Expected events %v, got %v", tt.expectedEvents, events)
+			}
+		})
+	}
+}
+
+// TestCleanupItems_Delay tests that a single cleanup worker waits the delay between its deletions
+func TestCleanupItems_Delay(t *testing.T) {
+	tests := []struct {
+		name           string
+		delay          time.Duration
+		expectedEvents []string
+	}{
+		{
+			name:           "delay between deletions",
+			delay:          time.Second,
+			expectedEvents: []string{"delete I_1", "sleep 1s", "delete I_2", "sleep 1s", "delete I_3"},
+		},
+		{
+			name:           "zero delay",
+			expectedEvents: []string{"delete I_1", "delete I_2", "delete I_3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []string
+			recordSleeps(t, &events)
+			issues := []types.Issue{{NodeID: "I_1", Title: "One"}, {NodeID: "I_2", Title: "Two"}, {NodeID: "I_3", Title: "Three"}}
+
+			summary := &CleanupSummary{}
+			funcs := issueCleanup(
+				func(_ context.Context, fn func([]types.Issue) error) error { return fn(issues) },
+				func(ctx context.Context, nodeID string) error {
+					events = append(events, "delete "+nodeID)
+					return nil
+				},
+			)
+			errs := cleanupItems(context.Background(), funcs, CleanupOptions{CleanIssues: true, Concurrency: 1, Delay: tt.delay}, summary, &testutil.MockLogger{})
+
+			if len(errs) != 0 {
+				t.Fatalf("Unexpected errors: %v", errs)
+			}
+			if !reflect.DeepEqual(events, tt.expectedEvents) {
+				t.Errorf("Expected events %v, got %v", tt.expectedEvents, events)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// orderIssuesByDependencies returns the issues ordered so that every issue comes after the issues
+// it depends on. The sort is stable: each next issue is the earliest in the content file whose
+// dependencies are placed, so an issue only moves behind the issues it waits for and independent
+// issues keep their file order. Dependencies on titles that are not among the issues, such as
+// issues created by an earlier run, do not affect the order. A cycle of dependencies is a
+// validation error naming the issues involved.
+func orderIssuesByDependencies(issues []types.Issue) ([]types.Issue, error) {
+	pending := make(map[string]bool, len(issues))
+	for _, issue := range issues {
+		if len(issue.DependsOnTitles) > 0 {
+			pending[issue.Title] = true
+		}
+	}
+	if len(pending) == 0 {
+		return issues, nil
+	}
+	for _, issue := range issues {
+		pending[issue.Title] = true
+	}
+
+	ordered := make([]types.Issue, 0, len(issues))
+	placed := make([]bool, len(issues))
+	for len(ordered) < len(issues) {
+		next := -1
+		for i, issue := range issues {
+			if !placed[i] && dependenciesPlaced(issue, pending) {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, errors.ValidationError("order_issues", "issue dependencies form a cycle: "+strings.Join(findDependencyCycle(issues, pending), " -> "))
+		}
+		ordered = append(ordered, issues[next])
+		placed[next] = true
+		delete(pending, issues[next].Title)
+	}
+	return ordered, nil
+}
+
+// dependenciesPlaced reports whether none of the issue's dependencies are still pending
+func dependenciesPlaced(issue types.Issue, pending map[string]bool) bool {
+	for _, dependency := range issue.DependsOnTitles {
+		if pending[dependency] {
+			return false
+		}
+	}
+	return true
+}
+
+// findDependencyCycle follows pending dependencies from the first pending issue until a title
+// repeats, returning the titles of the cycle with the first title repeated at the end
+func findDependencyCycle(issues []types.Issue, pending map[string]bool) []string {
+	byTitle := make(map[string]types.Issue, len(issues))
+	var start string
+	for _, issue := range issues {
+		if pending[issue.Title] {
+			byTitle[issue.Title] = issue
+			if start == "" {
+				start = issue.Title
+			}
+		}
+	}
+
+	position := make(map[string]int)
+	var path []string
+	for title := start; ; {
+		if i, seen := position[title]; seen {
+			return append(path[i:], title)
+		}
+		position[title] = len(path)
+		path = append(path, title)
+		for _, dependency := range byTitle[title].DependsOnTitles {
+			if pending[dependency] {
+				title = dependency
+				break
+			}
+		}
+	}
+}
+
+// withBlockedByLines wraps an issue create function so that the body of each issue ends with a
+// "Blocked by #N" line for every dependency whose number is known, either because it was created
+// earlier in the run or because manifest records it. Unknown dependencies are logged and left out.
+func withBlockedByLines(create createFunc[types.Issue], manifest *Manifest, logger common.Logger) createFunc[types.Issue] {
+	numbers := make(map[string]int)
+	return func(ctx context.Context, issue types.Issue) (*types.CreatedItemInfo, error) {
+		var lines []string
+		for _, dependency := range issue.DependsOnTitles {
+			number, found := numbers[dependency]
+			if !found && manifest != nil {
+				if entry, recorded := manifest.Lookup("issue", dependency); recorded {
+					number, found = entry.Number, entry.Number > 0
+				}
+			}
+			if !found {
+				logger.Debug("Issue '%s' depends on '%s', whose number is unknown; leaving it out of the body", issue.Title, dependency)
+				continue
+			}
+			lines = append(lines, fmt.Sprintf("Blocked by #%d", number))
+		}
+		if len(lines) > 0 {
+			issue.Body = withBlockedBy(issue.Body, strings.Join(lines, "\n"))
+		}
+
+		info, err := create(ctx, issue)
+		if err == nil && info != nil && info.Number > 0 {
+			numbers[issue.Title] = info.Number
+		}
+		return info, err
+	}
+}
+
+// withBlockedBy appends the blocked-by lines to body after a blank line, keeping any metadata
+// footer at the end
+func withBlockedBy(body, blockedBy string) string {
+	footer := ""
+	if index := strings.Index(body, metadataMarker); index >= 0 {
+		body, footer = body[:index], body[index:]
+	}
+	body = strings.TrimRight(body, "\n")
+	if body != "" {
+		body += "\n\n"
+	}
+	body += blockedBy
+	if footer != "" {
+		body += "\n\n" + footer
+	}
+	return body
+}
@@ -0,0 +1,136 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestOrderIssuesByDependencies tests that prerequisites are ordered first and that cycles are rejected
+func TestOrderIssuesByDependencies(t *testing.T) {
+	tests := []struct {
+		name          string
+		issues        []types.Issue
+		expectedOrder string
+		expectError   string
+	}{
+		{
+			name:          "no dependencies keeps file order",
+			issues:        []types.Issue{{Title: "B"}, {Title: "A"}},
+			expectedOrder: "B,A",
+		},
+		{
+			name: "dependency chain",
+			issues: []types.Issue{
+				{Title: "Release", DependsOnTitles: []string{"Build"}},
+				{Title: "Unrelated"},
+				{Title: "Build", DependsOnTitles: []string{"Design"}},
+				{Title: "Design"},
+			},
+			expectedOrder: "Unrelated,Design,Build,Release",
+		},
+		{
+			name: "later independent issue keeps its place",
+			issues: []types.Issue{
+				{Title: "Deploy", DependsOnTitles: []string{"Build"}},
+				{Title: "Build"},
+				{Title: "Docs"},
+			},
+			expectedOrder: "Build,Deploy,Docs",
+		},
+		{
+			name:          "dependency outside the run is ignored",
+			issues:        []types.Issue{{Title: "Follow-up", DependsOnTitles: []string{"Created earlier"}}, {Title: "Other"}},
+			expectedOrder: "Follow-up,Other",
+		},
+		{
+			name: "cycle is rejected",
+			issues: []types.Issue{
+				{Title: "Start", DependsOnTitles: []string{"A"}},
+				{Title: "A", DependsOnTitles: []string{"B"}},
+				{Title: "B", DependsOnTitles: []string{"A"}},
+			},
+			expectError: "issue dependencies form a cycle: A -> B -> A",
+		},
+		{
+			name:        "self dependency is rejected",
+			issues:      []types.Issue{{Title: "Loop", DependsOnTitles: []string{"Loop"}}},
+			expectError: "issue dependencies form a cycle: Loop -> Loop",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ordered, err := orderIssuesByDependencies(tt.issues)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var titles []string
+			for _, issue := range ordered {
+				titles = append(titles, issue.Title)
+			}
+			if strings.Join(titles, ",") != tt.expectedOrder {
+				t.Errorf("Expected order %s, got %s", tt.expectedOrder, strings.Join(titles, ","))
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_IssueDependencies tests that issues are created after their dependencies
+// and list them as "Blocked by #N" when requested
+func TestHydrateWithOptions_IssueDependencies(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesJSON := `[
+		{"title": "Ship", "body": "Release it", "depends_on": ["Build", "Test"]},
+		{"title": "Test", "body": "", "depends_on": ["Build"]},
+		{"title": "Build", "body": "Compile it"}
+	]`
+	if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(issuesJSON), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	client := NewSuccessfulMockGitHubClient()
+	options := HydrateOptions{IncludeIssues: true, BlockedByLines: true}
+
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []struct{ title, body string }{
+		{"Build", "Compile it"},
+		{"Test", "Blocked by #1"},
+		{"Ship", "Release it\n\nBlocked by #1\nBlocked by #2"},
+	}
+	if len(client.CreatedIssues) != len(expected) {
+		t.Fatalf("Expected %d issues, got %d", len(expected), len(client.CreatedIssues))
+	}
+	for i, want := range expected {
+		if client.CreatedIssues[i].Title != want.title || client.CreatedIssues[i].Body != want.body {
+			t.Errorf("Issue %d: expected %q with body %q, got %q with body %q", i+1, want.title, want.body, client.CreatedIssues[i].Title, client.CreatedIssues[i].Body)
+		}
+	}
+}
+
+// TestWithBlockedBy tests that blocked-by lines are placed before any metadata footer
+func TestWithBlockedBy(t *testing.T) {
+	footer := metadataMarker + "\n---\n<sub>Generated</sub>"
+	got := withBlockedBy("Body\n\n"+footer, "Blocked by #3")
+	want := "Body\n\nBlocked by #3\n\n" + footer
+	if got != want {
+		t.Errorf("Expected %q, got %q", want, got)
+	}
+}
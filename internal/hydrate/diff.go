@@ -0,0 +1,243 @@
+package hydrate
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// ContentDiff describes how the content of two configuration directories differs, per content type
+type ContentDiff struct {
+	Sections []DiffSection
+}
+
+// DiffSection lists the items of one content type that were added, removed or changed.
+// Items are matched by title, or by name for labels.
+type DiffSection struct {
+	Type    string
+	Added   []string
+	Removed []string
+	Changed []ChangedItem
+}
+
+// ChangedItem is an item present in both directories whose fields differ
+type ChangedItem struct {
+	Title  string
+	Fields []string
+}
+
+// Empty reports whether the two directories have the same content
+func (d *ContentDiff) Empty() bool {
+	for _, section := range d.Sections {
+		if len(section.Added) > 0 || len(section.Removed) > 0 || len(section.Changed) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// DiffConfigurations loads the content files and labels.json of both configurations and compares
+// them item by item. It reads local files only.
+func DiffConfigurations(ctx context.Context, from, to *config.Configuration) (*ContentDiff, error) {
+	fromIssues, fromDiscussions, fromPullRequests, fromLabels, err := loadContent(ctx, from)
+	if err != nil {
+		return nil, err
+	}
+	toIssues, toDiscussions, toPullRequests, toLabels, err := loadContent(ctx, to)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := diffItems("issues", fromIssues, toIssues, func(i types.Issue) string { return i.Title })
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_issues", "failed to compare issues")
+	}
+	discussions, err := diffItems("discussions", fromDiscussions, toDiscussions, func(d types.Discussion) string { return d.Title })
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_discussions", "failed to compare discussions")
+	}
+	pullRequests, err := diffItems("pull requests", fromPullRequests, toPullRequests, func(pr types.PullRequest) string { return pr.Title })
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_pull_requests", "failed to compare pull requests")
+	}
+	labels, err := diffItems("labels", fromLabels, toLabels, func(l types.Label) string { return l.Name })
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_labels", "failed to compare labels")
+	}
+
+	return &ContentDiff{Sections: []DiffSection{issues, discussions, pullRequests, labels}}, nil
+}
+
+// DiffManifests compares the manifests written by two runs. Entries are matched by title within each
+// content type; an entry whose number, URL or node ID differs is reported as changed. A title that
+// only moved to another content type is reported as changed in the section it moved to, with the
+// field "type", rather than as removed and added.
+func DiffManifests(from, to *Manifest) (*ContentDiff, error) {
+	entryTitle := func(entry ManifestEntry) string { return entry.Title }
+
+	issues, err := diffItems("issues", from.Issues, to.Issues, entryTitle)
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_issues", "failed to compare issues")
+	}
+	discussions, err := diffItems("discussions", from.Discussions, to.Discussions, entryTitle)
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_discussions", "failed to compare discussions")
+	}
+	pullRequests, err := diffItems("pull requests", from.PullRequests, to.PullRequests, entryTitle)
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_pull_requests", "failed to compare pull requests")
+	}
+	labels, err := diffItems("labels", manifestLabels(from), manifestLabels(to), func(l types.Label) string { return l.Name })
+	if err != nil {
+		return nil, errors.WrapWithOperation(err, "diff", "compare_labels", "failed to compare labels")
+	}
+
+	sections := []DiffSection{issues, discussions, pullRequests}
+	markTypeChanges(sections)
+	return &ContentDiff{Sections: append(sections, labels)}, nil
+}
+
+// manifestLabels returns the label names recorded in manifest as labels, so that they can be matched by name
+func manifestLabels(manifest *Manifest) []types.Label {
+	labels := make([]types.Label, 0, len(manifest.Labels))
+	for _, name := range manifest.Labels {
+		labels = append(labels, types.Label{Name: name})
+	}
+	return labels
+}
+
+// markTypeChanges replaces a title removed from one section and added to another with a change of
+// the "type" field in the section it was added to
+func markTypeChanges(sections []DiffSection) {
+	removedFrom := make(map[string]int)
+	for i, section := range sections {
+		for _, title := range section.Removed {
+			removedFrom[title] = i
+		}
+	}
+
+	for i := range sections {
+		var added []string
+		for _, title := range sections[i].Added {
+			previous, moved := removedFrom[title]
+			if !moved || previous == i {
+				added = append(added, title)
+				continue
+			}
+			sections[previous].Removed = withoutTitle(sections[previous].Removed, title)
+			delete(removedFrom, title)
+			sections[i].Changed = append(sections[i].Changed, ChangedItem{Title: title, Fields: []string{"type"}})
+		}
+		sections[i].Added = added
+	}
+}
+
+// withoutTitle returns titles without the first occurrence of title, or nil when nothing remains
+func withoutTitle(titles []string, title string) []string {
+	var remaining []string
+	removed := false
+	for _, candidate := range titles {
+		if candidate == title && !removed {
+			removed = true
+			continue
+		}
+		remaining = append(remaining, candidate)
+	}
+	return remaining
+}
+
+// loadContent loads the content files and labels.json of a configuration
+func loadContent(ctx context.Context, cfg *config.Configuration) ([]types.Issue, []types.Discussion, []types.PullRequest, []types.Label, error) {
+	issues, discussions, pullRequests, err := HydrateFromConfiguration(ctx, cfg, true, true, true)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	labels, err := ReadLabelsJSON(ctx, cfg.LabelsPath)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+	return issues, discussions, pullRequests, labels, nil
+}
+
+// diffItems matches the items of from and to by key and reports the keys only in to as added,
+// those only in from as removed, and those in both whose JSON fields differ as changed.
+// Items keep the order of the file they come from.
+func diffItems[T any](itemType string, from, to []T, key func(T) string) (DiffSection, error) {
+	section := DiffSection{Type: itemType}
+
+	fromByKey := make(map[string]T, len(from))
+	for _, item := range from {
+		fromByKey[key(item)] = item
+	}
+	toByKey := make(map[string]T, len(to))
+	for _, item := range to {
+		toByKey[key(item)] = item
+	}
+
+	for _, item := range from {
+		if _, found := toByKey[key(item)]; !found {
+			section.Removed = append(section.Removed, key(item))
+		}
+	}
+	for _, item := range to {
+		previous, found := fromByKey[key(item)]
+		if !found {
+			section.Added = append(section.Added, key(item))
+			continue
+		}
+		fields, err := changedFields(previous, item)
+		if err != nil {
+			return DiffSection{}, err
+		}
+		if len(fields) > 0 {
+			section.Changed = append(section.Changed, ChangedItem{Title: key(item), Fields: fields})
+		}
+	}
+
+	return section, nil
+}
+
+// changedFields returns the sorted JSON field names whose values differ between a and b.
+// Comparing the JSON form ignores fields that are not part of the content files.
+func changedFields(a, b any) ([]string, error) {
+	fromFields, err := jsonFields(a)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := jsonFields(b)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields []string
+	for name, value := range fromFields {
+		if !reflect.DeepEqual(value, toFields[name]) {
+			fields = append(fields, name)
+		}
+	}
+	for name := range toFields {
+		if _, found := fromFields[name]; !found {
+			fields = append(fields, name)
+		}
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// jsonFields returns the fields of v as they appear in its JSON form
+func jsonFields(v any) (map[string]any, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
@@ -0,0 +1,137 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+)
+
+// writeContentDir writes the given content files to a temporary configuration directory
+func writeContentDir(t *testing.T, issues, discussions, pullRequests, labels string) *config.Configuration {
+	t.Helper()
+	dir := t.TempDir()
+	files := map[string]string{
+		config.IssuesFilename:       issues,
+		config.DiscussionsFilename:  discussions,
+		config.PullRequestsFilename: pullRequests,
+		config.LabelsFilename:       labels,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return config.NewConfiguration(context.Background(), dir)
+}
+
+// TestDiffConfigurations tests that added, removed and changed items are reported per content type
+func TestDiffConfigurations(t *testing.T) {
+	from := writeContentDir(t,
+		`[{"title": "Bug", "body": "Old body", "labels": ["bug"]}, {"title": "Removed issue", "body": "Body"}]`,
+		`[{"title": "Idea", "body": "Body", "category": "Ideas"}]`,
+		`[{"title": "Feature", "body": "Body", "head": "feature", "base": "main"}]`,
+		`[{"name": "bug", "color": "d73a4a"}]`,
+	)
+	to := writeContentDir(t,
+		`[{"title": "Added issue", "body": "Body"}, {"title": "Bug", "body": "New body", "labels": ["bug", "urgent"]}]`,
+		`[{"title": "Idea", "body": "Body", "category": "Ideas"}]`,
+		`[{"title": "Feature", "body": "Body", "head": "feature", "base": "develop"}]`,
+		`[{"name": "bug", "color": "d73a4a"}, {"name": "urgent", "color": "b60205"}]`,
+	)
+
+	diff, err := DiffConfigurations(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if diff.Empty() {
+		t.Fatal("Expected differences")
+	}
+
+	expected := []DiffSection{
+		{
+			Type:    "issues",
+			Added:   []string{"Added issue"},
+			Removed: []string{"Removed issue"},
+			Changed: []ChangedItem{{Title: "Bug", Fields: []string{"body", "labels"}}},
+		},
+		{Type: "discussions"},
+		{Type: "pull requests", Changed: []ChangedItem{{Title: "Feature", Fields: []string{"base"}}}},
+		{Type: "labels", Added: []string{"urgent"}},
+	}
+	if !reflect.DeepEqual(diff.Sections, expected) {
+		t.Errorf("Expected sections %+v, got %+v", expected, diff.Sections)
+	}
+}
+
+// TestDiffConfigurations_Identical tests that identical directories have no differences
+func TestDiffConfigurations_Identical(t *testing.T) {
+	from := writeContentDir(t, `[{"title": "Bug", "body": "Body"}]`, `[]`, `[]`, `[]`)
+	to := writeContentDir(t, `[{"title": "Bug", "body": "Body"}]`, `[]`, `[]`, `[]`)
+
+	diff, err := DiffConfigurations(context.Background(), from, to)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("Expected no differences, got %+v", diff.Sections)
+	}
+}
+
+// TestDiffManifests tests that new, removed, moved and changed entries of two runs are reported
+func TestDiffManifests(t *testing.T) {
+	from := &Manifest{
+		Issues: []ManifestEntry{
+			{Title: "Bug", Number: 1, URL: "https://github.com/o/r/issues/1"},
+			{Title: "Removed", Number: 2, URL: "https://github.com/o/r/issues/2"},
+			{Title: "Idea", Number: 3, URL: "https://github.com/o/r/issues/3"},
+		},
+		PullRequests: []ManifestEntry{{Title: "Feature", Number: 4, URL: "https://github.com/o/r/pull/4"}},
+		Labels:       []string{"bug"},
+	}
+	to := &Manifest{
+		Issues: []ManifestEntry{
+			{Title: "Bug", Number: 10, URL: "https://github.com/o/r/issues/10"},
+			{Title: "Added", Number: 11, URL: "https://github.com/o/r/issues/11"},
+		},
+		Discussions:  []ManifestEntry{{Title: "Idea", Number: 12, URL: "https://github.com/o/r/discussions/12"}},
+		PullRequests: []ManifestEntry{{Title: "Feature", Number: 4, URL: "https://github.com/o/r/pull/4"}},
+		Labels:       []string{"bug", "demo"},
+	}
+
+	diff, err := DiffManifests(from, to)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := []DiffSection{
+		{
+			Type:    "issues",
+			Added:   []string{"Added"},
+			Removed: []string{"Removed"},
+			Changed: []ChangedItem{{Title: "Bug", Fields: []string{"number", "url"}}},
+		},
+		{Type: "discussions", Changed: []ChangedItem{{Title: "Idea", Fields: []string{"type"}}}},
+		{Type: "pull requests"},
+		{Type: "labels", Added: []string{"demo"}},
+	}
+	if !reflect.DeepEqual(diff.Sections, expected) {
+		t.Errorf("Expected sections %+v, got %+v", expected, diff.Sections)
+	}
+}
+
+// TestDiffManifests_Identical tests that the same manifest has no differences
+func TestDiffManifests_Identical(t *testing.T) {
+	manifest := &Manifest{Issues: []ManifestEntry{{Title: "Bug", Number: 1}}, Labels: []string{"bug"}}
+
+	diff, err := DiffManifests(manifest, manifest)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !diff.Empty() {
+		t.Errorf("Expected no differences, got %+v", diff.Sections)
+	}
+}
@@ -0,0 +1,71 @@
+package hydrate
+
+import (
+	"fmt"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+)
+
+// DryRunCounts is how many items of one type a dry run would create, update and skip
+type DryRunCounts struct {
+	Type   string
+	Create int
+	Update int
+	Skip   int
+}
+
+// DryRunSummary collects the per-type counts of a dry run whose per-item "Would ..." lines are
+// suppressed. Types are kept in the order they are first counted.
+type DryRunSummary struct {
+	Counts []DryRunCounts
+}
+
+// NewDryRunSummary returns an empty dry-run summary
+func NewDryRunSummary() *DryRunSummary {
+	return &DryRunSummary{}
+}
+
+// add adds to the counts of itemType. It is safe to call on a nil summary.
+func (s *DryRunSummary) add(itemType string, create, update, skip int) {
+	if s == nil {
+		return
+	}
+	for i := range s.Counts {
+		if s.Counts[i].Type == itemType {
+			s.Counts[i].Create += create
+			s.Counts[i].Update += update
+			s.Counts[i].Skip += skip
+			return
+		}
+	}
+	s.Counts = append(s.Counts, DryRunCounts{Type: itemType, Create: create, Update: update, Skip: skip})
+}
+
+// Lines formats the counts of each type, e.g. "issues: 3 would be created, 0 would be updated, 1 would be skipped"
+func (s *DryRunSummary) Lines() []string {
+	lines := make([]string, 0, len(s.Counts))
+	for _, counts := range s.Counts {
+		lines = append(lines, fmt.Sprintf("%s: %d would be created, %d would be updated, %d would be skipped",
+			counts.Type, counts.Create, counts.Update, counts.Skip))
+	}
+	return lines
+}
+
+// debugInfoLogger writes Info messages at debug level, so they are only shown with --debug
+type debugInfoLogger struct {
+	common.Logger
+}
+
+// Info logs the message at debug level
+func (l debugInfoLogger) Info(format string, args ...interface{}) {
+	l.Logger.Debug(format, args...)
+}
+
+// itemLogger returns the logger for per-item lines: logger itself, or when summaryOnly is set a
+// logger that demotes them to debug level so only the totals are shown
+func itemLogger(logger common.Logger, summaryOnly bool) common.Logger {
+	if summaryOnly {
+		return debugInfoLogger{logger}
+	}
+	return logger
+}
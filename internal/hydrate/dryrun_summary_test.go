@@ -0,0 +1,82 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestHydrateWithOptions_DryRunSummaryOnly tests that per-item lines are suppressed while the
+// counts per type are still recorded
+func TestHydrateWithOptions_DryRunSummaryOnly(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesJSON := `[
+		{"title": "First", "body": "Body", "labels": ["bug", "new"]},
+		{"title": "Second", "body": "Body"},
+		{"title": "Third", "body": "Body"}
+	]`
+	if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(issuesJSON), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	manifest := NewManifest()
+	manifest.Issues = []ManifestEntry{{Title: "Second", Number: 2}}
+	summary := NewDryRunSummary()
+	client := NewSuccessfulMockGitHubClient("bug")
+	logger := &testutil.MockLogger{}
+	options := HydrateOptions{IncludeIssues: true, DryRun: true, Manifest: manifest, DryRunSummary: summary}
+
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, logger); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, line := range logger.InfoCalls {
+		if strings.HasPrefix(line, "Would create") || strings.HasPrefix(line, "Skipping issue") {
+			t.Errorf("Expected per-item line to be suppressed, got: %q", line)
+		}
+	}
+	if !strings.Contains(strings.Join(logger.DebugCalls, "\n"), "Would create issue: First") {
+		t.Errorf("Expected per-item lines at debug level, got: %v", logger.DebugCalls)
+	}
+
+	expected := []DryRunCounts{
+		{Type: "issues", Create: 2, Skip: 1},
+		{Type: "discussions"},
+		{Type: "pull requests"},
+		{Type: "labels", Create: 1, Skip: 1},
+	}
+	if !reflect.DeepEqual(summary.Counts, expected) {
+		t.Errorf("Expected counts %+v, got %+v", expected, summary.Counts)
+	}
+	if line := summary.Lines()[0]; line != "issues: 2 would be created, 0 would be updated, 1 would be skipped" {
+		t.Errorf("Unexpected summary line: %q", line)
+	}
+}
+
+// TestCleanupBeforeHydration_SummaryOnly tests that dry-run cleanup logs deletions at debug level
+// while the totals are still counted
+func TestCleanupBeforeHydration_SummaryOnly(t *testing.T) {
+	client := NewSuccessfulMockGitHubClient("bug", "enhancement")
+	logger := &testutil.MockLogger{}
+
+	summary, err := CleanupBeforeHydration(context.Background(), client, CleanupOptions{CleanLabels: true, DryRun: true, SummaryOnly: true}, logger)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if summary.LabelsDeleted != 2 {
+		t.Errorf("Expected 2 labels counted, got %d", summary.LabelsDeleted)
+	}
+	for _, line := range logger.InfoCalls {
+		if strings.HasPrefix(line, "Would delete") {
+			t.Errorf("Expected per-item line to be suppressed, got: %q", line)
+		}
+	}
+}
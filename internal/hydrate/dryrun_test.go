@@ -0,0 +1,249 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// mutationRecordingClient records every call to a method that changes the repository or a project
+type mutationRecordingClient struct {
+	*ConfigurableMockGitHubClient
+	mutations []string
+}
+
+func (c *mutationRecordingClient) CreateLabel(ctx context.Context, label types.Label) error {
+	c.mutations = append(c.mutations, "CreateLabel "+label.Name)
+	return c.ConfigurableMockGitHubClient.CreateLabel(ctx, label)
+}
+
+func (c *mutationRecordingClient) UpdateLabel(ctx context.Context, name string, label types.Label) error {
+	c.mutations = append(c.mutations, "UpdateLabel "+name)
+	return c.ConfigurableMockGitHubClient.UpdateLabel(ctx, name, label)
+}
+
+func (c *mutationRecordingClient) CreateIssue(ctx context.Context, issue types.Issue) (*types.CreatedItemInfo, error) {
+	c.mutations = append(c.mutations, "CreateIssue "+issue.Title)
+	return c.ConfigurableMockGitHubClient.CreateIssue(ctx, issue)
+}
+
+func (c *mutationRecordingClient) UpdateIssue(ctx context.Context, nodeID string, issue types.Issue) (*types.CreatedItemInfo, error) {
+	c.mutations = append(c.mutations, "UpdateIssue "+issue.Title)
+	return c.ConfigurableMockGitHubClient.UpdateIssue(ctx, nodeID, issue)
+}
+
+func (c *mutationRecordingClient) CreateDiscussion(ctx context.Context, discussion types.Discussion) (*types.CreatedItemInfo, error) {
+	c.mutations = append(c.mutations, "CreateDiscussion "+discussion.Title)
+	return c.ConfigurableMockGitHubClient.CreateDiscussion(ctx, discussion)
+}
+
+func (c *mutationRecordingClient) CreatePR(ctx context.Context, pullRequest types.PullRequest) (*types.CreatedItemInfo, error) {
+	c.mutations = append(c.mutations, "CreatePR "+pullRequest.Title)
+	return c.ConfigurableMockGitHubClient.CreatePR(ctx, pullRequest)
+}
+
+func (c *mutationRecordingClient) EnablePullRequestAutoMerge(ctx context.Context, prNodeID, method string) error {
+	c.mutations = append(c.mutations, "EnablePullRequestAutoMerge "+prNodeID)
+	return c.ConfigurableMockGitHubClient.EnablePullRequestAutoMerge(ctx, prNodeID, method)
+}
+
+func (c *mutationRecordingClient) RequestReviews(ctx context.Context, prNodeID string, reviewers []string) error {
+	c.mutations = append(c.mutations, "RequestReviews "+prNodeID)
+	return c.ConfigurableMockGitHubClient.RequestReviews(ctx, prNodeID, reviewers)
+}
+
+func (c *mutationRecordingClient) AddPullRequestReview(ctx context.Context, prNodeID, body, event string) error {
+	c.mutations = append(c.mutations, "AddPullRequestReview "+prNodeID)
+	return c.ConfigurableMockGitHubClient.AddPullRequestReview(ctx, prNodeID, body, event)
+}
+
+func (c *mutationRecordingClient) MarkIssueAsDuplicate(ctx context.Context, issueNodeID string, originalNumber int) error {
+	c.mutations = append(c.mutations, "MarkIssueAsDuplicate "+issueNodeID)
+	return c.ConfigurableMockGitHubClient.MarkIssueAsDuplicate(ctx, issueNodeID, originalNumber)
+}
+
+func (c *mutationRecordingClient) AddComment(ctx context.Context, subjectNodeID, body string) error {
+	c.mutations = append(c.mutations, "AddComment "+subjectNodeID)
+	return c.ConfigurableMockGitHubClient.AddComment(ctx, subjectNodeID, body)
+}
+
+func (c *mutationRecordingClient) DeleteIssue(ctx context.Context, nodeID string) error {
+	c.mutations = append(c.mutations, "DeleteIssue "+nodeID)
+	return c.ConfigurableMockGitHubClient.DeleteIssue(ctx, nodeID)
+}
+
+func (c *mutationRecordingClient) DeleteDiscussion(ctx context.Context, nodeID string) error {
+	c.mutations = append(c.mutations, "DeleteDiscussion "+nodeID)
+	return c.ConfigurableMockGitHubClient.DeleteDiscussion(ctx, nodeID)
+}
+
+func (c *mutationRecordingClient) CloseDiscussion(ctx context.Context, nodeID, reason string) error {
+	c.mutations = append(c.mutations, "CloseDiscussion "+nodeID)
+	return c.ConfigurableMockGitHubClient.CloseDiscussion(ctx, nodeID, reason)
+}
+
+func (c *mutationRecordingClient) DeletePR(ctx context.Context, nodeID string) error {
+	c.mutations = append(c.mutations, "DeletePR "+nodeID)
+	return c.ConfigurableMockGitHubClient.DeletePR(ctx, nodeID)
+}
+
+func (c *mutationRecordingClient) DeleteLabel(ctx context.Context, name string) error {
+	c.mutations = append(c.mutations, "DeleteLabel "+name)
+	return c.ConfigurableMockGitHubClient.DeleteLabel(ctx, name)
+}
+
+func (c *mutationRecordingClient) DeleteBranch(ctx context.Context, refID string) error {
+	c.mutations = append(c.mutations, "DeleteBranch "+refID)
+	return c.ConfigurableMockGitHubClient.DeleteBranch(ctx, refID)
+}
+
+func (c *mutationRecordingClient) CreateOrUpdateFile(ctx context.Context, path, content, message string) error {
+	c.mutations = append(c.mutations, "CreateOrUpdateFile "+path)
+	return c.ConfigurableMockGitHubClient.CreateOrUpdateFile(ctx, path, content, message)
+}
+
+func (c *mutationRecordingClient) CreateProjectV2(ctx context.Context, projectConfig types.ProjectV2Configuration) (*types.ProjectV2, error) {
+	c.mutations = append(c.mutations, "CreateProjectV2 "+projectConfig.Title)
+	return c.ConfigurableMockGitHubClient.CreateProjectV2(ctx, projectConfig)
+}
+
+func (c *mutationRecordingClient) DeleteProjectV2(ctx context.Context, projectID string) error {
+	c.mutations = append(c.mutations, "DeleteProjectV2 "+projectID)
+	return c.ConfigurableMockGitHubClient.DeleteProjectV2(ctx, projectID)
+}
+
+func (c *mutationRecordingClient) ConfigureProjectV2Fields(ctx context.Context, projectID string, fields []types.ProjectV2Field) error {
+	c.mutations = append(c.mutations, "ConfigureProjectV2Fields "+projectID)
+	return c.ConfigurableMockGitHubClient.ConfigureProjectV2Fields(ctx, projectID, fields)
+}
+
+func (c *mutationRecordingClient) UpdateProjectV2Description(ctx context.Context, projectID, description string) error {
+	c.mutations = append(c.mutations, "UpdateProjectV2Description "+projectID)
+	return c.ConfigurableMockGitHubClient.UpdateProjectV2Description(ctx, projectID, description)
+}
+
+func (c *mutationRecordingClient) AddItemToProjectV2(ctx context.Context, projectID, itemNodeID string) error {
+	c.mutations = append(c.mutations, "AddItemToProjectV2 "+itemNodeID)
+	return c.ConfigurableMockGitHubClient.AddItemToProjectV2(ctx, projectID, itemNodeID)
+}
+
+func (c *mutationRecordingClient) UpdateProjectV2FieldOptions(ctx context.Context, fieldID string, options []types.ProjectV2FieldOption) error {
+	c.mutations = append(c.mutations, "UpdateProjectV2FieldOptions "+fieldID)
+	return c.ConfigurableMockGitHubClient.UpdateProjectV2FieldOptions(ctx, fieldID, options)
+}
+
+func (c *mutationRecordingClient) ClearProjectV2Items(ctx context.Context, projectID string) error {
+	c.mutations = append(c.mutations, "ClearProjectV2Items "+projectID)
+	return c.ConfigurableMockGitHubClient.ClearProjectV2Items(ctx, projectID)
+}
+
+// TestDryRun_NoMutations tests that a dry run with every cleanup and hydration feature enabled makes no mutating calls
+func TestDryRun_NoMutations(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		config.LabelsFilename: `[{"name": "bug", "color": "d73a4a"}, {"name": "new-label", "color": "ededed"}]`,
+		config.IssuesFilename: `[
+			{"title": "Existing bug", "body": "Updated", "labels": ["bug"], "projects": [1], "author": "octocat"},
+			{"title": "Crash", "body": "Body", "depends_on": ["Existing bug"]},
+			{"title": "Crash again", "body": "Body", "duplicate_of": "Crash"}
+		]`,
+		config.DiscussionsFilename:     `[{"title": "Welcome", "body": "Body", "category": "General"}]`,
+		config.PullRequestsFilename:    `[{"title": "Feature", "body": "Body", "head": "feature", "base": "main", "auto_merge": true, "reviews": [{"body": "Looks good"}]}]`,
+		config.FilesFilename:           `[{"path": "README.md", "content": "# Demo"}]`,
+		config.CrossReferencesFilename: `[{"from": "Crash", "to": "Feature"}]`,
+		config.ProjectConfigFilename:   `{"title": "Demo Board", "description": "Demo", "fields": [{"name": "Priority", "type": "single_select", "options": [{"name": "High"}, {"name": "Low"}]}]}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+	cfg := config.NewConfiguration(context.Background(), tempDir)
+
+	client := &mutationRecordingClient{ConfigurableMockGitHubClient: NewFailingMockGitHubClient(MockConfig{
+		ExistingLabels: map[string]bool{"bug": true, "stale": true, "wontfix": true},
+		// The remote color of bug differs from labels.json, so a real run would update it
+		LabelDetails: map[string]types.Label{"bug": {Name: "bug", Color: "000000"}},
+		Codeowners:   []types.CodeownersRule{{Pattern: "*", Owners: []string{"@octocat"}}},
+		Branches:     []types.Branch{{NodeID: "REF_1", Name: "demo/old"}},
+	})}
+	client.CreatedIssues = []types.Issue{{NodeID: "I_1", Number: 1, Title: "Existing bug", Labels: []string{"demo"}}}
+	client.CreatedDiscussions = []types.Discussion{{NodeID: "D_1", Number: 2, Title: "Old discussion", Category: "General"}}
+	client.CreatedPRs = []types.PullRequest{{NodeID: "PR_1", Number: 3, Title: "Old PR"}}
+	client.Projects = []types.ProjectV2{{ID: "PVT_1", Number: 1, Title: "Demo Board"}}
+	client.ProjectItems = map[string][]string{"PVT_1": {"I_1"}}
+	logger := &testutil.MockLogger{}
+
+	cleanupOptions := CleanupOptions{
+		CleanIssues:       true,
+		CleanDiscussions:  true,
+		CleanPRs:          true,
+		CleanLabels:       true,
+		DryRun:            true,
+		ClearProjectItems: true,
+		ProjectTitle:      "Demo Board",
+		PruneBranchPrefix: "demo/",
+		CloseComment:      "Closed by gh-demo cleanup",
+	}
+	if _, err := CleanupBeforeHydration(context.Background(), client, cleanupOptions, logger); err != nil {
+		t.Fatalf("Unexpected cleanup error: %v", err)
+	}
+	cleanupOptions.CloseDiscussions = true
+	if _, err := CleanupBeforeHydration(context.Background(), client, cleanupOptions, logger); err != nil {
+		t.Fatalf("Unexpected cleanup error: %v", err)
+	}
+
+	hydrateOptions := HydrateOptions{
+		IncludeIssues:       true,
+		IncludeDiscussions:  true,
+		IncludePullRequests: true,
+		DryRun:              true,
+		CreateProject:       true,
+		ProjectConfigPath:   filepath.Join(tempDir, config.ProjectConfigFilename),
+		PruneLabels:         true,
+		DefaultLabels:       true,
+		Idempotent:          true,
+		Upsert:              true,
+		UseCodeowners:       true,
+		BlockedByLines:      true,
+		SeedFiles:           true,
+		Delay:               1,
+		Manifest:            NewManifest(),
+		Metadata:            NewRunMetadata("run-1", time.Now()),
+	}
+	if err := HydrateWithOptions(context.Background(), client, cfg, hydrateOptions, logger); err != nil {
+		t.Fatalf("Unexpected hydration error: %v", err)
+	}
+	// Defaults that are defined are never cleared, so clearing them runs without --default-labels
+	hydrateOptions.DefaultLabels, hydrateOptions.ClearDefaultLabels = false, true
+	if err := HydrateWithOptions(context.Background(), client, cfg, hydrateOptions, logger); err != nil {
+		t.Fatalf("Unexpected hydration error: %v", err)
+	}
+
+	manifest := &Manifest{Labels: []string{"demo"}, Project: &ManifestEntry{Title: "Demo Board", NodeID: "PVT_1"}, ProjectCreated: true}
+	if _, err := Teardown(context.Background(), client, CleanupOptions{DryRun: true}, "demo", manifest, logger); err != nil {
+		t.Fatalf("Unexpected teardown error: %v", err)
+	}
+
+	if len(client.mutations) != 0 {
+		t.Errorf("Expected no mutating calls in a dry run, got %v", client.mutations)
+	}
+	logged := strings.Join(logger.InfoCalls, "\n")
+	expectedLogs := []string{
+		"Would delete issue: Existing bug", "Would close discussion: Old discussion", "Would delete branch: demo/old",
+		"Would create ProjectV2", "Would update issue #1", "Would update label: bug", "Would write file: README.md",
+		"Would comment on 'Crash' referencing 'Feature'", "Would delete default label: wontfix", "Would delete project",
+	}
+	for _, expected := range expectedLogs {
+		if !strings.Contains(logged, expected) {
+			t.Errorf("Expected %q to be logged, got: %v", expected, logger.InfoCalls)
+		}
+	}
+}
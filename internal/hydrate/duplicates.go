@@ -0,0 +1,99 @@
+package hydrate
+
+import (
+	"context"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// linkDuplicateIssues closes each issue that sets DuplicateOfTitle as a duplicate of the issue with
+// that title. It runs after issues are created so that the original can be another issue from the
+// same run. Both issues are looked up among the issues created in this run, so issues left by an
+// earlier run with the same titles are never closed or linked; only with options.LinkExisting can
+// the original be an open issue this run did not create. Issues whose original is missing, or that
+// fail to be marked, are skipped with a warning: the issue itself was created, so the link is
+// treated like other optional extras.
+func linkDuplicateIssues(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, created []CreatedItem, options HydrateOptions, logger common.Logger) error {
+	var duplicates []types.Issue
+	for _, issue := range issues {
+		if issue.DuplicateOfTitle != "" {
+			duplicates = append(duplicates, issue)
+		}
+	}
+	if len(duplicates) == 0 {
+		return nil
+	}
+
+	if options.DryRun {
+		for _, issue := range duplicates {
+			itemLogger(logger, options.DryRunSummary != nil).Info("Would close issue '%s' as a duplicate of '%s'", issue.Title, issue.DuplicateOfTitle)
+		}
+		return nil
+	}
+
+	byTitle := createdByTitle(created)
+	existing, err := existingIssuesByTitle(ctx, client, options.LinkExisting, logger)
+	if err != nil {
+		return err
+	}
+
+	for _, issue := range duplicates {
+		if err := ctx.Err(); err != nil {
+			return errors.ContextError("link_duplicates", err)
+		}
+
+		duplicate, found := byTitle[issue.Title]
+		if !found {
+			logger.Debug("Issue '%s' was not created, not marking it as a duplicate", issue.Title)
+			continue
+		}
+		original, found := byTitle[issue.DuplicateOfTitle]
+		if !found {
+			original, found = existing[issue.DuplicateOfTitle]
+		}
+		if !found || original.NodeID == duplicate.NodeID {
+			logger.Info("Warning: issue '%s' is a duplicate of '%s', but no issue with that title was created; leaving it open", issue.Title, issue.DuplicateOfTitle)
+			continue
+		}
+
+		if err := client.MarkIssueAsDuplicate(ctx, duplicate.NodeID, original.Number); err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			logger.Info("Warning: could not mark issue '%s' as a duplicate of #%d: %v", issue.Title, original.Number, err)
+			continue
+		}
+		logger.Info("Closed issue '%s' as a duplicate of #%d", issue.Title, original.Number)
+	}
+
+	return nil
+}
+
+// existingIssuesByTitle maps the titles of the repository's open issues to the issues when
+// linkExisting is set, keeping the first match so that an older issue wins over a later one with
+// the same title. It returns nil when linkExisting is not set or the issues cannot be listed.
+func existingIssuesByTitle(ctx context.Context, client githubapi.GitHubClient, linkExisting bool, logger common.Logger) (map[string]CreatedItem, error) {
+	if !linkExisting {
+		return nil, nil
+	}
+
+	openIssues, err := client.ListIssues(ctx)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return nil, err
+		}
+		logger.Info("Warning: could not list issues to link duplicates to existing issues: %v", err)
+		return nil, nil
+	}
+
+	byTitle := make(map[string]CreatedItem, len(openIssues))
+	for _, issue := range openIssues {
+		if _, exists := byTitle[issue.Title]; !exists {
+			byTitle[issue.Title] = CreatedItem{NodeID: issue.NodeID, Title: issue.Title, Type: "issue", Number: issue.Number}
+		}
+	}
+	return byTitle, nil
+}
@@ -0,0 +1,116 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestCreateRepositoryContent_DuplicateOf tests closing issues as duplicates after creation
+func TestCreateRepositoryContent_DuplicateOf(t *testing.T) {
+	tests := []struct {
+		name               string
+		issues             []types.Issue
+		existing           []types.Issue // open issues left by an earlier run
+		linkExisting       bool
+		mockConfig         MockConfig
+		dryRun             bool
+		expectedDuplicates map[string]int
+		expectedLog        string
+	}{
+		{
+			name: "duplicate is linked to the original",
+			issues: []types.Issue{
+				{Title: "Login fails"},
+				{Title: "Cannot sign in", DuplicateOfTitle: "Login fails"},
+			},
+			expectedDuplicates: map[string]int{"mock-issue-id-2": 1},
+			expectedLog:        "Closed issue 'Cannot sign in' as a duplicate of #1",
+		},
+		{
+			name: "missing original is a warning",
+			issues: []types.Issue{
+				{Title: "Cannot sign in", DuplicateOfTitle: "Login fails"},
+			},
+			expectedDuplicates: map[string]int{},
+			expectedLog:        "Warning: issue 'Cannot sign in' is a duplicate of 'Login fails', but no issue with that title was created",
+		},
+		{
+			name: "issues from an earlier run are left untouched",
+			issues: []types.Issue{
+				{Title: "Login fails"},
+				{Title: "Cannot sign in", DuplicateOfTitle: "Login fails"},
+			},
+			existing:           []types.Issue{{Title: "Login fails", NodeID: "old-login", Number: 1}, {Title: "Cannot sign in", NodeID: "old-sign-in", Number: 2}},
+			expectedDuplicates: map[string]int{"mock-issue-id-4": 3},
+			expectedLog:        "Closed issue 'Cannot sign in' as a duplicate of #3",
+		},
+		{
+			name: "existing original is not linked without opting in",
+			issues: []types.Issue{
+				{Title: "Cannot sign in", DuplicateOfTitle: "Login fails"},
+			},
+			existing:           []types.Issue{{Title: "Login fails", NodeID: "old-login", Number: 1}},
+			expectedDuplicates: map[string]int{},
+			expectedLog:        "Warning: issue 'Cannot sign in' is a duplicate of 'Login fails', but no issue with that title was created",
+		},
+		{
+			name: "existing original is linked when opted in",
+			issues: []types.Issue{
+				{Title: "Cannot sign in", DuplicateOfTitle: "Login fails"},
+			},
+			existing:           []types.Issue{{Title: "Login fails", NodeID: "old-login", Number: 1}},
+			linkExisting:       true,
+			expectedDuplicates: map[string]int{"mock-issue-id-2": 1},
+			expectedLog:        "Closed issue 'Cannot sign in' as a duplicate of #1",
+		},
+		{
+			name: "marking failure is a warning",
+			issues: []types.Issue{
+				{Title: "Login fails"},
+				{Title: "Cannot sign in", DuplicateOfTitle: "Login fails"},
+			},
+			mockConfig:         MockConfig{MarkDuplicate: testutil.ErrorConfig{ShouldError: true}},
+			expectedDuplicates: map[string]int{},
+			expectedLog:        "Warning: could not mark issue 'Cannot sign in' as a duplicate of #1",
+		},
+		{
+			name: "dry-run only reports the link",
+			issues: []types.Issue{
+				{Title: "Login fails"},
+				{Title: "Cannot sign in", DuplicateOfTitle: "Login fails"},
+			},
+			dryRun:             true,
+			expectedDuplicates: map[string]int{},
+			expectedLog:        "Would close issue 'Cannot sign in' as a duplicate of 'Login fails'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.mockConfig)
+			client.CreatedIssues = append(client.CreatedIssues, tt.existing...)
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludeIssues: true, DryRun: tt.dryRun, LinkExisting: tt.linkExisting}
+
+			if _, err := createRepositoryContent(context.Background(), client, tt.issues, nil, nil, options, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(client.DuplicateOf) != len(tt.expectedDuplicates) {
+				t.Errorf("Expected duplicates %v, got %v", tt.expectedDuplicates, client.DuplicateOf)
+			}
+			for nodeID, number := range tt.expectedDuplicates {
+				if client.DuplicateOf[nodeID] != number {
+					t.Errorf("Expected %s to be a duplicate of #%d, got %v", nodeID, number, client.DuplicateOf)
+				}
+			}
+			if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedLog) {
+				t.Errorf("Expected log containing %q, got: %v", tt.expectedLog, logger.InfoCalls)
+			}
+		})
+	}
+}
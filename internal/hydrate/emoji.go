@@ -0,0 +1,90 @@
+package hydrate
+
+import (
+	"regexp"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// shortcodePattern matches an emoji shortcode such as ":rocket:" or ":+1:"
+var shortcodePattern = regexp.MustCompile(`:[a-z0-9_+\-]+:`)
+
+// emojiShortcodes maps the GitHub emoji shortcodes most used in demo content to their Unicode
+// equivalents. Shortcodes that are not listed are left for GitHub to render.
+var emojiShortcodes = map[string]string{
+	":+1:":                       "👍",
+	":-1:":                       "👎",
+	":100:":                      "💯",
+	":art:":                      "🎨",
+	":bug:":                      "🐛",
+	":bulb:":                     "💡",
+	":books:":                    "📚",
+	":boom:":                     "💥",
+	":calendar:":                 "📆",
+	":chart_with_upwards_trend:": "📈",
+	":clipboard:":                "📋",
+	":construction:":             "🚧",
+	":eyes:":                     "👀",
+	":fire:":                     "🔥",
+	":gear:":                     "⚙️",
+	":hammer:":                   "🔨",
+	":heart:":                    "❤️",
+	":heavy_check_mark:":         "✔️",
+	":hourglass:":                "⌛",
+	":information_source:":       "ℹ️",
+	":lock:":                     "🔒",
+	":mag:":                      "🔍",
+	":memo:":                     "📝",
+	":package:":                  "📦",
+	":pencil:":                   "📝",
+	":pushpin:":                  "📌",
+	":question:":                 "❓",
+	":recycle:":                  "♻️",
+	":rocket:":                   "🚀",
+	":rotating_light:":           "🚨",
+	":sparkles:":                 "✨",
+	":star:":                     "⭐",
+	":tada:":                     "🎉",
+	":thumbsdown:":               "👎",
+	":thumbsup:":                 "👍",
+	":warning:":                  "⚠️",
+	":white_check_mark:":         "✅",
+	":wrench:":                   "🔧",
+	":x:":                        "❌",
+	":zap:":                      "⚡",
+}
+
+// renderEmoji replaces known emoji shortcodes in text with their Unicode equivalents
+func renderEmoji(text string) string {
+	return shortcodePattern.ReplaceAllStringFunc(text, func(shortcode string) string {
+		if emoji, known := emojiShortcodes[shortcode]; known {
+			return emoji
+		}
+		return shortcode
+	})
+}
+
+// renderContentEmoji replaces known emoji shortcodes in the titles and bodies of every item, and in
+// the titles issues reference as their original, their dependencies or their epic children so that
+// the references still match.
+func renderContentEmoji(issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) {
+	for i := range issues {
+		issues[i].Title = renderEmoji(issues[i].Title)
+		issues[i].Body = renderEmoji(issues[i].Body)
+		issues[i].DuplicateOfTitle = renderEmoji(issues[i].DuplicateOfTitle)
+		for j := range issues[i].DependsOnTitles {
+			issues[i].DependsOnTitles[j] = renderEmoji(issues[i].DependsOnTitles[j])
+		}
+		for j := range issues[i].EpicChildrenTitles {
+			issues[i].EpicChildrenTitles[j] = renderEmoji(issues[i].EpicChildrenTitles[j])
+		}
+	}
+	for i := range discussions {
+		discussions[i].Title = renderEmoji(discussions[i].Title)
+		discussions[i].Body = renderEmoji(discussions[i].Body)
+	}
+	for i := range pullRequests {
+		pullRequests[i].Title = renderEmoji(pullRequests[i].Title)
+		pullRequests[i].Body = renderEmoji(pullRequests[i].Body)
+	}
+}
@@ -0,0 +1,70 @@
+package hydrate
+
+import (
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestRenderEmoji tests replacing known shortcodes and preserving unknown ones
+func TestRenderEmoji(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			name:     "known shortcode is replaced",
+			input:    "Launch :rocket:",
+			expected: "Launch 🚀",
+		},
+		{
+			name:     "several shortcodes are replaced",
+			input:    ":bug: fixed :+1: :tada:",
+			expected: "🐛 fixed 👍 🎉",
+		},
+		{
+			name:     "unknown shortcode is preserved",
+			input:    "Ship it :shipit: :rocket:",
+			expected: "Ship it :shipit: 🚀",
+		},
+		{
+			name:     "times and plain colons are untouched",
+			input:    "Meeting at 10:30: agenda",
+			expected: "Meeting at 10:30: agenda",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := renderEmoji(tt.input); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestRenderContentEmoji tests that titles, bodies and duplicate references are rendered
+func TestRenderContentEmoji(t *testing.T) {
+	issues := []types.Issue{
+		{Title: ":bug: Crash", Body: "Steps :memo:"},
+		{Title: "Crash again", DuplicateOfTitle: ":bug: Crash"},
+	}
+	discussions := []types.Discussion{{Title: ":tada: Welcome", Body: ":wave:"}}
+	pullRequests := []types.PullRequest{{Title: ":sparkles: Feature", Body: ":rocket:"}}
+
+	renderContentEmoji(issues, discussions, pullRequests)
+
+	if issues[0].Title != "🐛 Crash" || issues[0].Body != "Steps 📝" {
+		t.Errorf("Expected issue to be rendered, got %q / %q", issues[0].Title, issues[0].Body)
+	}
+	if issues[1].DuplicateOfTitle != issues[0].Title {
+		t.Errorf("Expected duplicate reference %q to match rendered title %q", issues[1].DuplicateOfTitle, issues[0].Title)
+	}
+	if discussions[0].Title != "🎉 Welcome" || discussions[0].Body != ":wave:" {
+		t.Errorf("Expected discussion title rendered and unknown body shortcode kept, got %q / %q", discussions[0].Title, discussions[0].Body)
+	}
+	if pullRequests[0].Title != "✨ Feature" || pullRequests[0].Body != "🚀" {
+		t.Errorf("Expected pull request to be rendered, got %q / %q", pullRequests[0].Title, pullRequests[0].Body)
+	}
+}
@@ -0,0 +1,91 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// linkEpicChildren appends a "- [ ] #N" task list of its children to the body of each issue that sets
+// EpicChildrenTitles. It runs after issues are created so that the children can come from the same
+// run. Epics and children are the issues created in this run, so issues left by an earlier run with
+// the same titles are never linked or changed. Children that were not created are skipped with a
+// warning, and an epic whose update fails is left as it was created.
+func linkEpicChildren(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, created []CreatedItem, options HydrateOptions, logger common.Logger) error {
+	var epics []types.Issue
+	for _, issue := range issues {
+		if len(issue.EpicChildrenTitles) > 0 {
+			epics = append(epics, issue)
+		}
+	}
+	if len(epics) == 0 {
+		return nil
+	}
+
+	if options.DryRun {
+		for _, epic := range epics {
+			itemLogger(logger, options.DryRunSummary != nil).Info("Would add a task list of %d child issues to '%s'", len(epic.EpicChildrenTitles), epic.Title)
+		}
+		return nil
+	}
+
+	byTitle := createdByTitle(created)
+
+	for _, epic := range epics {
+		if err := ctx.Err(); err != nil {
+			return errors.ContextError("link_epic_children", err)
+		}
+
+		createdEpic, found := byTitle[epic.Title]
+		if !found {
+			logger.Debug("Epic '%s' was not created, not adding its task list", epic.Title)
+			continue
+		}
+
+		tasks := epicTasks(epic, createdEpic, byTitle, logger)
+		if len(tasks) == 0 {
+			continue
+		}
+		if err := appendEpicTasks(ctx, client, createdEpic, tasks); err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			logger.Info("Warning: could not add the task list to epic '%s': %v", epic.Title, err)
+			continue
+		}
+		logger.Info("Added a task list of %d child issues to epic #%d", len(tasks), createdEpic.Number)
+	}
+
+	return nil
+}
+
+// epicTasks returns a "- [ ] #N" task list item for each child of epic created in this run
+func epicTasks(epic types.Issue, createdEpic CreatedItem, byTitle map[string]CreatedItem, logger common.Logger) []string {
+	var tasks []string
+	for _, child := range epic.EpicChildrenTitles {
+		issue, found := byTitle[child]
+		if !found || issue.NodeID == createdEpic.NodeID {
+			logger.Info("Warning: epic '%s' lists child '%s', but no issue with that title was created; leaving it out of the task list", epic.Title, child)
+			continue
+		}
+		tasks = append(tasks, fmt.Sprintf("- [ ] #%d", issue.Number))
+	}
+	return tasks
+}
+
+// appendEpicTasks adds tasks to the body of the created epic. The issue is fetched first so that the
+// update, which replaces labels and assignees, sends back all of the ones it has.
+func appendEpicTasks(ctx context.Context, client githubapi.GitHubClient, createdEpic CreatedItem, tasks []string) error {
+	current, err := client.GetIssue(ctx, createdEpic.Number)
+	if err != nil {
+		return err
+	}
+	current.Body = withBlockedBy(current.Body, strings.Join(tasks, "\n"))
+	_, err = client.UpdateIssue(ctx, createdEpic.NodeID, *current)
+	return err
+}
@@ -0,0 +1,102 @@
+package hydrate
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestCreateRepositoryContent_EpicChildren tests adding task lists of child issues to epics after creation
+func TestCreateRepositoryContent_EpicChildren(t *testing.T) {
+	tests := []struct {
+		name         string
+		issues       []types.Issue
+		existing     []types.Issue // open issues left by an earlier run
+		mockConfig   MockConfig
+		dryRun       bool
+		expectedBody string
+		expectedLog  string
+	}{
+		{
+			name: "task list of children",
+			issues: []types.Issue{
+				{Title: "Epic", Body: "Ship the feature", EpicChildrenTitles: []string{"Design", "Build"}},
+				{Title: "Design"},
+				{Title: "Build"},
+			},
+			expectedBody: "Ship the feature\n\n- [ ] #2\n- [ ] #3",
+			expectedLog:  "Added a task list of 2 child issues to epic #1",
+		},
+		{
+			name: "missing child is skipped",
+			issues: []types.Issue{
+				{Title: "Epic", Body: "Ship the feature", EpicChildrenTitles: []string{"Design", "Missing"}},
+				{Title: "Design"},
+			},
+			expectedBody: "Ship the feature\n\n- [ ] #2",
+			expectedLog:  "Warning: epic 'Epic' lists child 'Missing', but no issue with that title was created",
+		},
+		{
+			name: "issues from an earlier run are ignored",
+			issues: []types.Issue{
+				{Title: "Epic", Body: "Ship the feature", Labels: []string{"epic"}, Assignees: []string{"octocat"}, EpicChildrenTitles: []string{"Design"}},
+				{Title: "Design"},
+			},
+			existing:     []types.Issue{{Title: "Epic", NodeID: "old-epic", Number: 1}, {Title: "Design", NodeID: "old-design", Number: 2}},
+			expectedBody: "Ship the feature\n\n- [ ] #4",
+			expectedLog:  "Added a task list of 1 child issues to epic #3",
+		},
+		{
+			name: "update failure is a warning",
+			issues: []types.Issue{
+				{Title: "Epic", Body: "Ship the feature", EpicChildrenTitles: []string{"Design"}},
+				{Title: "Design"},
+			},
+			mockConfig:   MockConfig{UpdateIssue: testutil.ErrorConfig{ShouldError: true}},
+			expectedBody: "Ship the feature",
+			expectedLog:  "Warning: could not add the task list to epic 'Epic'",
+		},
+		{
+			name: "dry-run only reports the task list",
+			issues: []types.Issue{
+				{Title: "Epic", Body: "Ship the feature", EpicChildrenTitles: []string{"Design"}},
+				{Title: "Design"},
+			},
+			dryRun:      true,
+			expectedLog: "Would add a task list of 1 child issues to 'Epic'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.mockConfig)
+			client.CreatedIssues = append(client.CreatedIssues, tt.existing...)
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludeIssues: true, DryRun: tt.dryRun}
+
+			if _, err := createRepositoryContent(context.Background(), client, tt.issues, nil, nil, options, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !tt.dryRun {
+				epic := client.CreatedIssues[len(tt.existing)]
+				if epic.Body != tt.expectedBody {
+					t.Errorf("Expected epic body %q, got %q", tt.expectedBody, epic.Body)
+				}
+				if !reflect.DeepEqual(epic.Labels, tt.issues[0].Labels) || !reflect.DeepEqual(epic.Assignees, tt.issues[0].Assignees) {
+					t.Errorf("Expected epic labels %v and assignees %v to be kept, got %v and %v", tt.issues[0].Labels, tt.issues[0].Assignees, epic.Labels, epic.Assignees)
+				}
+				if len(tt.existing) > 0 && client.CreatedIssues[0].Body != "" {
+					t.Errorf("Expected the earlier epic to be left unchanged, got body %q", client.CreatedIssues[0].Body)
+				}
+			}
+			if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedLog) {
+				t.Errorf("Expected log containing %q, got: %v", tt.expectedLog, logger.InfoCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// seedRepositoryFiles writes each file of files.json to the repository's default branch, creating
+// it or updating an existing file. It runs before content is created, so that the demo repository
+// has its README when the first issue appears. A failed write stops the run, since the files were
+// requested explicitly with --seed-files.
+func seedRepositoryFiles(ctx context.Context, client githubapi.GitHubClient, files []types.SeedFile, options HydrateOptions, logger common.Logger) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	if options.DryRun {
+		for _, file := range files {
+			itemLogger(logger, options.DryRunSummary != nil).Info("Would write file: %s", file.Path)
+		}
+		return nil
+	}
+
+	for _, file := range files {
+		if err := ctx.Err(); err != nil {
+			return errors.ContextError("seed_files", err)
+		}
+		if err := client.CreateOrUpdateFile(ctx, file.Path, file.Content, file.Message); err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			return errors.WrapWithOperation(err, "hydrate", "seed_files", fmt.Sprintf("failed to write file '%s'", file.Path))
+		}
+		logger.Info("Wrote file: %s", file.Path)
+	}
+	return nil
+}
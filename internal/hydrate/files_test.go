@@ -0,0 +1,84 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestHydrateWithOptions_SeedFiles tests that files.json is written to the repository only when requested
+func TestHydrateWithOptions_SeedFiles(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"issues.json":    `[{"title": "Issue", "body": "Body"}]`,
+		"files.json":     `[{"path": "README.md", "source": "README.demo.md"}, {"path": "docs/guide.md", "content": "Guide", "message": "Add the guide"}]`,
+		"README.demo.md": "# Demo",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+	cfg := config.NewConfiguration(context.Background(), tempDir)
+
+	tests := []struct {
+		name          string
+		options       HydrateOptions
+		mockConfig    MockConfig
+		expectError   string
+		expectedFiles map[string]string
+		expectIssues  int
+	}{
+		{
+			name:          "files written before content",
+			options:       HydrateOptions{IncludeIssues: true, SeedFiles: true},
+			expectedFiles: map[string]string{"README.md": "# Demo", "docs/guide.md": "Guide"},
+			expectIssues:  1,
+		},
+		{
+			name:         "files ignored without the flag",
+			options:      HydrateOptions{IncludeIssues: true},
+			expectIssues: 1,
+		},
+		{
+			name:         "dry run writes nothing",
+			options:      HydrateOptions{IncludeIssues: true, SeedFiles: true, DryRun: true},
+			expectIssues: 0,
+		},
+		{
+			name:         "failed write stops the run",
+			options:      HydrateOptions{IncludeIssues: true, SeedFiles: true},
+			mockConfig:   MockConfig{WriteFile: testutil.ErrorConfig{ShouldError: true}},
+			expectError:  "failed to write file 'README.md'",
+			expectIssues: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.mockConfig)
+
+			err := HydrateWithOptions(context.Background(), client, cfg, tt.options, &testutil.MockLogger{})
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(client.Files, tt.expectedFiles) {
+				t.Errorf("Expected files %v, got %v", tt.expectedFiles, client.Files)
+			}
+			if len(client.CreatedIssues) != tt.expectIssues {
+				t.Errorf("Expected %d created issues, got %d", tt.expectIssues, len(client.CreatedIssues))
+			}
+		})
+	}
+}
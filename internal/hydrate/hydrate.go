@@ -10,7 +10,10 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/chrisreddington/gh-demo/internal/common"
 	"github.com/chrisreddington/gh-demo/internal/config"
@@ -22,11 +25,13 @@ import (
 // SectionSummary holds statistics for a hydration section (labels, issues, discussions, pull requests).
 // It tracks the total number of items processed, successful operations, failures, and detailed error messages.
 type SectionSummary struct {
-	Name     string   // Name of the section (e.g., "Issues", "Labels")
-	Total    int      // Total number of items to process
-	Success  int      // Number of successful operations
-	Failures int      // Number of failed operations
-	Errors   []string // Detailed error messages for failed operations
+	Name     string        // Name of the section (e.g., "Issues", "Labels")
+	Total    int           // Total number of items to process
+	Success  int           // Number of successful operations
+	Failures int           // Number of failed operations
+	Errors   []string      // Detailed error messages for failed operations
+	Created  []CreatedItem // Items created in this section, including their GitHub numbers
+	Updated  int           // Number of existing items updated rather than created, counted within Success
 }
 
 // CleanupOptions defines the options for cleanup operations
@@ -37,19 +42,73 @@ type CleanupOptions struct {
 	CleanLabels      bool
 	DryRun           bool
 	PreserveConfig   *config.PreserveConfig
+
+	// ClearProjectItems removes every item from the project titled ProjectTitle, keeping the project itself
+	ClearProjectItems bool
+	ProjectTitle      string
+
+	// TitlePrefix, when set, limits issue, discussion and pull request cleanup to items whose title starts with it
+	TitlePrefix string
+
+	// Concurrency is the number of issue, discussion and pull request deletions run at once (values below 1 mean 1)
+	Concurrency int
+
+	// Delay is how long each worker waits between its deletions, to stay below GitHub's secondary rate limits
+	Delay time.Duration
+
+	// CloseDiscussions closes discussions as outdated instead of deleting them, keeping their history
+	CloseDiscussions bool
+
+	// CloseComment, when set, is commented on each issue and pull request before cleanup closes it,
+	// so that anyone watching the repository sees why
+	CloseComment string
+
+	// SummaryOnly logs the per-item lines of a dry run at debug level, leaving only the totals
+	SummaryOnly bool
+
+	// LabelManifest, when set, limits label cleanup to the labels it records as created by a run,
+	// so labels that existed before the run are never deleted
+	LabelManifest *Manifest
+
+	// PruneBranchPrefix, when set, deletes branches whose name starts with it, except the default and protected branches
+	PruneBranchPrefix string
+
+	// MarkerLabel, when set, limits issue, discussion and pull request cleanup to items carrying it
+	MarkerLabel string
+
+	// DeleteProject deletes the project with ProjectID or, when ProjectID is empty, the project titled ProjectTitle
+	DeleteProject bool
+	ProjectID     string
 }
 
-// CleanupSummary holds statistics for cleanup operations
+// CleanupSummary holds statistics for cleanup operations. It is also written as JSON by
+// --cleanup-summary-file so that CI can assert on teardown results.
 type CleanupSummary struct {
-	IssuesDeleted        int
-	IssuesPreserved      int
-	DiscussionsDeleted   int
-	DiscussionsPreserved int
-	PRsDeleted           int
-	PRsPreserved         int
-	LabelsDeleted        int
-	LabelsPreserved      int
-	Errors               []string
+	IssuesDeleted        int      `json:"issues_deleted"`
+	IssuesPreserved      int      `json:"issues_preserved"`
+	DiscussionsDeleted   int      `json:"discussions_deleted"`
+	DiscussionsPreserved int      `json:"discussions_preserved"`
+	PRsDeleted           int      `json:"pull_requests_deleted"`
+	PRsPreserved         int      `json:"pull_requests_preserved"`
+	LabelsDeleted        int      `json:"labels_deleted"`
+	LabelsPreserved      int      `json:"labels_preserved"`
+	BranchesDeleted      int      `json:"branches_deleted"`
+	BranchesSkipped      int      `json:"branches_skipped"`
+	ProjectsDeleted      int      `json:"projects_deleted"`
+	Errors               []string `json:"errors"`
+
+	// DeletedItems and PreservedItems list every item cleanup deleted or kept, in the order it finished
+	// with them. In a dry run, DeletedItems lists the items that would be deleted.
+	DeletedItems   []CleanupItem `json:"deleted_items"`
+	PreservedItems []CleanupItem `json:"preserved_items"`
+}
+
+// CleanupItem identifies an item deleted or preserved by cleanup. Type is issue, discussion,
+// pull_request, label, branch or project. Labels are deleted by name, so they have no node ID.
+type CleanupItem struct {
+	Type   string `json:"type"`
+	Title  string `json:"title"`
+	NodeID string `json:"node_id,omitempty"`
 }
 
 // handleListError creates and returns error for list operation failures
@@ -86,164 +145,405 @@ func convertErrorsToStringSlice(collector *errors.ErrorCollector) []string {
 	return nil
 }
 
+// HydrateOptions defines which content types are hydrated and how the hydration behaves
+type HydrateOptions struct {
+	IncludeIssues             bool
+	IncludeDiscussions        bool
+	IncludePullRequests       bool
+	DryRun                    bool
+	CreateProject             bool
+	ProjectConfigPath         string
+	PruneLabels               bool
+	NoAutoLabels              bool // Only ensure labels defined in labels.json; referenced labels are not auto-created
+	LabelsFailOpen            bool // Warn and continue without ensuring labels when the repository labels cannot be listed
+	DefaultLabels             bool // Also ensure GitHub's default labels, which labels.json entries with the same name override
+	LabelsOnly                bool // Only ensure, and when requested prune, the labels defined in labels.json; no content is created
+	NormalizeLabels           bool // Treat a label as existing when a repository label has the same name in any case
+	ClearDefaultLabels        bool // Delete GitHub's default labels, unless defined or referenced, before labels are ensured
+	PreserveConfig            *config.PreserveConfig
+	Idempotent                bool           // Check for an existing item by title before retrying a create that timed out
+	SkipExisting              bool           // Skip discussions whose title already exists in the repository, within their category when one is set
+	Upsert                    bool           // Update the body, labels and assignees of open issues with the same title instead of creating duplicates
+	DefaultDiscussionCategory string         // Category used for discussions that do not specify one
+	DefaultBase               string         // Base branch for pull requests that do not specify one
+	DetectDefaultBase         bool           // When DefaultBase is empty, use the repository's default branch as the base
+	TruncateBodies            bool           // Truncate bodies over GitHub's length limit instead of failing
+	BodyPreviewLength         int            // When positive, dry-run logs each body truncated to this many characters
+	UseCodeowners             bool           // Request reviews on created pull requests from the global owners in CODEOWNERS
+	BlockedByLines            bool           // End the body of each issue with a "Blocked by #N" line per dependency with a known number
+	Manifest                  *Manifest      // When set, created items and labels are recorded and items already recorded are skipped
+	Report                    *RunReport     // When set, the results of each section are recorded for reporting
+	TitlePrefix               string         // Prepended to the title of every created issue, discussion and pull request
+	RenderEmoji               bool           // Replace known emoji shortcodes in titles and bodies with Unicode emoji
+	UseIssueTemplates         bool           // Apply the title prefix and labels of matching repository issue templates to issues
+	Metadata                  *RunMetadata   // When set, a footer naming the run is appended to every body
+	DryRunSummary             *DryRunSummary // When set during a dry run, per-item lines are only logged at debug level and the counts per type are recorded instead
+	Plan                      *Plan          // When set during a dry run, the labels and content that would be created are recorded
+	Delay                     time.Duration  // Wait between consecutive creates of each content type, to stay below GitHub's secondary rate limits
+	MaxItemsPerType           int            // When positive, fail before creating anything if more items of any type would be created
+	Force                     bool           // Only warn when MaxItemsPerType is exceeded
+	APITimeout                time.Duration  // Time allowed for fetching a labels file from a URL; 0 uses config.APITimeout
+	Color                     bool           // Style the section summaries with ANSI colors
+	Strict                    bool           // Fail instead of warning when labels in labels.json share or nearly share a color
+	RateLimitWait             time.Duration  // When positive, a create rejected by the primary rate limit waits up to this long for the reset and is retried
+	MarkerLabel               string         // When set, added to every created issue, discussion and pull request and ensured to exist
+	SeedFiles                 bool           // Write the files of files.json to the default branch before content is created
+	LinkExisting              bool           // Let duplicate links and cross-references fall back to open items this run did not create
+}
+
 // HydrateWithLabels loads content, collects all labels, and ensures labels exist before hydration.
 // It supports both explicit label definitions from labels.json and auto-generated labels with defaults.
 // It continues processing even if individual items fail, collecting all errors and reporting them at the end.
 func HydrateWithLabels(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration, includeIssues, includeDiscussions, includePullRequests bool, logger common.Logger, dryRun bool) error {
+	return HydrateWithOptions(ctx, client, cfg, HydrateOptions{
+		IncludeIssues:       includeIssues,
+		IncludeDiscussions:  includeDiscussions,
+		IncludePullRequests: includePullRequests,
+		DryRun:              dryRun,
+	}, logger)
+}
 
-	if dryRun {
+// HydrateWithProject loads content, collects all labels, ensures labels exist, and optionally creates a ProjectV2.
+// When createProject is true, it creates a project and associates all created content with it.
+// It supports both explicit label definitions from labels.json and auto-generated labels with defaults.
+// It continues processing even if individual items fail, collecting all errors and reporting them at the end.
+func HydrateWithProject(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration, includeIssues, includeDiscussions, includePullRequests bool, logger common.Logger, dryRun bool, createProject bool, projectConfigPath string) error {
+	return HydrateWithOptions(ctx, client, cfg, HydrateOptions{
+		IncludeIssues:       includeIssues,
+		IncludeDiscussions:  includeDiscussions,
+		IncludePullRequests: includePullRequests,
+		DryRun:              dryRun,
+		CreateProject:       createProject,
+		ProjectConfigPath:   projectConfigPath,
+	}, logger)
+}
+
+// HydrateWithOptions loads content, ensures all labels exist, optionally prunes labels that are not
+// defined, and creates the requested content. When CreateProject is set, created content is also
+// associated with a new ProjectV2.
+func HydrateWithOptions(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration, options HydrateOptions, logger common.Logger) error {
+	if options.DryRun {
 		logger.Info("Starting hydration operations (dry-run: true)")
 	}
 
-	issues, discussions, pullRequests, err := HydrateFromConfiguration(ctx, cfg, includeIssues, includeDiscussions, includePullRequests)
+	// Content files are not even read, so labels they reference are not created either
+	if options.LabelsOnly {
+		logger.Info("Reconciling labels only; no issues, discussions or pull requests are created")
+		return ensureRepositoryLabels(ctx, client, cfg, nil, nil, nil, options, logger)
+	}
+
+	issues, discussions, pullRequests, err := HydrateFromConfiguration(ctx, cfg, options.IncludeIssues, options.IncludeDiscussions, options.IncludePullRequests)
 	if err != nil {
 		return errors.ConfigError("load_config_files", "failed to load configuration files", err)
 	}
-
-	// Try to read explicit label definitions from labels.json
-	explicitLabels, err := ReadLabelsJSON(ctx, cfg.LabelsPath)
+	if issues, err = orderIssuesByDependencies(issues); err != nil {
+		return err
+	}
+	crossReferences, err := config.LoadCrossReferences(ctx, cfg.CrossReferencesPath)
 	if err != nil {
-		err = errors.WrapWithOperation(err, "config", "read_labels_config", "failed to read labels configuration")
-		return errors.WithContextSafe(err, "path", cfg.LabelsPath)
+		return errors.WithContextSafe(err, "path", cfg.CrossReferencesPath)
+	}
+	var seedFiles []types.SeedFile
+	if options.SeedFiles {
+		if seedFiles, err = config.LoadSeedFiles(ctx, cfg.FilesPath); err != nil {
+			return errors.WithContextSafe(err, "path", cfg.FilesPath)
+		}
 	}
 
-	// Collect label names referenced in content
-	referencedLabelNames := CollectLabels(ctx, issues, discussions, pullRequests)
-
-	// Prepare the final list of labels to ensure exist
-	labelsToEnsure := prepareLabelsToEnsure(ctx, explicitLabels, referencedLabelNames)
+	// Rewrite titles first so that the manifest and idempotency checks see the titles that are created
+	loadedTitles := referenceableTitles(issues, pullRequests)
+	if options.UseIssueTemplates {
+		if err := applyRepositoryIssueTemplates(ctx, client, issues, logger); err != nil {
+			return err
+		}
+	}
+	applyTitlePrefix(options.TitlePrefix, issues, discussions, pullRequests)
+	if options.RenderEmoji {
+		renderContentEmoji(issues, discussions, pullRequests)
+	}
+	renameCrossReferences(crossReferences, loadedTitles, referenceableTitles(issues, pullRequests))
+	// Dates are added first so that the author note ends up above them
+	if err := annotateSimulatedDates(issues, discussions, pullRequests); err != nil {
+		return err
+	}
+	if err := resolveViewerAuthors(ctx, client, issues, discussions, pullRequests, logger); err != nil {
+		return err
+	}
+	annotateAuthors(issues, discussions, pullRequests)
 
-	labelSummary := &SectionSummary{Name: "Labels", Total: len(labelsToEnsure)}
+	// Skip items a previous run already created
+	itemLog := itemLogger(logger, options.DryRunSummary != nil)
+	loadedIssues, loadedDiscussions, loadedPullRequests := len(issues), len(discussions), len(pullRequests)
+	issues = skipRecorded(issues, options.Manifest, "issue", func(issue types.Issue) string { return issue.Title }, itemLog)
+	discussions = skipRecorded(discussions, options.Manifest, "discussion", func(discussion types.Discussion) string { return discussion.Title }, itemLog)
+	pullRequests = skipRecorded(pullRequests, options.Manifest, "pull_request", func(pullRequest types.PullRequest) string { return pullRequest.Title }, itemLog)
+	if options.SkipExisting {
+		if discussions, err = skipExistingDiscussions(ctx, client, discussions, options.DefaultDiscussionCategory, itemLog); err != nil {
+			return err
+		}
+	}
+	updatedIssues := 0
+	if options.Upsert && options.DryRun {
+		if issues, updatedIssues, err = previewIssueUpserts(ctx, client, issues, itemLog); err != nil {
+			return err
+		}
+	}
+	if options.DryRun {
+		options.DryRunSummary.add("issues", len(issues), updatedIssues, loadedIssues-len(issues)-updatedIssues)
+		options.DryRunSummary.add("discussions", len(discussions), 0, loadedDiscussions-len(discussions))
+		options.DryRunSummary.add("pull requests", len(pullRequests), 0, loadedPullRequests-len(pullRequests))
+	}
 
-	if len(explicitLabels) > 0 {
-		logger.Debug("Found %d explicit label definitions from %s", len(explicitLabels), cfg.LabelsPath)
+	if err := checkItemCounts(issues, discussions, pullRequests, options.MaxItemsPerType, options.Force, logger); err != nil {
+		return err
 	}
-	logger.Debug("Found %d total labels to ensure exist", len(labelsToEnsure))
 
-	if err := EnsureDefinedLabelsExist(ctx, client, labelsToEnsure, logger, labelSummary, dryRun); err != nil {
-		return errors.APIError("ensure_labels", "failed to ensure labels exist", err)
+	// The footer counts towards GitHub's body length limit, so it is added before bodies are checked
+	appendMetadata(options.Metadata, issues, discussions, pullRequests)
+	applyMarkerLabel(options.MarkerLabel, issues, discussions, pullRequests)
+
+	if err := checkBodyLengths(ctx, issues, discussions, pullRequests, options.TruncateBodies, logger); err != nil {
+		return err
 	}
 
-	// Report label summary
-	logger.Info("Labels: %d total, %d successful, %d failed", labelSummary.Total, labelSummary.Success, labelSummary.Failures)
+	if options.DryRun {
+		options.Plan.recordContent(issues, discussions, pullRequests)
+	}
 
-	// Create issues, discussions, and pull requests
-	if err := createRepositoryContent(ctx, client, issues, discussions, pullRequests, includeIssues, includeDiscussions, includePullRequests, logger, dryRun); err != nil {
+	if err := ensureRepositoryLabels(ctx, client, cfg, issues, discussions, pullRequests, options, logger); err != nil {
+		return err
+	}
+	if err := seedRepositoryFiles(ctx, client, seedFiles, options, logger); err != nil {
 		return err
 	}
 
-	return nil
-}
+	var created []CreatedItem
+	if !options.CreateProject {
+		created, err = createRepositoryContent(ctx, client, issues, discussions, pullRequests, options, logger)
+	} else {
+		// Create project if requested
+		var project *types.ProjectV2
+		if !options.DryRun {
+			var projectCreated bool
+			project, projectCreated, err = createProjectV2(ctx, client, cfg, options.ProjectConfigPath, logger)
+			if err != nil {
+				return err
+			}
+			options.Report.RecordProject(project)
+			options.Manifest.RecordProject(project, projectCreated)
+		} else {
+			logger.Info("Would create ProjectV2 (skipped in dry-run mode)")
+		}
 
-// HydrateWithProject loads content, collects all labels, ensures labels exist, and optionally creates a ProjectV2.
-// When createProject is true, it creates a project and associates all created content with it.
-// It supports both explicit label definitions from labels.json and auto-generated labels with defaults.
-// It continues processing even if individual items fail, collecting all errors and reporting them at the end.
-func HydrateWithProject(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration, includeIssues, includeDiscussions, includePullRequests bool, logger common.Logger, dryRun bool, createProject bool, projectConfigPath string) error {
-	if dryRun {
-		logger.Info("Starting hydration operations (dry-run: true)")
+		// Create issues, discussions, and pull requests (with project tracking)
+		created, err = createRepositoryContentWithProject(ctx, client, issues, discussions, pullRequests, options, logger, project)
+	}
+	if err != nil && !errors.IsPartialFailure(err) {
+		return err
 	}
 
-	// Load content configuration
-	issues, discussions, pullRequests, err := HydrateFromConfiguration(ctx, cfg, includeIssues, includeDiscussions, includePullRequests)
-	if err != nil {
-		return errors.ConfigError("load_config_files", "failed to load configuration files", err)
+	// Cross-references are posted last, once every item they can name exists
+	if crossErr := postCrossReferences(ctx, client, crossReferences, created, options, logger); crossErr != nil {
+		return crossErr
 	}
+	return err
+}
 
-	// Try to read explicit label definitions from labels.json
-	explicitLabels, err := ReadLabelsJSON(ctx, cfg.LabelsPath)
+// ensureRepositoryLabels reads labels.json, ensures every defined or referenced label exists and,
+// when requested, prunes repository labels that are not part of that set.
+func ensureRepositoryLabels(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, options HydrateOptions, logger common.Logger) error {
+	explicitLabels, updatableLabels, err := readDefinedLabels(ctx, cfg, options, logger)
 	if err != nil {
-		err = errors.WrapWithOperation(err, "config", "read_labels_config", "failed to read labels configuration")
-		return errors.WithContextSafe(err, "path", cfg.LabelsPath)
+		return err
 	}
 
 	// Collect label names referenced in content
 	referencedLabelNames := CollectLabels(ctx, issues, discussions, pullRequests)
 
-	// Prepare the final list of labels to ensure exist
-	labelsToEnsure := prepareLabelsToEnsure(ctx, explicitLabels, referencedLabelNames)
+	// Load the pattern-based descriptions given to auto-generated labels
+	labelDescriptions, err := config.LoadLabelDescriptions(ctx, cfg.LabelDescriptionsPath)
+	if err != nil {
+		return errors.WithContextSafe(err, "path", cfg.LabelDescriptionsPath)
+	}
+
+	// Prepare the final list of labels to ensure exist; the marker label is treated as defined
+	// without counting as a labels.json definition for pruning
+	definedLabels := withMarkerLabel(explicitLabels, options.MarkerLabel)
+	labelsToEnsure := prepareLabelsToEnsure(ctx, definedLabels, referencedLabelNames, labelDescriptions)
+	knownLabels := labelsToEnsure
 
-	labelSummary := &SectionSummary{Name: "Labels", Total: len(labelsToEnsure)}
+	if options.NoAutoLabels {
+		labelsToEnsure = explicitLabelsOnly(labelsToEnsure, definedLabels, logger)
+	}
 
-	if len(explicitLabels) > 0 {
-		logger.Debug("Found %d explicit label definitions from %s", len(explicitLabels), cfg.LabelsPath)
+	// Defaults that are defined or referenced are kept rather than deleted and created again
+	if options.ClearDefaultLabels {
+		if _, err := clearDefaultLabels(ctx, client, knownLabels, options.PreserveConfig, logger, options.DryRun); err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			logger.Info("Clearing default labels encountered errors but continuing with hydration: %v", err)
+		}
 	}
+
+	if options.DryRun {
+		options.Plan.recordLabels(labelsToEnsure)
+	}
+
+	labelSummary := &SectionSummary{Name: "Labels", Total: len(labelsToEnsure)}
 	logger.Debug("Found %d total labels to ensure exist", len(labelsToEnsure))
 
-	if err := EnsureDefinedLabelsExist(ctx, client, labelsToEnsure, logger, labelSummary, dryRun); err != nil {
-		return errors.APIError("ensure_labels", "failed to ensure labels exist", err)
+	if err := ensureLabelsExist(ctx, client, labelsToEnsure, labelSummary, options.ensureLabelsOptions(updatableLabels), logger); err != nil {
+		if !options.LabelsFailOpen || errors.IsContextError(err) {
+			return errors.APIError("ensure_labels", "failed to ensure labels exist", err)
+		}
+		// Items are still created; labels that do not exist are skipped when they are applied
+		logger.Info("Warning: could not ensure labels exist, continuing without them: %v", err)
+		return nil
 	}
 
 	// Report label summary
-	logger.Info("Labels: %d total, %d successful, %d failed", labelSummary.Total, labelSummary.Success, labelSummary.Failures)
+	logger.Info("%s", formatSectionSummary(labelSummary, common.Colorizer{Enabled: options.Color}))
+	options.Report.AddSection(*labelSummary)
 
-	// Create project if requested
-	var project *types.ProjectV2
-	if createProject && !dryRun {
-		project, err = createProjectV2(ctx, client, cfg, projectConfigPath, logger)
-		if err != nil {
+	if !options.PruneLabels {
+		return nil
+	}
+
+	// Pruning without any definitions would delete every unreferenced label, which is never intended
+	if len(explicitLabels) == 0 {
+		logger.Info("Skipping label prune: no labels defined in %s", cfg.LabelsPath)
+		return nil
+	}
+
+	// Labels referenced by content are never pruned, even when they are not auto-created
+	if _, err := PruneUndefinedLabels(ctx, client, knownLabels, options.PreserveConfig, logger, options.DryRun); err != nil {
+		if errors.IsContextError(err) {
 			return err
 		}
-	} else if createProject && dryRun {
-		logger.Info("Would create ProjectV2 (skipped in dry-run mode)")
+		// Pruning is housekeeping; a failed delete should not prevent content creation
+		logger.Info("Label prune encountered errors but continuing with hydration: %v", err)
 	}
 
-	// Create issues, discussions, and pull requests (with project tracking)
-	if err := createRepositoryContentWithProject(ctx, client, issues, discussions, pullRequests, includeIssues, includeDiscussions, includePullRequests, logger, dryRun, project); err != nil {
-		return err
+	return nil
+}
+
+// readDefinedLabels reads the labels defined in labels.json or a shared labels URL, adding GitHub's
+// defaults when requested. It also returns the names of the labels.json definitions, the only ones
+// applied to existing labels: a repository's own colors for GitHub's defaults and for auto-created
+// labels are kept.
+func readDefinedLabels(ctx context.Context, cfg *config.Configuration, options HydrateOptions, logger common.Logger) ([]types.Label, map[string]bool, error) {
+	explicitLabels, err := readLabels(ctx, cfg.LabelsPath, options.APITimeout)
+	if err != nil {
+		err = errors.WrapWithOperation(err, "config", "read_labels_config", "failed to read labels configuration")
+		return nil, nil, errors.WithContextSafe(err, "path", cfg.LabelsPath)
+	}
+	// Only labels.json is checked; GitHub's defaults are distinct enough and auto-created labels share a color by design
+	if err := checkLabelColors(explicitLabels, options.Strict, logger); err != nil {
+		return nil, nil, err
+	}
+	if len(explicitLabels) > 0 {
+		logger.Debug("Found %d explicit label definitions from %s", len(explicitLabels), cfg.LabelsPath)
 	}
 
-	return nil
+	updatableLabels := labelNameSet(explicitLabels)
+	if options.DefaultLabels {
+		explicitLabels = withDefaultLabels(explicitLabels)
+	}
+	return explicitLabels, updatableLabels, nil
+}
+
+// ensureLabelsOptions returns the options ensureLabelsExist runs with during hydration, updating the
+// existing labels named in defined
+func (options HydrateOptions) ensureLabelsOptions(defined map[string]bool) ensureLabelsOptions {
+	return ensureLabelsOptions{
+		Defined:       defined,
+		DryRun:        options.DryRun,
+		Normalize:     options.NormalizeLabels,
+		Manifest:      options.Manifest,
+		DryRunSummary: options.DryRunSummary,
+	}
+}
+
+// explicitLabelsOnly filters labels down to those defined in labels.json, warning about each referenced
+// label that will not be auto-created. Such labels are skipped when items are created unless they
+// already exist in the repository.
+func explicitLabelsOnly(labels, explicitLabels []types.Label, logger common.Logger) []types.Label {
+	defined := make(map[string]struct{}, len(explicitLabels))
+	for _, label := range explicitLabels {
+		defined[label.Name] = struct{}{}
+	}
+
+	var filtered []types.Label
+	for _, label := range labels {
+		if _, ok := defined[label.Name]; ok {
+			filtered = append(filtered, label)
+			continue
+		}
+		logger.Info("Warning: label '%s' is not defined in labels.json and will not be created (--no-auto-labels); items will only get it if it already exists", label.Name)
+	}
+	return filtered
 }
 
 // createRepositoryContent orchestrates the creation of all content types.
 // This function handles the creation of issues, discussions, and pull requests
-// and collects any errors that occur during the process.
-func createRepositoryContent(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, includeIssues, includeDiscussions, includePullRequests bool, logger common.Logger, dryRun bool) error {
-	var allErrors []string
+// and collects any errors that occur during the process. It returns the items it created.
+func createRepositoryContent(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, options HydrateOptions, logger common.Logger) ([]CreatedItem, error) {
+	var allFailures []errors.Failure
+	var created []CreatedItem
 
 	// Create issues, discussions, and pull requests
-	if includeIssues {
-		issueErrors, err := createIssues(ctx, client, issues, logger, dryRun)
+	if options.IncludeIssues {
+		createdIssues, issueFailures, err := createIssues(ctx, client, issues, logger, options)
 		if err != nil {
-			return err
+			return created, err
+		}
+		created = append(created, createdIssues...)
+		if len(issueFailures) > 0 {
+			allFailures = append(allFailures, issueFailures...)
+		}
+		if err := linkDuplicateIssues(ctx, client, issues, createdIssues, options, logger); err != nil {
+			return created, err
 		}
-		if len(issueErrors) > 0 {
-			allErrors = append(allErrors, issueErrors...)
+		if err := linkEpicChildren(ctx, client, issues, createdIssues, options, logger); err != nil {
+			return created, err
 		}
 	}
 
-	if includeDiscussions {
-		discussionErrors, err := createDiscussions(ctx, client, discussions, logger, dryRun)
+	if options.IncludeDiscussions {
+		createdDiscussions, discussionFailures, err := createDiscussions(ctx, client, discussions, logger, options)
 		if err != nil {
-			return err
+			return created, err
 		}
-		if len(discussionErrors) > 0 {
-			allErrors = append(allErrors, discussionErrors...)
+		created = append(created, createdDiscussions...)
+		if len(discussionFailures) > 0 {
+			allFailures = append(allFailures, discussionFailures...)
 		}
 	}
 
-	if includePullRequests {
-		prErrors, err := createPullRequests(ctx, client, pullRequests, logger, dryRun)
+	if options.IncludePullRequests {
+		createdPullRequests, pullRequestFailures, err := createPullRequests(ctx, client, pullRequests, logger, options)
 		if err != nil {
-			return err
+			return created, err
 		}
-		if len(prErrors) > 0 {
-			allErrors = append(allErrors, prErrors...)
+		created = append(created, createdPullRequests...)
+		if len(pullRequestFailures) > 0 {
+			allFailures = append(allFailures, pullRequestFailures...)
 		}
 	}
 
 	// If any errors occurred, return them as a combined error but don't fail completely
-	if len(allErrors) > 0 {
-		return errors.NewPartialFailureError(allErrors)
+	if len(allFailures) > 0 {
+		return created, errors.NewPartialFailureFromFailures(allFailures)
 	}
 
-	return nil
+	return created, nil
 }
 
 // prepareLabelsToEnsure builds the final list of labels that need to be ensured to exist.
-// It combines explicit labels from labels.json with auto-generated labels for any referenced labels.
-func prepareLabelsToEnsure(ctx context.Context, explicitLabels []types.Label, referencedLabelNames []string) []types.Label {
+// It combines explicit labels from labels.json with auto-generated labels for any referenced labels,
+// describing the auto-generated ones from the matching pattern in descriptions.
+func prepareLabelsToEnsure(ctx context.Context, explicitLabels []types.Label, referencedLabelNames []string, descriptions map[string]string) []types.Label {
 	// Create a map of explicit labels by name for quick lookup
 	explicitLabelMap := make(map[string]types.Label)
 	for _, label := range explicitLabels {
@@ -262,18 +562,46 @@ func prepareLabelsToEnsure(ctx context.Context, explicitLabels []types.Label, re
 			// Create a default label for any referenced label not explicitly defined
 			defaultLabel := types.Label{
 				Name:        labelName,
-				Description: "Label created by gh-demo hydration tool",
+				Description: defaultLabelDescription(labelName, descriptions),
 				Color:       config.DefaultLabelColor, // Light gray default color
 			}
 			labelsToEnsure = append(labelsToEnsure, defaultLabel)
 		}
 	}
 
+	// Ensure labels in name order so repeated demo runs create them identically
+	sort.SliceStable(labelsToEnsure, func(i, j int) bool {
+		return labelsToEnsure[i].Name < labelsToEnsure[j].Name
+	})
+
 	return labelsToEnsure
 }
 
+// defaultLabelDescription returns the description for an auto-generated label. Patterns are name
+// prefixes with an optional trailing "*"; the longest matching prefix wins and "{suffix}" in its
+// description is replaced by the rest of the label name. Unmatched labels get the generic description.
+func defaultLabelDescription(name string, descriptions map[string]string) string {
+	bestPattern, bestPrefix, matched := "", "", false
+	for pattern := range descriptions {
+		prefix := strings.TrimSuffix(pattern, "*")
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		// Break ties ("area/" vs "area/*") on pattern text so the result does not depend on map order
+		if !matched || len(prefix) > len(bestPrefix) || (len(prefix) == len(bestPrefix) && pattern < bestPattern) {
+			bestPattern, bestPrefix, matched = pattern, prefix, true
+		}
+	}
+
+	if !matched {
+		return config.DefaultLabelDescription
+	}
+	return strings.ReplaceAll(descriptions[bestPattern], "{suffix}", strings.TrimPrefix(name, bestPrefix))
+}
+
 // createItems is a generic function for creating GitHub objects (issues, discussions, PRs).
-// It eliminates code duplication between the specific creation functions.
+// It eliminates code duplication between the specific creation functions, and returns the items
+// it created along with the failure of each item that could not be created.
 func createItems[T any](
 	ctx context.Context,
 	client githubapi.GitHubClient,
@@ -282,97 +610,143 @@ func createItems[T any](
 	createFunc func(context.Context, T) (*types.CreatedItemInfo, error),
 	getTitleFunc func(T) string,
 	logger common.Logger,
-	dryRun bool,
-) ([]string, error) {
+	options HydrateOptions,
+) ([]CreatedItem, []errors.Failure, error) {
 	if len(items) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 
-	var errors []string
+	var failures []errors.Failure
 	summary := &SectionSummary{Name: itemType, Total: len(items)}
 	logger.Debug("Creating %d %s", len(items), strings.ToLower(itemType))
+	itemLog := itemLogger(logger, options.DryRunSummary != nil)
 
 	for i, item := range items {
 		// Check for cancellation before each item creation
 		if err := ctx.Err(); err != nil {
-			return errors, err
+			return summary.Created, failures, err
 		}
 
 		title := getTitleFunc(item)
-		if dryRun {
-			logger.Info("Would create %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
+		if options.DryRun {
+			itemLog.Info("Would create %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
+			logBodyPreview(item, options.BodyPreviewLength, itemLog)
 			summary.Success++
 		} else {
-			_, err := createFunc(ctx, item)
+			createdItemInfo, err := createFunc(ctx, item)
 			if err != nil {
-				errorMsg := common.FormatCreationError(itemType[:len(itemType)-1], title, i, err)
-				errors = append(errors, errorMsg)
+				index, source := i, ""
+				if file, fileIndex, ok := itemSource(item); ok {
+					index, source = fileIndex, file
+				}
+				errorMsg := common.FormatCreationError(itemType[:len(itemType)-1], title, index, source, err)
+				failures = append(failures, errors.Failure{Section: itemType, Message: errorMsg, Err: err})
 				summary.Errors = append(summary.Errors, errorMsg)
 				summary.Failures++
 				logger.Debug("Failed to create %s '%s': %v", strings.ToLower(itemType[:len(itemType)-1]), title, err)
 			} else {
 				summary.Success++
-				logger.Debug("Successfully created %s '%s'", strings.ToLower(itemType[:len(itemType)-1]), title)
+				created := newCreatedItem(createdItemInfo, title)
+				summary.Created = append(summary.Created, created)
+				logger.Info("%s %s", createdVerb(createdItemInfo), describeCreatedItem(strings.ToLower(itemType[:len(itemType)-1]), created))
 			}
 		}
 	}
-	logger.Info("%s: %d total, %d successful, %d failed", itemType, summary.Total, summary.Success, summary.Failures)
-	return errors, nil
+	logger.Info("%s", formatSectionSummary(summary, common.Colorizer{Enabled: options.Color}))
+	options.Report.AddSection(*summary)
+	return summary.Created, failures, nil
+}
+
+// itemSource returns the content file an item was loaded from and its position in that file.
+// ok is false for items that were not loaded from a file.
+func itemSource(item any) (file string, index int, ok bool) {
+	switch v := item.(type) {
+	case types.Issue:
+		file, index = v.SourceFile, v.SourceIndex
+	case types.Discussion:
+		file, index = v.SourceFile, v.SourceIndex
+	case types.PullRequest:
+		file, index = v.SourceFile, v.SourceIndex
+	}
+	return file, index, file != ""
 }
 
 // createIssues creates all issues and collects any errors that occur.
-// It returns a slice of error messages for any issues that failed to create.
-func createIssues(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, logger common.Logger, dryRun bool) ([]string, error) {
+// It returns the created issues and the failure of any issue that could not be created.
+func createIssues(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, logger common.Logger, options HydrateOptions) ([]CreatedItem, []errors.Failure, error) {
 	return createItems(
 		ctx, client, issues, "Issues",
-		client.CreateIssue,
+		issueCreator(client, logger, options),
 		func(issue types.Issue) string { return issue.Title },
-		logger, dryRun,
+		logger, options,
 	)
 }
 
 // createDiscussions creates all discussions and collects any errors that occur.
-// It returns a slice of error messages for any discussions that failed to create.
-func createDiscussions(ctx context.Context, client githubapi.GitHubClient, discussions []types.Discussion, logger common.Logger, dryRun bool) ([]string, error) {
+// It returns the discussions it created and the failure of any discussion that could not be created.
+func createDiscussions(ctx context.Context, client githubapi.GitHubClient, discussions []types.Discussion, logger common.Logger, options HydrateOptions) ([]CreatedItem, []errors.Failure, error) {
 	return createItems(
 		ctx, client, discussions, "Discussions",
-		client.CreateDiscussion,
+		discussionCreator(client, logger, options),
 		func(discussion types.Discussion) string { return discussion.Title },
-		logger, dryRun,
+		logger, options,
 	)
 }
 
 // createPullRequests creates all pull requests and collects any errors that occur.
-// It returns a slice of error messages for any pull requests that failed to create.
-func createPullRequests(ctx context.Context, client githubapi.GitHubClient, pullRequests []types.PullRequest, logger common.Logger, dryRun bool) ([]string, error) {
+// It returns the pull requests it created and the failure of any pull request that could not be created.
+func createPullRequests(ctx context.Context, client githubapi.GitHubClient, pullRequests []types.PullRequest, logger common.Logger, options HydrateOptions) ([]CreatedItem, []errors.Failure, error) {
 	return createItems(
 		ctx, client, pullRequests, "Pull Requests",
-		client.CreatePR,
+		pullRequestCreator(client, logger, options),
 		func(pr types.PullRequest) string { return pr.Title },
-		logger, dryRun,
+		logger, options,
 	)
 }
 
 // EnsureDefinedLabelsExist creates any missing labels in the repository.
 // It checks which labels already exist and only creates those that are missing.
-// This function works with full Label objects that include color and description.
+// This function works with full Label objects that include color and description,
+// and updates existing labels whose color or description differ from them.
 func EnsureDefinedLabelsExist(ctx context.Context, client githubapi.GitHubClient, labels []types.Label, logger common.Logger, summary *SectionSummary, dryRun bool) error {
+	return ensureLabelsExist(ctx, client, labels, summary, ensureLabelsOptions{Defined: labelNameSet(labels), DryRun: dryRun}, logger)
+}
+
+// ensureLabelsOptions controls how ensureLabelsExist treats existing and missing labels
+type ensureLabelsOptions struct {
+	Defined       map[string]bool // Names of the labels whose existing color and description are updated to match
+	DryRun        bool            // Report what would be created and updated without changing anything
+	Normalize     bool            // Match existing labels whose names differ only by case
+	Manifest      *Manifest       // Records each created label when not nil
+	DryRunSummary *DryRunSummary  // Counts dry-run labels instead of logging each one when not nil
+}
+
+// ensureLabelsExist creates the labels that do not exist yet and updates the existing labels named
+// in options.Defined whose color or description differ; other existing labels are left as they are.
+// A label exists when a repository label has exactly its name or, when options.Normalize is set,
+// the same name in another case.
+func ensureLabelsExist(ctx context.Context, client githubapi.GitHubClient, labels []types.Label, summary *SectionSummary, options ensureLabelsOptions, logger common.Logger) error {
 	if len(labels) == 0 {
 		return nil
 	}
 
 	logger.Debug("Fetching existing labels from repository")
-	existing, err := client.ListLabels(ctx)
+	existing, err := client.ListLabelsDetailed(ctx)
 	if err != nil {
 		return err
 	}
 
-	existSet := make(map[string]struct{}, len(existing))
+	existSet := make(map[string]types.Label, len(existing))
+	foldedSet := make(map[string]types.Label, len(existing))
 	for _, l := range existing {
-		existSet[l] = struct{}{}
+		existSet[l.Name] = l
+		if _, found := foldedSet[strings.ToLower(l.Name)]; !found {
+			foldedSet[strings.ToLower(l.Name)] = l
+		}
 	}
 
 	logger.Debug("Found %d existing labels in repository", len(existing))
+	warnCaseVariantLabels(existing, logger)
 
 	for _, label := range labels {
 		// Check for cancellation before each label creation
@@ -380,25 +754,20 @@ func EnsureDefinedLabelsExist(ctx context.Context, client githubapi.GitHubClient
 			return err
 		}
 
-		if _, ok := existSet[label.Name]; !ok {
-			if dryRun {
-				logger.Info("Would create label: %s (color: %s)", label.Name, label.Color)
-				summary.Success++
-			} else {
-				logger.Debug("Creating missing label '%s' (color: %s)", label.Name, label.Color)
-
-				if err := client.CreateLabel(ctx, label); err != nil {
-					errorMsg := fmt.Sprintf("Label '%s': %v", label.Name, err)
-					summary.Errors = append(summary.Errors, errorMsg)
-					summary.Failures++
-					logger.Debug("Failed to create label '%s': %v", label.Name, err)
-				} else {
-					summary.Success++
-					logger.Debug("Successfully created label '%s' with color '%s'", label.Name, label.Color)
-				}
-			}
-		} else {
+		current, ok := existSet[label.Name]
+		if !ok && options.Normalize {
+			current, ok = foldedSet[strings.ToLower(label.Name)]
+		}
+		switch {
+		case !ok:
+			createMissingLabel(ctx, client, label, summary, options, logger)
+		case options.Defined[label.Name] && labelDiffers(current, label):
+			updateDifferingLabel(ctx, client, current, label, summary, options, logger)
+		default:
 			summary.Success++
+			if options.DryRun {
+				options.DryRunSummary.add("labels", 0, 0, 1)
+			}
 			logger.Debug("Label '%s' already exists", label.Name)
 		}
 	}
@@ -406,62 +775,217 @@ func EnsureDefinedLabelsExist(ctx context.Context, client githubapi.GitHubClient
 	return nil
 }
 
+// createMissingLabel creates label, or reports that it would in a dry run, and counts the outcome in summary
+func createMissingLabel(ctx context.Context, client githubapi.GitHubClient, label types.Label, summary *SectionSummary, options ensureLabelsOptions, logger common.Logger) {
+	if options.DryRun {
+		itemLogger(logger, options.DryRunSummary != nil).Info("Would create label: %s (color: %s)", label.Name, label.Color)
+		options.DryRunSummary.add("labels", 1, 0, 0)
+		summary.Success++
+		return
+	}
+
+	logger.Debug("Creating missing label '%s' (color: %s)", label.Name, label.Color)
+	if err := client.CreateLabel(ctx, label); err != nil {
+		summary.Errors = append(summary.Errors, fmt.Sprintf("Label '%s': %v", label.Name, err))
+		summary.Failures++
+		logger.Debug("Failed to create label '%s': %v", label.Name, err)
+		return
+	}
+	summary.Success++
+	logger.Debug("Successfully created label '%s' with color '%s'", label.Name, label.Color)
+	if options.Manifest != nil {
+		options.Manifest.RecordLabel(label.Name)
+	}
+}
+
+// updateDifferingLabel updates the existing label current to the color and description of its
+// definition, or reports that it would in a dry run, and counts the outcome in summary
+func updateDifferingLabel(ctx context.Context, client githubapi.GitHubClient, current, label types.Label, summary *SectionSummary, options ensureLabelsOptions, logger common.Logger) {
+	logger.Debug("Label '%s' already exists with color '%s' and description '%s', which differ from its definition", current.Name, current.Color, current.Description)
+	if options.DryRun {
+		itemLogger(logger, options.DryRunSummary != nil).Info("Would update label: %s (color: %s)", current.Name, label.Color)
+		options.DryRunSummary.add("labels", 0, 1, 0)
+		summary.Success++
+		summary.Updated++
+		return
+	}
+
+	if err := client.UpdateLabel(ctx, current.Name, label); err != nil {
+		summary.Errors = append(summary.Errors, fmt.Sprintf("Label '%s': %v", current.Name, err))
+		summary.Failures++
+		logger.Debug("Failed to update label '%s': %v", current.Name, err)
+		return
+	}
+	summary.Success++
+	summary.Updated++
+	logger.Debug("Successfully updated label '%s' with color '%s'", current.Name, label.Color)
+}
+
+// warnCaseVariantLabels warns about repository labels whose names differ only by case. GitHub
+// normally rejects such names, but where they exist an item can get either label depending on how
+// its name is written.
+func warnCaseVariantLabels(existing []types.Label, logger common.Logger) {
+	variants := make(map[string][]string)
+	var order []string
+	for _, label := range existing {
+		folded := strings.ToLower(label.Name)
+		if _, found := variants[folded]; !found {
+			order = append(order, folded)
+		}
+		variants[folded] = append(variants[folded], label.Name)
+	}
+
+	for _, folded := range order {
+		if names := variants[folded]; len(names) > 1 {
+			logger.Info("Warning: the repository has labels that differ only by case: %s", strings.Join(names, ", "))
+		}
+	}
+}
+
+// labelNameSet returns the set of the names of labels
+func labelNameSet(labels []types.Label) map[string]bool {
+	names := make(map[string]bool, len(labels))
+	for _, label := range labels {
+		names[label.Name] = true
+	}
+	return names
+}
+
+// labelDiffers reports whether an existing label's color or description differs from its definition.
+// Colors are compared case-insensitively, since GitHub stores them in lower case.
+func labelDiffers(existing, defined types.Label) bool {
+	return !strings.EqualFold(existing.Color, defined.Color) || existing.Description != defined.Description
+}
+
+// PruneUndefinedLabels deletes repository labels that are not in the given set of defined labels,
+// making labels.json the source of truth. Labels matched by the preserve configuration are kept.
+// It returns the number of labels pruned (or that would be pruned in dry-run mode).
+func PruneUndefinedLabels(ctx context.Context, client githubapi.GitHubClient, definedLabels []types.Label, preserveConfig *config.PreserveConfig, logger common.Logger, dryRun bool) (int, error) {
+	existing, err := client.ListLabels(ctx)
+	if err != nil {
+		return 0, errors.APIError("prune_labels", "failed to list labels", err)
+	}
+
+	definedSet := make(map[string]struct{}, len(definedLabels))
+	for _, label := range definedLabels {
+		definedSet[label.Name] = struct{}{}
+	}
+
+	collector := errors.NewErrorCollector("prune_labels")
+	pruned := 0
+
+	for _, labelName := range existing {
+		if err := ctx.Err(); err != nil {
+			return pruned, err
+		}
+
+		if _, ok := definedSet[labelName]; ok {
+			continue
+		}
+
+		if preserveConfig != nil {
+			if reason := LabelPreservationReason(ctx, preserveConfig, labelName); reason != "" {
+				logPreservation(logger, dryRun, "label", labelName, reason)
+				continue
+			}
+		}
+
+		if dryRun {
+			logger.Info("Would prune label: %s (not defined in labels.json)", labelName)
+		} else {
+			logger.Debug("Pruning label not defined in labels.json: %s", labelName)
+			if err := client.DeleteLabel(ctx, labelName); err != nil {
+				if cancelled(ctx, err) {
+					continue
+				}
+				handleLabelDeleteError(err, collector, logger, labelName)
+				continue
+			}
+		}
+		pruned++
+	}
+
+	if dryRun {
+		logger.Info("Label prune (dry-run): %d labels would be deleted", pruned)
+	} else {
+		logger.Info("Label prune: %d labels deleted", pruned)
+	}
+
+	return pruned, collector.Result()
+}
+
 // HydrateFromConfiguration loads issues, discussions, and pull requests from their respective JSON files
-// using a Configuration object. It only loads files for content types that are included.
+// using a Configuration object. It only loads files for content types that are included. Assignee groups
+// referenced as "@name" are expanded to their members from the assignee groups file.
 func HydrateFromConfiguration(ctx context.Context, cfg *config.Configuration, includeIssues, includeDiscussions, includePullRequests bool) ([]types.Issue, []types.Discussion, []types.PullRequest, error) {
-	return HydrateFromFiles(ctx, cfg.IssuesPath, cfg.DiscussionsPath, cfg.PullRequestsPath, includeIssues, includeDiscussions, includePullRequests)
+	issues, discussions, pullRequests, err := HydrateFromFiles(ctx, cfg.IssuesPath, cfg.DiscussionsPath, cfg.PullRequestsPath, includeIssues, includeDiscussions, includePullRequests)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	groups, err := config.LoadAssigneeGroups(ctx, cfg.AssigneeGroupsPath)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if err := expandAssigneeGroups(issues, pullRequests, groups); err != nil {
+		return nil, nil, nil, err
+	}
+
+	return issues, discussions, pullRequests, nil
 }
 
-// CleanupBeforeHydration performs cleanup operations before hydration
+// CleanupBeforeHydration performs cleanup operations before hydration.
+// When ctx is cancelled, cleanup stops at the next item and returns the summary of what was
+// deleted so far together with a context error, which IsContextError tells apart from the
+// partial failure error returned for failed deletions.
 func CleanupBeforeHydration(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, logger common.Logger) (*CleanupSummary, error) {
 	summary := &CleanupSummary{
-		Errors: make([]string, 0),
+		Errors:         make([]string, 0),
+		DeletedItems:   make([]CleanupItem, 0),
+		PreservedItems: make([]CleanupItem, 0),
 	}
 
 	logger.Info("Starting cleanup operations (dry-run: %v)", options.DryRun)
 
 	var allErrors []string
 
-	// Clean issues
-	if options.CleanIssues {
-		issueErrors := cleanupIssues(ctx, client, options, summary, logger)
-		if len(issueErrors) > 0 {
-			allErrors = append(allErrors, issueErrors...)
-		}
-	}
-
-	// Clean discussions
-	if options.CleanDiscussions {
-		discussionErrors := cleanupDiscussions(ctx, client, options, summary, logger)
-		if len(discussionErrors) > 0 {
-			allErrors = append(allErrors, discussionErrors...)
-		}
-	}
-
-	// Clean pull requests
-	if options.CleanPRs {
-		prErrors := cleanupPRs(ctx, client, options, summary, logger)
-		if len(prErrors) > 0 {
-			allErrors = append(allErrors, prErrors...)
+	steps := []struct {
+		enabled bool
+		run     func() []string
+	}{
+		// Clean issues
+		{options.CleanIssues, func() []string { return cleanupIssues(ctx, client, options, summary, logger) }},
+		// Clean discussions
+		{options.CleanDiscussions, func() []string { return cleanupDiscussions(ctx, client, options, summary, logger) }},
+		// Clean pull requests
+		{options.CleanPRs, func() []string { return cleanupPRs(ctx, client, options, summary, logger) }},
+		// Clean labels
+		{options.CleanLabels, func() []string { return cleanupLabels(ctx, client, options, summary, logger) }},
+		// Prune demo branches
+		{options.PruneBranchPrefix != "", func() []string { return cleanupBranches(ctx, client, options, summary, logger) }},
+		// Clear the project board
+		{options.ClearProjectItems, func() []string { return cleanupProjectItems(ctx, client, options, logger) }},
+		// Delete the project
+		{options.DeleteProject, func() []string { return cleanupProject(ctx, client, options, summary, logger) }},
+	}
+	for _, step := range steps {
+		if !step.enabled {
+			continue
 		}
-	}
+		allErrors = append(allErrors, step.run()...)
 
-	// Clean labels
-	if options.CleanLabels {
-		labelErrors := cleanupLabels(ctx, client, options, summary, logger)
-		if len(labelErrors) > 0 {
-			allErrors = append(allErrors, labelErrors...)
+		if err := ctx.Err(); err != nil {
+			summary.Errors = allErrors
+			logger.Info("%s", formatCleanupSummary(summary, options.DryRun))
+			logger.Info("Cleanup cancelled; the summary covers only the items handled before cancellation")
+			return summary, errors.ContextError("cleanup", err)
 		}
 	}
 
 	summary.Errors = allErrors
 
 	// Log summary
-	logger.Info("Cleanup summary: Issues(%d deleted, %d preserved), Discussions(%d deleted, %d preserved), PRs(%d deleted, %d preserved), Labels(%d deleted, %d preserved)",
-		summary.IssuesDeleted, summary.IssuesPreserved,
-		summary.DiscussionsDeleted, summary.DiscussionsPreserved,
-		summary.PRsDeleted, summary.PRsPreserved,
-		summary.LabelsDeleted, summary.LabelsPreserved)
+	logger.Info("%s", formatCleanupSummary(summary, options.DryRun))
 
 	if len(allErrors) > 0 {
 		logger.Info("Cleanup completed with %d errors", len(allErrors))
@@ -473,97 +997,347 @@ func CleanupBeforeHydration(ctx context.Context, client githubapi.GitHubClient,
 	return summary, nil
 }
 
+// cancelled reports whether err was caused by ctx being cancelled. Such errors are not recorded as
+// failed deletions; each cleanup step reports the cancellation once when it stops.
+func cancelled(ctx context.Context, err error) bool {
+	return ctx.Err() != nil && errors.IsContextError(err)
+}
+
+// formatCleanupSummary renders the per-type cleanup tallies as a single line.
+// In dry-run mode the wording makes clear that nothing was actually deleted.
+func formatCleanupSummary(summary *CleanupSummary, dryRun bool) string {
+	deletedLabel, preservedLabel := "deleted", "preserved"
+	prefix := "Cleanup summary"
+	if dryRun {
+		deletedLabel, preservedLabel = "would delete", "would preserve"
+		prefix = "Cleanup summary (dry-run)"
+	}
+	return fmt.Sprintf("%s: Issues(%d %s, %d %s), Discussions(%d %s, %d %s), PRs(%d %s, %d %s), Labels(%d %s, %d %s)",
+		prefix,
+		summary.IssuesDeleted, deletedLabel, summary.IssuesPreserved, preservedLabel,
+		summary.DiscussionsDeleted, deletedLabel, summary.DiscussionsPreserved, preservedLabel,
+		summary.PRsDeleted, deletedLabel, summary.PRsPreserved, preservedLabel,
+		summary.LabelsDeleted, deletedLabel, summary.LabelsPreserved, preservedLabel)
+}
+
+// logPreservation reports a preserved item together with the rule that matched it.
+// Dry-run output is logged at Info so users can verify their preserve rules before a real run.
+func logPreservation(logger common.Logger, dryRun bool, itemType, title, reason string) {
+	if dryRun {
+		logger.Info("Would preserve %s: %s (reason: %s)", itemType, title, reason)
+		return
+	}
+	logger.Debug("Preserving %s: %s (reason: %s)", itemType, title, reason)
+}
+
+// cleanupFuncs describes how cleanupItems lists, evaluates, deletes and counts one type of item
+type cleanupFuncs[T any] struct {
+	ItemType           string                                                  // Plural name of the items, e.g. "Issues"
+	ListPages          func(context.Context, func([]T) error) error            // Lists the items a page at a time
+	PreservationReason func(context.Context, *config.PreserveConfig, T) string // Explains why an item is preserved, or returns ""
+	Delete             func(context.Context, string) error                     // Deletes or closes the item with a node ID
+	Title              func(T) string
+	NodeID             func(T) string
+	CountPreserved     func(*CleanupSummary)
+	CountDeleted       func(*CleanupSummary)
+}
+
+// issueCleanup returns the cleanupFuncs for issues listed by listPages and removed with deleteFunc
+func issueCleanup(listPages func(context.Context, func([]types.Issue) error) error, deleteFunc func(context.Context, string) error) cleanupFuncs[types.Issue] {
+	return cleanupFuncs[types.Issue]{
+		ItemType:           "Issues",
+		ListPages:          listPages,
+		PreservationReason: IssuePreservationReason,
+		Delete:             deleteFunc,
+		Title:              func(issue types.Issue) string { return issue.Title },
+		NodeID:             func(issue types.Issue) string { return issue.NodeID },
+		CountPreserved:     func(s *CleanupSummary) { s.IssuesPreserved++ },
+		CountDeleted:       func(s *CleanupSummary) { s.IssuesDeleted++ },
+	}
+}
+
+// discussionCleanup returns the cleanupFuncs for discussions listed by listPages and removed with deleteFunc
+func discussionCleanup(listPages func(context.Context, func([]types.Discussion) error) error, deleteFunc func(context.Context, string) error) cleanupFuncs[types.Discussion] {
+	return cleanupFuncs[types.Discussion]{
+		ItemType:           "Discussions",
+		ListPages:          listPages,
+		PreservationReason: DiscussionPreservationReason,
+		Delete:             deleteFunc,
+		Title:              func(discussion types.Discussion) string { return discussion.Title },
+		NodeID:             func(discussion types.Discussion) string { return discussion.NodeID },
+		CountPreserved:     func(s *CleanupSummary) { s.DiscussionsPreserved++ },
+		CountDeleted:       func(s *CleanupSummary) { s.DiscussionsDeleted++ },
+	}
+}
+
+// pullRequestCleanup returns the cleanupFuncs for pull requests listed by listPages and removed with deleteFunc
+func pullRequestCleanup(listPages func(context.Context, func([]types.PullRequest) error) error, deleteFunc func(context.Context, string) error) cleanupFuncs[types.PullRequest] {
+	return cleanupFuncs[types.PullRequest]{
+		ItemType:           "Pull Requests",
+		ListPages:          listPages,
+		PreservationReason: PRPreservationReason,
+		Delete:             deleteFunc,
+		Title:              func(pr types.PullRequest) string { return pr.Title },
+		NodeID:             func(pr types.PullRequest) string { return pr.NodeID },
+		CountPreserved:     func(s *CleanupSummary) { s.PRsPreserved++ },
+		CountDeleted:       func(s *CleanupSummary) { s.PRsDeleted++ },
+	}
+}
+
+// itemCleanup holds the state of one cleanupItems run. Deletions run on up to concurrency workers
+// while further pages are listed; mu guards the summary, the collector and the logger, which are
+// shared between them.
+type itemCleanup[T any] struct {
+	funcs         cleanupFuncs[T]
+	options       CleanupOptions
+	summary       *CleanupSummary
+	logger        common.Logger
+	collector     *errors.ErrorCollector
+	operationName string
+	singular      string
+	kind          string
+	action        string
+	concurrency   int
+
+	mu         sync.Mutex
+	wg         sync.WaitGroup
+	slots      chan struct{}
+	dispatched int
+	evaluated  int
+}
+
 // cleanupItems is a generic function for cleaning up GitHub objects.
 // It eliminates code duplication between the specific cleanup functions.
-func cleanupItems[T any](
-	ctx context.Context,
-	client githubapi.GitHubClient,
-	options CleanupOptions,
-	summary *CleanupSummary,
-	logger common.Logger,
-	itemType string,
-	listFunc func(context.Context) ([]T, error),
-	preserveFunc func(context.Context, *config.PreserveConfig, T) bool,
-	deleteFunc func(context.Context, string) error,
-	getTitleFunc func(T) string,
-	getNodeIDFunc func(T) string,
-	updatePreservedCount func(*CleanupSummary),
-	updateDeletedCount func(*CleanupSummary),
-) []string {
-	operationName := common.FormatOperationContext("cleanup", itemType)
-	collector := errors.NewErrorCollector(operationName)
-
-	items, err := listFunc(ctx)
-	if err != nil {
-		return handleListError(err, common.FormatOperationContext("list", itemType), strings.ToLower(itemType))
+// Items are evaluated and deleted a page at a time as funcs.ListPages fetches them, so deletion
+// starts with the first page and the full list is never held in memory.
+func cleanupItems[T any](ctx context.Context, funcs cleanupFuncs[T], options CleanupOptions, summary *CleanupSummary, logger common.Logger) []string {
+	singular := strings.ToLower(funcs.ItemType[:len(funcs.ItemType)-1])
+	action := "delete"
+	if options.CloseDiscussions && funcs.ItemType == "Discussions" {
+		action = "close"
+	}
+	concurrency := options.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	operationName := common.FormatOperationContext("cleanup", funcs.ItemType)
+	run := &itemCleanup[T]{
+		funcs:         funcs,
+		options:       options,
+		summary:       summary,
+		logger:        logger,
+		collector:     errors.NewErrorCollector(operationName),
+		operationName: operationName,
+		singular:      singular,
+		kind:          strings.ReplaceAll(singular, " ", "_"),
+		action:        action,
+		concurrency:   concurrency,
+		slots:         make(chan struct{}, concurrency),
+	}
+
+	listErr := funcs.ListPages(ctx, func(items []T) error { return run.cleanupPage(ctx, items) })
+	run.wg.Wait()
+
+	if listErr != nil {
+		if errors.IsContextError(listErr) {
+			run.collector.Add(listErr)
+		} else {
+			// Deletions already made from earlier pages are kept in the summary
+			wrappedErr := errors.WrapWithOperation(listErr, "cleanup", common.FormatOperationContext("list", funcs.ItemType), fmt.Sprintf("failed to list %s", strings.ToLower(funcs.ItemType)))
+			run.collector.Add(wrappedErr)
+		}
 	}
+	logger.Debug("Evaluated %d %s for cleanup", run.evaluated, strings.ToLower(funcs.ItemType))
+
+	return convertErrorsToStringSlice(run.collector)
+}
 
-	logger.Debug("Found %d %s to evaluate for cleanup", len(items), strings.ToLower(itemType))
+// cleanupPage evaluates one page of items, handing each item that is not kept to a deletion worker
+func (run *itemCleanup[T]) cleanupPage(ctx context.Context, items []T) error {
+	run.mu.Lock()
+	run.evaluated += len(items)
+	run.logger.Debug("Fetched %d %s to evaluate for cleanup", len(items), strings.ToLower(run.funcs.ItemType))
+	run.mu.Unlock()
 
 	for _, item := range items {
-		title := getTitleFunc(item)
-		if options.PreserveConfig != nil && preserveFunc(ctx, options.PreserveConfig, item) {
-			updatePreservedCount(summary)
-			logger.Debug("Preserving %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
+		title := run.funcs.Title(item)
+		if run.keep(ctx, item, title) {
 			continue
 		}
 
-		if options.DryRun {
-			logger.Info("Would delete %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
-		} else {
-			logger.Debug("Deleting %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
-			nodeID := getNodeIDFunc(item)
-			if err := deleteFunc(ctx, nodeID); err != nil {
-				handleDeleteError(err, collector, logger, strings.ToLower(itemType[:len(itemType)-1]), title, nodeID)
-				continue
-			}
+		if run.options.DryRun {
+			run.mu.Lock()
+			itemLogger(run.logger, run.options.SummaryOnly).Info("Would %s %s: %s", run.action, run.singular, title)
+			run.recordDeleted(title, run.funcs.NodeID(item))
+			run.mu.Unlock()
+			continue
+		}
+
+		if err := run.dispatch(ctx, item, title); err != nil {
+			return err
 		}
-		updateDeletedCount(summary)
 	}
+	return nil
+}
 
-	return convertErrorsToStringSlice(collector)
+// keep reports whether item is left in place, either because its title lacks the title prefix or
+// because a preserve rule matches it. Preserved items are counted and logged with their reason.
+func (run *itemCleanup[T]) keep(ctx context.Context, item T, title string) bool {
+	if run.options.TitlePrefix != "" && !strings.HasPrefix(title, run.options.TitlePrefix) {
+		run.mu.Lock()
+		run.logger.Debug("Skipping %s without title prefix '%s': %s", run.singular, run.options.TitlePrefix, title)
+		run.mu.Unlock()
+		return true
+	}
+	if run.options.PreserveConfig == nil {
+		return false
+	}
+	reason := run.funcs.PreservationReason(ctx, run.options.PreserveConfig, item)
+	if reason == "" {
+		return false
+	}
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	run.funcs.CountPreserved(run.summary)
+	run.summary.PreservedItems = append(run.summary.PreservedItems, CleanupItem{Type: run.kind, Title: title, NodeID: run.funcs.NodeID(item)})
+	logPreservation(itemLogger(run.logger, run.options.SummaryOnly), run.options.DryRun, run.singular, title, reason)
+	return true
+}
+
+// dispatch waits for a free worker and starts deleting item on it. Each worker after the first
+// concurrency waits for the delay before deleting, so it applies between the deletions of each
+// worker. It returns a context error, without deleting, once ctx is cancelled.
+func (run *itemCleanup[T]) dispatch(ctx context.Context, item T, title string) error {
+	if err := ctx.Err(); err != nil {
+		return errors.ContextError(run.operationName, err)
+	}
+
+	delay := run.options.Delay
+	if run.dispatched < run.concurrency {
+		delay = 0
+	}
+	run.dispatched++
+
+	run.slots <- struct{}{}
+	// Waiting for a free worker can outlast a cancellation, so check again before deleting
+	if err := ctx.Err(); err != nil {
+		<-run.slots
+		return errors.ContextError(run.operationName, err)
+	}
+	run.wg.Add(1)
+	go run.deleteItem(ctx, item, title, delay)
+	return nil
+}
+
+// deleteItem waits for delay and deletes item, recording the deletion or its error. Failures caused
+// by cancellation are not recorded; cleanupItems reports the cancellation once.
+func (run *itemCleanup[T]) deleteItem(ctx context.Context, item T, title string, delay time.Duration) {
+	defer run.wg.Done()
+	defer func() { <-run.slots }()
+
+	nodeID := run.funcs.NodeID(item)
+	if err := pause(ctx, delay); err != nil {
+		if !cancelled(ctx, err) {
+			run.mu.Lock()
+			run.collector.Add(err)
+			run.mu.Unlock()
+		}
+		return
+	}
+	run.mu.Lock()
+	run.logger.Debug("Deleting %s: %s", run.singular, title)
+	run.mu.Unlock()
+
+	err := run.funcs.Delete(ctx, nodeID)
+
+	run.mu.Lock()
+	defer run.mu.Unlock()
+	if cancelled(ctx, err) {
+		return
+	}
+	if err != nil {
+		handleDeleteError(err, run.collector, run.logger, run.singular, title, nodeID)
+		return
+	}
+	run.recordDeleted(title, nodeID)
+}
+
+// recordDeleted counts a deleted item in the summary; the caller holds mu
+func (run *itemCleanup[T]) recordDeleted(title, nodeID string) {
+	run.funcs.CountDeleted(run.summary)
+	run.summary.DeletedItems = append(run.summary.DeletedItems, CleanupItem{Type: run.kind, Title: title, NodeID: nodeID})
+}
+
+// commentBeforeClosing returns closeFunc preceded by adding options.CloseComment to the item, or
+// closeFunc unchanged when no comment is configured. When the comment cannot be added, the item is
+// left open and the error is reported like a failed close.
+func commentBeforeClosing(client githubapi.GitHubClient, options CleanupOptions, singular string, closeFunc func(context.Context, string) error, logger common.Logger) func(context.Context, string) error {
+	if options.CloseComment == "" {
+		return closeFunc
+	}
+	if options.DryRun {
+		logger.Info("Would comment \"%s\" on each %s before closing it", options.CloseComment, singular)
+	} else {
+		logger.Info("Commenting \"%s\" on each %s before closing it", options.CloseComment, singular)
+	}
+	return func(ctx context.Context, nodeID string) error {
+		if err := client.AddComment(ctx, nodeID, options.CloseComment); err != nil {
+			return err
+		}
+		return closeFunc(ctx, nodeID)
+	}
 }
 
 // cleanupIssues handles cleanup of issues
 func cleanupIssues(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, summary *CleanupSummary, logger common.Logger) []string {
-	return cleanupItems(
-		ctx, client, options, summary, logger, "Issues",
-		client.ListIssues,
-		ShouldPreserveIssue,
-		client.DeleteIssue,
-		func(issue types.Issue) string { return issue.Title },
-		func(issue types.Issue) string { return issue.NodeID },
-		func(s *CleanupSummary) { s.IssuesPreserved++ },
-		func(s *CleanupSummary) { s.IssuesDeleted++ },
+	funcs := issueCleanup(
+		markedPages(client.ListIssuePages, options.MarkerLabel, func(issue types.Issue) []string { return issue.Labels }, logger),
+		commentBeforeClosing(client, options, "issue", client.DeleteIssue, logger),
 	)
+	return cleanupItems(ctx, funcs, options, summary, logger)
 }
 
+// discussionCloseReason is the reason given when cleanup closes discussions instead of deleting them
+const discussionCloseReason = "OUTDATED"
+
 // cleanupDiscussions handles cleanup of discussions
 func cleanupDiscussions(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, summary *CleanupSummary, logger common.Logger) []string {
-	return cleanupItems(
-		ctx, client, options, summary, logger, "Discussions",
-		client.ListDiscussions,
-		ShouldPreserveDiscussion,
-		client.DeleteDiscussion,
-		func(discussion types.Discussion) string { return discussion.Title },
-		func(discussion types.Discussion) string { return discussion.NodeID },
-		func(s *CleanupSummary) { s.DiscussionsPreserved++ },
-		func(s *CleanupSummary) { s.DiscussionsDeleted++ },
+	listPages := client.ListDiscussionPages
+	deleteFunc := client.DeleteDiscussion
+	if options.CloseDiscussions {
+		logger.Info("Closing discussions as %s instead of deleting them", discussionCloseReason)
+		// Discussions closed by an earlier cleanup are left alone so repeated runs do not close them again
+		listPages = func(ctx context.Context, fn func([]types.Discussion) error) error {
+			return client.ListDiscussionPages(ctx, func(discussions []types.Discussion) error {
+				open := make([]types.Discussion, 0, len(discussions))
+				for _, discussion := range discussions {
+					if discussion.State != "CLOSED" {
+						open = append(open, discussion)
+					}
+				}
+				return fn(open)
+			})
+		}
+		deleteFunc = func(ctx context.Context, nodeID string) error {
+			return client.CloseDiscussion(ctx, nodeID, discussionCloseReason)
+		}
+	}
+
+	funcs := discussionCleanup(
+		markedPages(listPages, options.MarkerLabel, func(discussion types.Discussion) []string { return discussion.Labels }, logger),
+		deleteFunc,
 	)
+	return cleanupItems(ctx, funcs, options, summary, logger)
 }
 
 // cleanupPRs handles cleanup of pull requests
 func cleanupPRs(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, summary *CleanupSummary, logger common.Logger) []string {
-	return cleanupItems(
-		ctx, client, options, summary, logger, "Pull Requests",
-		client.ListPRs,
-		ShouldPreservePR,
-		client.DeletePR,
-		func(pr types.PullRequest) string { return pr.Title },
-		func(pr types.PullRequest) string { return pr.NodeID },
-		func(s *CleanupSummary) { s.PRsPreserved++ },
-		func(s *CleanupSummary) { s.PRsDeleted++ },
+	funcs := pullRequestCleanup(
+		markedPages(client.ListPRPages, options.MarkerLabel, func(pr types.PullRequest) []string { return pr.Labels }, logger),
+		commentBeforeClosing(client, options, "pull request", client.DeletePR, logger),
 	)
+	return cleanupItems(ctx, funcs, options, summary, logger)
 }
 
 // cleanupLabels handles cleanup of labels
@@ -578,27 +1352,137 @@ func cleanupLabels(ctx context.Context, client githubapi.GitHubClient, options C
 	logger.Debug("Found %d labels to evaluate for cleanup", len(labelNames))
 
 	for _, labelName := range labelNames {
-		if options.PreserveConfig != nil && ShouldPreserveLabel(ctx, options.PreserveConfig, labelName) {
-			summary.LabelsPreserved++
-			logger.Debug("Preserving label: %s", labelName)
+		if err := ctx.Err(); err != nil {
+			collector.Add(errors.ContextError("cleanup_labels", err))
+			break
+		}
+		if options.LabelManifest != nil && !options.LabelManifest.HasLabel(labelName) {
+			logger.Debug("Keeping label '%s': not created by the run recorded in the manifest", labelName)
 			continue
 		}
 
+		if options.PreserveConfig != nil {
+			if reason := LabelPreservationReason(ctx, options.PreserveConfig, labelName); reason != "" {
+				summary.LabelsPreserved++
+				summary.PreservedItems = append(summary.PreservedItems, CleanupItem{Type: "label", Title: labelName})
+				logPreservation(itemLogger(logger, options.SummaryOnly), options.DryRun, "label", labelName, reason)
+				continue
+			}
+		}
+
 		if options.DryRun {
-			logger.Info("Would delete label: %s", labelName)
+			itemLogger(logger, options.SummaryOnly).Info("Would delete label: %s", labelName)
 		} else {
 			logger.Debug("Deleting label: %s", labelName)
 			if err := client.DeleteLabel(ctx, labelName); err != nil {
+				if cancelled(ctx, err) {
+					continue
+				}
 				handleLabelDeleteError(err, collector, logger, labelName)
 				continue
 			}
 		}
 		summary.LabelsDeleted++
+		summary.DeletedItems = append(summary.DeletedItems, CleanupItem{Type: "label", Title: labelName})
+	}
+
+	return convertErrorsToStringSlice(collector)
+}
+
+// cleanupBranches deletes the branches whose name starts with options.PruneBranchPrefix. The default
+// branch and protected branches are never deleted. Nothing is deleted when the default branch cannot
+// be determined, since it could then match the prefix.
+func cleanupBranches(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, summary *CleanupSummary, logger common.Logger) []string {
+	collector := errors.NewErrorCollector("cleanup_branches")
+
+	defaultBranch, err := client.GetDefaultBranch(ctx)
+	if err != nil {
+		wrappedErr := errors.WrapWithOperation(err, "cleanup", "get_default_branch", "failed to determine the default branch")
+		return []string{wrappedErr.Error()}
+	}
+
+	branches, err := client.ListBranches(ctx, options.PruneBranchPrefix)
+	if err != nil {
+		return handleListError(err, "list_branches", "branches")
+	}
+
+	logger.Debug("Found %d branches starting with '%s'", len(branches), options.PruneBranchPrefix)
+
+	for _, branch := range branches {
+		if err := ctx.Err(); err != nil {
+			collector.Add(errors.ContextError("cleanup_branches", err))
+			break
+		}
+
+		if branch.Name == defaultBranch {
+			summary.BranchesSkipped++
+			logger.Info("Skipping branch %s: it is the default branch", branch.Name)
+			continue
+		}
+		if branch.Protected {
+			summary.BranchesSkipped++
+			logger.Info("Skipping branch %s: it is protected", branch.Name)
+			continue
+		}
+
+		if options.DryRun {
+			itemLogger(logger, options.SummaryOnly).Info("Would delete branch: %s", branch.Name)
+		} else {
+			logger.Debug("Deleting branch: %s", branch.Name)
+			if err := client.DeleteBranch(ctx, branch.NodeID); err != nil {
+				if cancelled(ctx, err) {
+					continue
+				}
+				handleDeleteError(err, collector, logger, "branch", branch.Name, branch.NodeID)
+				continue
+			}
+		}
+		summary.BranchesDeleted++
+		summary.DeletedItems = append(summary.DeletedItems, CleanupItem{Type: "branch", Title: branch.Name, NodeID: branch.NodeID})
+	}
+
+	if options.DryRun {
+		logger.Info("Would prune %d branches starting with '%s' (%d skipped)", summary.BranchesDeleted, options.PruneBranchPrefix, summary.BranchesSkipped)
+	} else {
+		logger.Info("Pruned %d branches starting with '%s' (%d skipped)", summary.BranchesDeleted, options.PruneBranchPrefix, summary.BranchesSkipped)
 	}
 
 	return convertErrorsToStringSlice(collector)
 }
 
+// cleanupProjectItems removes every item from the configured project without deleting the project,
+// its fields, or the issues and pull requests the items refer to
+func cleanupProjectItems(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, logger common.Logger) []string {
+	project, err := client.FindProjectV2ByTitle(ctx, options.ProjectTitle)
+	if err != nil {
+		return handleListError(err, "find_project", "projects")
+	}
+	if project == nil {
+		logger.Info("No open project titled '%s' found; skipping project item cleanup", options.ProjectTitle)
+		return nil
+	}
+
+	if options.DryRun {
+		items, err := client.ListProjectV2Items(ctx, project.ID)
+		if err != nil {
+			return handleListError(err, "list_project_items", "project items")
+		}
+		for _, item := range items {
+			itemLogger(logger, options.SummaryOnly).Info("Would remove project item: %s", item.Title)
+		}
+		logger.Info("Would remove %d items from project '%s'", len(items), project.Title)
+		return nil
+	}
+
+	logger.Debug("Removing all items from project '%s'", project.Title)
+	if err := client.ClearProjectV2Items(ctx, project.ID); err != nil {
+		wrappedErr := errors.WrapWithOperation(err, "cleanup", "clear_project_items", "failed to clear project items")
+		return []string{errors.WithContextSafe(wrappedErr, "project", project.Title).Error()}
+	}
+	logger.Info("Removed all items from project '%s'", project.Title)
+	return nil
+}
+
 // HydrateFromFiles loads issues, discussions, and pull requests from their respective JSON files.
 // It only loads files for content types that are included (enabled by the respective boolean flags).
 func HydrateFromFiles(ctx context.Context, issuesPath, discussionsPath, pullRequestsPath string, includeIssues, includeDiscussions, includePullRequests bool) ([]types.Issue, []types.Discussion, []types.PullRequest, error) {
@@ -621,6 +1505,9 @@ func HydrateFromFiles(ctx context.Context, issuesPath, discussionsPath, pullRequ
 			err = errors.WrapWithOperation(err, "file", "parse_issues", "failed to parse issues file")
 			return nil, nil, nil, errors.WithContextSafe(err, "path", issuesPath)
 		}
+		for i := range issues {
+			issues[i].SourceFile, issues[i].SourceIndex = issuesPath, i
+		}
 	}
 
 	if includeDiscussions {
@@ -638,6 +1525,9 @@ func HydrateFromFiles(ctx context.Context, issuesPath, discussionsPath, pullRequ
 			err = errors.WrapWithOperation(err, "file", "parse_discussions", "failed to parse discussions file")
 			return nil, nil, nil, errors.WithContextSafe(err, "path", discussionsPath)
 		}
+		for i := range discussions {
+			discussions[i].SourceFile, discussions[i].SourceIndex = discussionsPath, i
+		}
 	}
 
 	if includePullRequests {
@@ -654,13 +1544,16 @@ func HydrateFromFiles(ctx context.Context, issuesPath, discussionsPath, pullRequ
 			err = errors.WrapWithOperation(err, "file", "parse_pull_requests", "failed to parse pull requests file")
 			return nil, nil, nil, errors.WithContextSafe(err, "path", pullRequestsPath)
 		}
+		for i := range pullRequests {
+			pullRequests[i].SourceFile, pullRequests[i].SourceIndex = pullRequestsPath, i
+		}
 	}
 
 	return issues, discussions, pullRequests, nil
 }
 
-// CollectLabels returns a deduplicated list of all labels used in issues, discussions, and PRs.
-// CollectLabels returns a deduplicated list of all labels used in issues, discussions, and pull requests.
+// CollectLabels returns a deduplicated, sorted list of all labels used in issues, discussions, and pull requests.
+// Sorting keeps label creation order stable between runs.
 func CollectLabels(ctx context.Context, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) []string {
 	labelSet := make(map[string]struct{})
 	for _, issue := range issues {
@@ -682,6 +1575,7 @@ func CollectLabels(ctx context.Context, issues []types.Issue, discussions []type
 	for label := range labelSet {
 		labels = append(labels, label)
 	}
+	sort.Strings(labels)
 	return labels
 }
 
@@ -745,8 +1639,9 @@ func FindProjectRoot(ctx context.Context) (string, error) {
 	}
 }
 
-// createProjectV2 creates a new ProjectV2 based on configuration and returns it for item association.
-func createProjectV2(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration, projectConfigPath string, logger common.Logger) (*types.ProjectV2, error) {
+// createProjectV2 loads the project configuration and ensures the project exists, returning it for item
+// association and whether it was created rather than reused.
+func createProjectV2(ctx context.Context, client githubapi.GitHubClient, cfg *config.Configuration, projectConfigPath string, logger common.Logger) (*types.ProjectV2, bool, error) {
 	// Determine the project configuration path
 	configPath := projectConfigPath
 	if configPath == "" {
@@ -758,96 +1653,41 @@ func createProjectV2(ctx context.Context, client githubapi.GitHubClient, cfg *co
 	// Load project configuration
 	projectConfig, err := config.LoadProjectConfiguration(ctx, configPath)
 	if err != nil {
-		return nil, errors.ProjectConfigurationError("load_project_config", "failed to load project configuration", err)
-	}
-
-	logger.Info("Creating ProjectV2 '%s'", projectConfig.Title)
-
-	// Create the basic project
-	project, err := client.CreateProjectV2(ctx, *projectConfig)
-	if err != nil {
-		// Check for permission errors and provide helpful guidance
-		if errors.IsLayer(err, "project") {
-			if layeredErr := errors.AsLayeredError(err); layeredErr != nil {
-				if layeredErr.Context["type"] == "permission" {
-					logger.Info("Failed to create project due to insufficient permissions")
-					logger.Info("Ensure your GitHub token has 'write:org' or 'write:user' scope")
-					return nil, err
-				}
-			}
-		}
-		return nil, errors.ProjectError("create_project", "failed to create ProjectV2", err)
-	}
-
-	logger.Info("Successfully created ProjectV2 '%s' (Number: %d, URL: %s)",
-		project.Title, project.Number, project.URL)
-
-	// Configure project with additional settings
-	err = configureProjectV2Additional(ctx, client, project.ID, *projectConfig, logger)
-	if err != nil {
-		logger.Info("Warning: Failed to configure some project settings: %v", err)
-		// Don't fail the entire operation - the basic project was created successfully
+		return nil, false, errors.ProjectConfigurationError("load_project_config", "failed to load project configuration", err)
 	}
 
-	return project, nil
-}
-
-// configureProjectV2Additional configures additional project settings like description, fields, and views.
-func configureProjectV2Additional(ctx context.Context, client githubapi.GitHubClient, projectID string, projectConfig types.ProjectV2Configuration, logger common.Logger) error {
-	errorCollector := errors.NewErrorCollector("configure_project_additional")
-
-	// TODO: Update project description - currently disabled due to API schema issues
-	if strings.TrimSpace(projectConfig.Description) != "" {
-		logger.Debug("Project description configuration is currently disabled (GitHub API limitations)")
-		// Temporarily disabled: err := client.UpdateProjectV2Description(ctx, projectID, projectConfig.Description)
-	}
-
-	// Create custom fields - now working with updated GitHub API schema
-	if len(projectConfig.Fields) > 0 {
-		logger.Info("Creating %d custom fields for project", len(projectConfig.Fields))
-		err := client.ConfigureProjectV2Fields(ctx, projectID, projectConfig.Fields)
-		if err != nil {
-			logger.Info("Warning: Failed to create some custom fields: %v", err)
-			errorCollector.Add(errors.ProjectError("configure_project_fields", "failed to configure custom fields", err))
-		} else {
-			logger.Info("Successfully configured all custom fields")
-		}
-	}
-
-	// Note: Views are not currently supported by GitHub's GraphQL API
-	// They can only be created through the web interface
-	if len(projectConfig.Views) > 0 {
-		logger.Info("Note: Custom project views cannot be created via API - please configure them manually in the GitHub web interface")
-	}
-
-	return errorCollector.Result()
+	return ensureProject(ctx, client, *projectConfig, logger)
 }
 
 // createRepositoryContentWithProject orchestrates the creation of all content types with optional project association.
 // This function handles the creation of issues, discussions, and pull requests, and if a project is provided,
-// associates all created items with the project.
-func createRepositoryContentWithProject(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, includeIssues, includeDiscussions, includePullRequests bool, logger common.Logger, dryRun bool, project *types.ProjectV2) error {
+// associates all created items with the project. It returns the items it created.
+func createRepositoryContentWithProject(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, options HydrateOptions, logger common.Logger, project *types.ProjectV2) ([]CreatedItem, error) {
+	dryRun := options.DryRun
+
 	// Track created items for project association
 	var createdItems []CreatedItem
 
 	// Create issues
-	if includeIssues && len(issues) > 0 {
-		itemsCreated, err := createItemsWithTracking(ctx, client, issues, "Issues", func(ctx context.Context, item types.Issue) (*types.CreatedItemInfo, error) {
-			return client.CreateIssue(ctx, item)
-		}, logger, dryRun)
+	if options.IncludeIssues && len(issues) > 0 {
+		itemsCreated, err := createItemsWithTracking(ctx, client, issues, "Issues", issueCreator(client, logger, options), logger, options)
 		if err != nil {
 			// Log the error but don't fail the entire operation
 			logger.Info("Some issues failed to create: %v", err)
 		}
 		// Always append created items, even if some failed
 		createdItems = append(createdItems, itemsCreated...)
+		if err := linkDuplicateIssues(ctx, client, issues, itemsCreated, options, logger); err != nil {
+			return createdItems, err
+		}
+		if err := linkEpicChildren(ctx, client, issues, itemsCreated, options, logger); err != nil {
+			return createdItems, err
+		}
 	}
 
 	// Create discussions
-	if includeDiscussions && len(discussions) > 0 {
-		itemsCreated, err := createItemsWithTracking(ctx, client, discussions, "Discussions", func(ctx context.Context, item types.Discussion) (*types.CreatedItemInfo, error) {
-			return client.CreateDiscussion(ctx, item)
-		}, logger, dryRun)
+	if options.IncludeDiscussions && len(discussions) > 0 {
+		itemsCreated, err := createItemsWithTracking(ctx, client, discussions, "Discussions", discussionCreator(client, logger, options), logger, options)
 		if err != nil {
 			// Log the error but don't fail the entire operation
 			logger.Info("Some discussions failed to create: %v", err)
@@ -857,10 +1697,8 @@ func createRepositoryContentWithProject(ctx context.Context, client githubapi.Gi
 	}
 
 	// Create pull requests
-	if includePullRequests && len(pullRequests) > 0 {
-		itemsCreated, err := createItemsWithTracking(ctx, client, pullRequests, "Pull Requests", func(ctx context.Context, item types.PullRequest) (*types.CreatedItemInfo, error) {
-			return client.CreatePR(ctx, item)
-		}, logger, dryRun)
+	if options.IncludePullRequests && len(pullRequests) > 0 {
+		itemsCreated, err := createItemsWithTracking(ctx, client, pullRequests, "Pull Requests", pullRequestCreator(client, logger, options), logger, options)
 		if err != nil {
 			// Log the error but don't fail the entire operation
 			// We want to add successfully created items to the project even if some PRs failed
@@ -878,11 +1716,15 @@ func createRepositoryContentWithProject(ctx context.Context, client githubapi.Gi
 			// Log error but don't fail the entire operation
 			logger.Info("Failed to add some items to project: %v", err)
 		}
+		options.Report.RecordProjectAssociation(createdItems)
+		if notAdded := notAddedToProject(createdItems); len(notAdded) > 0 {
+			logger.Info("Warning: %d items were created but not added to project '%s': %s", len(notAdded), project.Title, strings.Join(notAdded, ", "))
+		}
 	} else if project != nil && dryRun {
 		logger.Info("Would add %d items to ProjectV2 '%s' (skipped in dry-run mode)", len(createdItems), project.Title)
 	}
 
-	return nil
+	return createdItems, nil
 }
 
 // CreatedItem represents an item that was successfully created and can be added to a project.
@@ -890,6 +1732,86 @@ type CreatedItem struct {
 	NodeID string // The GitHub node ID of the created item
 	Title  string // The title of the created item for logging
 	Type   string // The type of item (issue, discussion, pull_request)
+	Number int    // The GitHub number of the created item (0 when unknown)
+	URL    string // The URL of the created item
+
+	AddedToProject bool // The item was added to the project created for the run
+}
+
+// createdByTitle maps the titles of created items with a known number to the items. The first item
+// with a title is kept, so a later item with the same title never replaces it.
+func createdByTitle(created []CreatedItem) map[string]CreatedItem {
+	byTitle := make(map[string]CreatedItem, len(created))
+	for _, item := range created {
+		if _, exists := byTitle[item.Title]; !exists && item.Number > 0 {
+			byTitle[item.Title] = item
+		}
+	}
+	return byTitle
+}
+
+// newCreatedItem converts the information returned by a create call into a CreatedItem.
+// The requested title is used when the API response does not include one.
+func newCreatedItem(info *types.CreatedItemInfo, title string) CreatedItem {
+	if info == nil {
+		return CreatedItem{Title: title}
+	}
+	created := CreatedItem{
+		NodeID: info.NodeID,
+		Title:  info.Title,
+		Type:   info.Type,
+		Number: info.Number,
+		URL:    info.URL,
+	}
+	if created.Title == "" {
+		created.Title = title
+	}
+	return created
+}
+
+// describeCreatedItem formats a created item for logs, e.g. "issue #12: Fix login".
+func describeCreatedItem(itemType string, item CreatedItem) string {
+	if item.Number > 0 {
+		return fmt.Sprintf("%s #%d: %s", itemType, item.Number, item.Title)
+	}
+	return fmt.Sprintf("%s: %s", itemType, item.Title)
+}
+
+// createdVerb returns "Updated" for an item that already existed and was updated, otherwise "Created"
+func createdVerb(info *types.CreatedItemInfo) string {
+	if info != nil && info.Updated {
+		return "Updated"
+	}
+	return "Created"
+}
+
+// formatSectionSummary formats the per-section totals, listing the numbers of created items
+// so they can be cross-referenced later (for example when cleaning up). With color enabled, the
+// section name is bold and the successful and failed counts are green and red when not zero.
+func formatSectionSummary(summary *SectionSummary, color common.Colorizer) string {
+	successful := fmt.Sprintf("%d successful", summary.Success)
+	if summary.Success > 0 {
+		successful = color.Green(successful)
+	}
+	failed := fmt.Sprintf("%d failed", summary.Failures)
+	if summary.Failures > 0 {
+		failed = color.Red(failed)
+	}
+	line := fmt.Sprintf("%s: %d total, %s, %s", color.Bold(summary.Name), summary.Total, successful, failed)
+	if summary.Updated > 0 {
+		line += fmt.Sprintf(", %d updated", summary.Updated)
+	}
+
+	var numbers []string
+	for _, item := range summary.Created {
+		if item.Number > 0 {
+			numbers = append(numbers, fmt.Sprintf("#%d", item.Number))
+		}
+	}
+	if len(numbers) > 0 {
+		line += fmt.Sprintf(" (created %s)", strings.Join(numbers, ", "))
+	}
+	return line
 }
 
 // createItemsWithTracking is a generic function for creating GitHub objects with tracking support.
@@ -901,16 +1823,19 @@ func createItemsWithTracking[T any](
 	itemType string,
 	createFunc func(context.Context, T) (*types.CreatedItemInfo, error),
 	logger common.Logger,
-	dryRun bool,
+	options HydrateOptions,
 ) ([]CreatedItem, error) {
 	if len(items) == 0 {
 		return nil, nil
 	}
 
 	logger.Info("Creating %d %s", len(items), strings.ToLower(itemType))
+	itemLog := itemLogger(logger, options.DryRunSummary != nil)
 
 	var createdItems []CreatedItem
-	errorCollector := errors.NewErrorCollector(fmt.Sprintf("create_%s", strings.ToLower(itemType)))
+	summary := &SectionSummary{Name: itemType, Total: len(items)}
+	defer func() { options.Report.AddSection(*summary) }()
+	var failures []errors.Failure
 
 	for i, item := range items {
 		// Extract title for tracking
@@ -926,36 +1851,49 @@ func createItemsWithTracking[T any](
 			title = v.Title
 		}
 
-		if dryRun {
-			logger.Info("Would create %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
+		if options.DryRun {
+			itemLog.Info("Would create %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
+			logBodyPreview(item, options.BodyPreviewLength, itemLog)
 			// In dry run mode, simulate successful creation for tracking
 			createdItems = append(createdItems, CreatedItem{
 				NodeID: fmt.Sprintf("dry-run-%s-%d", strings.ToLower(itemType), i),
 				Title:  title,
 				Type:   strings.ToLower(itemType[:len(itemType)-1]), // Remove 's' from plural
 			})
+			summary.Success++
 			continue
 		}
 
 		createdItemInfo, err := createFunc(ctx, item)
 		if err != nil {
-			wrappedErr := errors.APIError(fmt.Sprintf("create_%s", strings.ToLower(itemType[:len(itemType)-1])),
-				fmt.Sprintf("failed to create %s", strings.ToLower(itemType[:len(itemType)-1])), err)
+			index, source := i, ""
+			if file, fileIndex, ok := itemSource(item); ok {
+				index, source = fileIndex, file
+			}
+			errorMsg := common.FormatCreationError(itemType[:len(itemType)-1], title, index, source, err)
+			wrappedErr := errors.APIError(fmt.Sprintf("create_%s", strings.ToLower(itemType[:len(itemType)-1])), fmt.Sprintf("failed to create %s", strings.ToLower(itemType[:len(itemType)-1])), err)
 			wrappedErr = errors.WithContextSafe(wrappedErr, "title", title)
-			errorCollector.Add(wrappedErr)
+			if source != "" {
+				wrappedErr = errors.WithContextSafe(wrappedErr, "source_file", source)
+			}
+			failures = append(failures, errors.Failure{Section: itemType, Message: errorMsg, Err: wrappedErr})
+			summary.Failures++
+			summary.Errors = append(summary.Errors, errorMsg)
 			logger.Info("Failed to create %s '%s': %v", strings.ToLower(itemType[:len(itemType)-1]), title, err)
 		} else {
-			logger.Info("Created %s: %s", strings.ToLower(itemType[:len(itemType)-1]), title)
-			// Track successful creation with actual node ID from GitHub
-			createdItems = append(createdItems, CreatedItem{
-				NodeID: createdItemInfo.NodeID,
-				Title:  createdItemInfo.Title,
-				Type:   createdItemInfo.Type,
-			})
+			// Track successful creation with actual node ID and number from GitHub
+			created := newCreatedItem(createdItemInfo, title)
+			logger.Info("%s %s", createdVerb(createdItemInfo), describeCreatedItem(strings.ToLower(itemType[:len(itemType)-1]), created))
+			createdItems = append(createdItems, created)
+			summary.Success++
+			summary.Created = append(summary.Created, created)
 		}
 	}
 
-	return createdItems, errorCollector.Result()
+	if len(failures) > 0 {
+		return createdItems, errors.NewPartialFailureFromFailures(failures)
+	}
+	return createdItems, nil
 }
 
 // addItemsToProject adds all created items to the specified ProjectV2.
@@ -967,7 +1905,7 @@ func addItemsToProject(ctx context.Context, client githubapi.GitHubClient, proje
 	errorCollector := errors.NewErrorCollector("add_items_to_project")
 	successCount := 0
 
-	for _, item := range items {
+	for i, item := range items {
 		// Skip items without valid node IDs (e.g., dry-run items or items that failed to get node IDs)
 		if strings.HasPrefix(item.NodeID, "dry-run-") || strings.HasPrefix(item.NodeID, "created-") {
 			logger.Debug("Skipping item '%s' - no valid node ID available", item.Title)
@@ -984,6 +1922,7 @@ func addItemsToProject(ctx context.Context, client githubapi.GitHubClient, proje
 			logger.Info("Failed to add %s '%s' to project: %v", item.Type, item.Title, err)
 		} else {
 			successCount++
+			items[i].AddedToProject = true
 			logger.Debug("Added %s '%s' to project", item.Type, item.Title)
 		}
 	}
@@ -991,3 +1930,14 @@ func addItemsToProject(ctx context.Context, client githubapi.GitHubClient, proje
 	logger.Info("Added %d/%d items to project successfully", successCount, len(items))
 	return errorCollector.Result()
 }
+
+// notAddedToProject describes the created items that are not on the project, e.g. "issue #3: Fix login"
+func notAddedToProject(items []CreatedItem) []string {
+	var notAdded []string
+	for _, item := range items {
+		if !item.AddedToProject {
+			notAdded = append(notAdded, describeCreatedItem(item.Type, item))
+		}
+	}
+	return notAdded
+}
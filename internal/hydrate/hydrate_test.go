@@ -3,14 +3,20 @@ package hydrate
 import (
 	"context"
 	"encoding/json"
-	"errors"
+	stderrors "errors"
+	"fmt"
 	"os"
 	"path/filepath"
+	"reflect"
+	"sort"
 	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/chrisreddington/gh-demo/internal/common"
 	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
 	"github.com/chrisreddington/gh-demo/internal/githubapi"
 	"github.com/chrisreddington/gh-demo/internal/testutil"
 	"github.com/chrisreddington/gh-demo/internal/types"
@@ -455,7 +461,7 @@ func TestHydrateFromFiles_ContextCancellation(t *testing.T) {
 	}
 
 	// Should return context.Canceled error
-	if !errors.Is(err, context.Canceled) {
+	if !stderrors.Is(err, context.Canceled) {
 		t.Errorf("Expected context.Canceled, got: %v", err)
 	}
 }
@@ -486,7 +492,7 @@ func TestHydrateWithLabels_ContextCancellation(t *testing.T) {
 	}
 
 	// Should return context.Canceled error
-	if !errors.Is(err, context.Canceled) {
+	if !stderrors.Is(err, context.Canceled) {
 		t.Errorf("Expected context.Canceled, got: %v", err)
 	}
 }
@@ -596,6 +602,10 @@ func TestCollectLabels(t *testing.T) {
 					t.Errorf("Unexpected label: %s", label)
 				}
 			}
+
+			if !sort.StringsAreSorted(labels) {
+				t.Errorf("Expected labels in sorted order, got %v", labels)
+			}
 		})
 	}
 }
@@ -727,6 +737,147 @@ func TestEnsureLabelsExist_ListLabelsError(t *testing.T) {
 	}
 }
 
+// TestEnsureLabelsExist_UpdatesDifferingLabels tests that existing labels whose color or description
+// differ from their definitions are updated, and that matching labels are left untouched
+func TestEnsureLabelsExist_UpdatesDifferingLabels(t *testing.T) {
+	tests := []struct {
+		name             string
+		dryRun           bool
+		updateError      bool
+		expectedUpdated  []string
+		expectedSuccess  int
+		expectedFailures int
+		expectedCount    int
+	}{
+		{
+			name:            "differing label updated",
+			expectedUpdated: []string{"docs"},
+			expectedSuccess: 2,
+			expectedCount:   1,
+		},
+		{
+			name:            "dry run only counts the update",
+			dryRun:          true,
+			expectedSuccess: 2,
+			expectedCount:   1,
+		},
+		{
+			name:             "update failure recorded",
+			updateError:      true,
+			expectedSuccess:  1,
+			expectedFailures: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(MockConfig{
+				ExistingLabels: map[string]bool{"bug": true, "docs": true},
+				LabelDetails: map[string]types.Label{
+					"bug":  {Name: "bug", Color: "D73A4A", Description: "Something isn't working"},
+					"docs": {Name: "docs", Color: "0075ca", Description: "Old description"},
+				},
+				UpdateLabel: testutil.ErrorConfig{ShouldError: tt.updateError},
+			})
+
+			logger := &testutil.MockLogger{}
+			summary := &SectionSummary{}
+			labels := []types.Label{
+				{Name: "bug", Color: "d73a4a", Description: "Something isn't working"},
+				{Name: "docs", Color: "0075ca", Description: "Documentation"},
+			}
+
+			if err := EnsureDefinedLabelsExist(context.Background(), client, labels, logger, summary, tt.dryRun); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(client.CreatedLabels) != 0 {
+				t.Errorf("Expected no labels to be created, got %v", client.CreatedLabels)
+			}
+			if !reflect.DeepEqual(client.UpdatedLabels, tt.expectedUpdated) {
+				t.Errorf("Expected updated labels %v, got %v", tt.expectedUpdated, client.UpdatedLabels)
+			}
+			if summary.Success != tt.expectedSuccess || summary.Failures != tt.expectedFailures || summary.Updated != tt.expectedCount {
+				t.Errorf("Expected %d successes, %d failures and %d updated, got %d, %d and %d",
+					tt.expectedSuccess, tt.expectedFailures, tt.expectedCount, summary.Success, summary.Failures, summary.Updated)
+			}
+			if tt.expectedUpdated != nil && client.Config.LabelDetails["docs"].Description != "Documentation" {
+				t.Errorf("Expected docs to get its defined description, got %+v", client.Config.LabelDetails["docs"])
+			}
+		})
+	}
+}
+
+// TestEnsureRepositoryLabels_UpdatesOnlyDefinedLabels tests that hydration applies labels.json
+// definitions to existing labels but leaves GitHub's defaults as the repository has them
+func TestEnsureRepositoryLabels_UpdatesOnlyDefinedLabels(t *testing.T) {
+	tempDir := t.TempDir()
+	labelsJSON := `[{"name": "docs", "color": "0075ca", "description": "Documentation"}]`
+	if err := os.WriteFile(filepath.Join(tempDir, config.LabelsFilename), []byte(labelsJSON), 0644); err != nil {
+		t.Fatalf("Failed to create labels.json: %v", err)
+	}
+	cfg := config.NewConfiguration(context.Background(), tempDir)
+
+	client := NewFailingMockGitHubClient(MockConfig{
+		ExistingLabels: map[string]bool{"bug": true, "docs": true},
+		LabelDetails: map[string]types.Label{
+			"bug":  {Name: "bug", Color: "ff0000", Description: "Custom bug"},
+			"docs": {Name: "docs", Color: "0075ca", Description: "Old description"},
+		},
+	})
+	logger := &testutil.MockLogger{}
+
+	if err := ensureRepositoryLabels(context.Background(), client, cfg, nil, nil, nil, HydrateOptions{DefaultLabels: true}, logger); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(client.UpdatedLabels, []string{"docs"}) {
+		t.Errorf("Expected only docs to be updated, got %v", client.UpdatedLabels)
+	}
+	if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "1 updated") {
+		t.Errorf("Expected the label summary to count the update, got: %v", logger.InfoCalls)
+	}
+}
+
+// TestEnsureLabelsExist_CaseVariants tests existence decisions when the repository has labels differing only by case
+func TestEnsureLabelsExist_CaseVariants(t *testing.T) {
+	tests := []struct {
+		name            string
+		normalize       bool
+		expectedCreated []string
+	}{
+		{
+			name:            "exact names",
+			expectedCreated: []string{"BUG", "Feature"},
+		},
+		{
+			name:            "normalized names",
+			normalize:       true,
+			expectedCreated: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(MockConfig{ExistingLabels: map[string]bool{"Bug": true, "bug": true, "feature": true}})
+			logger := &testutil.MockLogger{}
+			summary := &SectionSummary{}
+			labels := []types.Label{{Name: "bug"}, {Name: "BUG"}, {Name: "Feature"}}
+
+			if err := ensureLabelsExist(context.Background(), client, labels, summary, ensureLabelsOptions{Normalize: tt.normalize}, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if !reflect.DeepEqual(client.CreatedLabels, tt.expectedCreated) {
+				t.Errorf("Expected created labels %v, got %v", tt.expectedCreated, client.CreatedLabels)
+			}
+			if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "the repository has labels that differ only by case") {
+				t.Errorf("Expected a warning about case-variant labels, got: %v", logger.InfoCalls)
+			}
+		})
+	}
+}
+
 // TestEnsureLabelsExist_EmptyLabels tests the early return when no labels provided
 func TestEnsureLabelsExist_EmptyLabels(t *testing.T) {
 	client := NewSuccessfulMockGitHubClient()
@@ -1347,6 +1498,49 @@ func TestCleanupBeforeHydration_ContextCancellation(t *testing.T) {
 	}
 }
 
+// cancellingDeleteClient cancels the cleanup context after its first issue deletion
+type cancellingDeleteClient struct {
+	*ConfigurableMockGitHubClient
+	cancel context.CancelFunc
+}
+
+func (c *cancellingDeleteClient) DeleteIssue(ctx context.Context, nodeID string) error {
+	err := c.ConfigurableMockGitHubClient.DeleteIssue(ctx, nodeID)
+	c.cancel()
+	return err
+}
+
+// TestCleanupBeforeHydration_CancelledAfterFirstDeletion tests that cancellation stops cleanup at the next item and returns exactly what was deleted
+func TestCleanupBeforeHydration_CancelledAfterFirstDeletion(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mock := NewSuccessfulMockGitHubClient("bug")
+	mock.CreatedIssues = []types.Issue{
+		{NodeID: "issue1", Title: "Issue 1"},
+		{NodeID: "issue2", Title: "Issue 2"},
+		{NodeID: "issue3", Title: "Issue 3"},
+	}
+	client := &cancellingDeleteClient{ConfigurableMockGitHubClient: mock, cancel: cancel}
+
+	summary, err := CleanupBeforeHydration(ctx, client, CleanupOptions{CleanIssues: true, CleanLabels: true}, &testutil.MockLogger{})
+
+	// A context error, rather than the partial failure error of failed deletions
+	if !stderrors.Is(err, context.Canceled) {
+		t.Fatalf("Expected a context error, got: %v", err)
+	}
+	if summary == nil {
+		t.Fatal("Expected the partial summary to be returned")
+	}
+	expectedItems := []CleanupItem{{Type: "issue", Title: "Issue 1", NodeID: "issue1"}}
+	if summary.IssuesDeleted != 1 || !reflect.DeepEqual(summary.DeletedItems, expectedItems) {
+		t.Errorf("Expected only Issue 1 to be deleted, got %d deleted: %v", summary.IssuesDeleted, summary.DeletedItems)
+	}
+	if summary.LabelsDeleted != 0 || len(mock.CreatedIssues) != 2 {
+		t.Errorf("Expected cleanup to stop after the first deletion, got %d labels deleted and %d issues left", summary.LabelsDeleted, len(mock.CreatedIssues))
+	}
+}
+
 // TestCleanupIssues tests issue cleanup functionality
 func TestCleanupIssues(t *testing.T) {
 	tests := []struct {
@@ -1637,3 +1831,973 @@ func TestCleanupLabels(t *testing.T) {
 		})
 	}
 }
+
+// TestCleanupBeforeHydration_DryRunReportsPreservation tests that dry-run cleanup tallies and explains preserved items
+func TestCleanupBeforeHydration_DryRunReportsPreservation(t *testing.T) {
+	client := NewSuccessfulMockGitHubClient("bug", "keep")
+	client.CreatedIssues = []types.Issue{
+		{NodeID: "issue1", Title: "Pinned Issue", Labels: []string{"keep"}},
+		{NodeID: "issue2", Title: "Release 1.0"},
+		{NodeID: "issue3", Title: "Throwaway Issue"},
+	}
+
+	preserveConfig := &config.PreserveConfig{}
+	preserveConfig.Issues.PreserveByLabel = []string{"keep"}
+	preserveConfig.Issues.PreserveByTitle = []string{"^Release.*"}
+	preserveConfig.Labels.PreserveByName = []string{"keep"}
+
+	options := CleanupOptions{
+		CleanIssues:    true,
+		CleanLabels:    true,
+		DryRun:         true,
+		PreserveConfig: preserveConfig,
+	}
+	logger := &testutil.MockLogger{}
+
+	summary, err := CleanupBeforeHydration(context.Background(), client, options, logger)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if summary.IssuesPreserved != 2 || summary.IssuesDeleted != 1 {
+		t.Errorf("Expected 2 issues preserved and 1 deleted, got %d preserved and %d deleted", summary.IssuesPreserved, summary.IssuesDeleted)
+	}
+	if summary.LabelsPreserved != 1 || summary.LabelsDeleted != 1 {
+		t.Errorf("Expected 1 label preserved and 1 deleted, got %d preserved and %d deleted", summary.LabelsPreserved, summary.LabelsDeleted)
+	}
+	if len(client.CreatedIssues) != 3 {
+		t.Errorf("Expected no issues to be deleted in dry-run, %d remain", len(client.CreatedIssues))
+	}
+
+	expectedMessages := []string{
+		"Would preserve issue: Pinned Issue (reason: label 'keep')",
+		"Would preserve issue: Release 1.0 (reason: title matches '^Release.*')",
+		"Would delete issue: Throwaway Issue",
+		"Would preserve label: keep (reason: name 'keep')",
+		"Cleanup summary (dry-run): Issues(1 would delete, 2 would preserve)",
+	}
+	infoOutput := strings.Join(logger.InfoCalls, "\n")
+	for _, expected := range expectedMessages {
+		if !strings.Contains(infoOutput, expected) {
+			t.Errorf("Expected info output to contain %q, got:\n%s", expected, infoOutput)
+		}
+	}
+}
+
+// TestPruneUndefinedLabels tests that labels missing from labels.json are pruned unless preserved
+func TestPruneUndefinedLabels(t *testing.T) {
+	definedLabels := []types.Label{
+		{Name: "bug", Color: "d73a4a"},
+		{Name: "enhancement", Color: "a2eeef"},
+	}
+
+	tests := []struct {
+		name             string
+		existingLabels   []string
+		preserveNames    []string
+		dryRun           bool
+		expectedPruned   int
+		expectedRemain   []string
+		expectedRemoved  []string
+		expectedInfoLogs []string
+	}{
+		{
+			name:            "deletes extra label",
+			existingLabels:  []string{"bug", "enhancement", "stale"},
+			expectedPruned:  1,
+			expectedRemain:  []string{"bug", "enhancement"},
+			expectedRemoved: []string{"stale"},
+		},
+		{
+			name:            "preserves protected label",
+			existingLabels:  []string{"bug", "protected", "stale"},
+			preserveNames:   []string{"protected"},
+			expectedPruned:  1,
+			expectedRemain:  []string{"bug", "protected"},
+			expectedRemoved: []string{"stale"},
+		},
+		{
+			name:             "dry-run reports without deleting",
+			existingLabels:   []string{"bug", "stale"},
+			dryRun:           true,
+			expectedPruned:   1,
+			expectedRemain:   []string{"bug", "stale"},
+			expectedInfoLogs: []string{"Would prune label: stale", "1 labels would be deleted"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient(tt.existingLabels...)
+			logger := &testutil.MockLogger{}
+
+			preserveConfig := &config.PreserveConfig{}
+			preserveConfig.Labels.PreserveByName = tt.preserveNames
+
+			pruned, err := PruneUndefinedLabels(context.Background(), client, definedLabels, preserveConfig, logger, tt.dryRun)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if pruned != tt.expectedPruned {
+				t.Errorf("Expected %d labels pruned, got %d", tt.expectedPruned, pruned)
+			}
+			for _, name := range tt.expectedRemain {
+				if !client.Config.ExistingLabels[name] {
+					t.Errorf("Expected label %q to remain", name)
+				}
+			}
+			for _, name := range tt.expectedRemoved {
+				if client.Config.ExistingLabels[name] {
+					t.Errorf("Expected label %q to be deleted", name)
+				}
+			}
+
+			infoOutput := strings.Join(logger.InfoCalls, "\n")
+			for _, expected := range tt.expectedInfoLogs {
+				if !strings.Contains(infoOutput, expected) {
+					t.Errorf("Expected info output to contain %q, got:\n%s", expected, infoOutput)
+				}
+			}
+		})
+	}
+}
+
+// TestCreateRepositoryContent_PartialFailureSections tests that failed items keep their section and error for the failure report
+func TestCreateRepositoryContent_PartialFailureSections(t *testing.T) {
+	client := NewFailingMockGitHubClient(MockConfig{Issues: testutil.ErrorConfig{ShouldError: true, ErrorMessage: "label not found"}})
+	options := HydrateOptions{IncludeIssues: true}
+
+	_, err := createRepositoryContent(context.Background(), client, []types.Issue{{Title: "Bug"}}, nil, nil, options, &testutil.MockLogger{})
+
+	var partialErr *errors.PartialFailureError
+	if !stderrors.As(err, &partialErr) || len(partialErr.Failures) != 1 {
+		t.Fatalf("Expected a partial failure with one failure, got: %v", err)
+	}
+	failure := partialErr.Failures[0]
+	if failure.Section != "Issues" || failure.Err == nil || !strings.HasPrefix(failure.Message, "Issue 1 (Bug): ") {
+		t.Errorf("Expected an Issues failure for 'Bug' with its error, got %+v", failure)
+	}
+}
+
+// TestCreateItems_ReportsCreatedNumbers tests that created issue and pull request numbers are logged and summarized
+func TestCreateItems_ReportsCreatedNumbers(t *testing.T) {
+	client := NewSuccessfulMockGitHubClient()
+	logger := &testutil.MockLogger{}
+	issues := []types.Issue{{Title: "First Issue"}, {Title: "Second Issue"}}
+
+	_, issueErrors, err := createIssues(context.Background(), client, issues, logger, HydrateOptions{})
+	if err != nil || len(issueErrors) > 0 {
+		t.Fatalf("Unexpected errors: %v %v", err, issueErrors)
+	}
+
+	expectedMessages := []string{
+		"Created issue #1: First Issue",
+		"Created issue #2: Second Issue",
+		"Issues: 2 total, 2 successful, 0 failed (created #1, #2)",
+	}
+	infoOutput := strings.Join(logger.InfoCalls, "\n")
+	for _, expected := range expectedMessages {
+		if !strings.Contains(infoOutput, expected) {
+			t.Errorf("Expected info output to contain %q, got:\n%s", expected, infoOutput)
+		}
+	}
+}
+
+// TestCreateItemsWithTracking_CapturesNumbers tests that tracked items carry the number and URL returned by GitHub
+func TestCreateItemsWithTracking_CapturesNumbers(t *testing.T) {
+	client := NewSuccessfulMockGitHubClient()
+	logger := &testutil.MockLogger{}
+	pullRequests := []types.PullRequest{{Title: "Add feature", Head: "feature", Base: "main"}}
+
+	created, err := createItemsWithTracking(context.Background(), client, pullRequests, "Pull Requests", client.CreatePR, logger, HydrateOptions{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(created) != 1 {
+		t.Fatalf("Expected 1 created item, got %d", len(created))
+	}
+	if created[0].Number != 1 || created[0].URL != "https://github.com/owner/repo/pull/1" {
+		t.Errorf("Expected number 1 and pull request URL, got %+v", created[0])
+	}
+	if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Created pull request #1: Add feature") {
+		t.Errorf("Expected created pull request number in output, got: %v", logger.InfoCalls)
+	}
+}
+
+// TestHydrateWithOptions_DeterministicOrdering tests that labels are ensured in sorted order
+// and content is created in config order on every run
+func TestHydrateWithOptions_DeterministicOrdering(t *testing.T) {
+	tempDir := t.TempDir()
+
+	issuesJSON := `[
+		{"title": "Zebra issue", "body": "Body", "labels": ["zeta", "alpha"]},
+		{"title": "Apple issue", "body": "Body", "labels": ["mu"]},
+		{"title": "Mango issue", "body": "Body", "labels": ["beta"]}
+	]`
+	labelsJSON := `[{"name": "omega", "color": "ffffff"}, {"name": "delta", "color": "000000"}]`
+	prsJSON := `[
+		{"title": "Second PR", "body": "Body", "head": "b", "base": "main", "labels": ["gamma"]},
+		{"title": "First PR", "body": "Body", "head": "a", "base": "main"}
+	]`
+	files := map[string]string{
+		"issues.json": issuesJSON,
+		"labels.json": labelsJSON,
+		"prs.json":    prsJSON,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+	options := HydrateOptions{IncludeIssues: true, IncludePullRequests: true}
+
+	expectedLabels := []string{"alpha", "beta", "delta", "gamma", "mu", "omega", "zeta"}
+	expectedIssues := []string{"Zebra issue", "Apple issue", "Mango issue"}
+	expectedPRs := []string{"Second PR", "First PR"}
+
+	for run := 0; run < 5; run++ {
+		client := NewSuccessfulMockGitHubClient()
+		if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+			t.Fatalf("Run %d: unexpected error: %v", run, err)
+		}
+
+		if strings.Join(client.CreatedLabels, ",") != strings.Join(expectedLabels, ",") {
+			t.Errorf("Run %d: expected labels %v, got %v", run, expectedLabels, client.CreatedLabels)
+		}
+
+		var issueTitles []string
+		for _, issue := range client.CreatedIssues {
+			issueTitles = append(issueTitles, issue.Title)
+		}
+		if strings.Join(issueTitles, ",") != strings.Join(expectedIssues, ",") {
+			t.Errorf("Run %d: expected issues %v, got %v", run, expectedIssues, issueTitles)
+		}
+
+		var prTitles []string
+		for _, pullRequest := range client.CreatedPRs {
+			prTitles = append(prTitles, pullRequest.Title)
+		}
+		if strings.Join(prTitles, ",") != strings.Join(expectedPRs, ",") {
+			t.Errorf("Run %d: expected pull requests %v, got %v", run, expectedPRs, prTitles)
+		}
+	}
+}
+
+// TestEnsureRepositoryLabels_NoAutoLabels tests that referenced labels are auto-created only by default
+func TestEnsureRepositoryLabels_NoAutoLabels(t *testing.T) {
+	tests := []struct {
+		name            string
+		noAutoLabels    bool
+		expectedLabels  []string
+		expectedWarning string
+	}{
+		{
+			name:           "default creates referenced labels",
+			noAutoLabels:   false,
+			expectedLabels: []string{"bug", "undefined"},
+		},
+		{
+			name:            "opt-out skips undefined labels with a warning",
+			noAutoLabels:    true,
+			expectedLabels:  []string{"bug"},
+			expectedWarning: "label 'undefined' is not defined in labels.json",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			labelsPath := filepath.Join(tempDir, "labels.json")
+			if err := os.WriteFile(labelsPath, []byte(`[{"name": "bug", "color": "d73a4a"}]`), 0644); err != nil {
+				t.Fatalf("Failed to create labels.json: %v", err)
+			}
+			cfg := &config.Configuration{LabelsPath: labelsPath}
+
+			client := NewSuccessfulMockGitHubClient()
+			logger := &testutil.MockLogger{}
+			issues := []types.Issue{{Title: "Issue", Labels: []string{"bug", "undefined"}}}
+
+			err := ensureRepositoryLabels(context.Background(), client, cfg, issues, nil, nil, HydrateOptions{NoAutoLabels: tt.noAutoLabels}, logger)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if strings.Join(client.CreatedLabels, ",") != strings.Join(tt.expectedLabels, ",") {
+				t.Errorf("Expected labels %v to be created, got %v", tt.expectedLabels, client.CreatedLabels)
+			}
+
+			warnings := strings.Join(logger.InfoCalls, "\n")
+			if tt.expectedWarning != "" && !strings.Contains(warnings, tt.expectedWarning) {
+				t.Errorf("Expected warning containing %q, got: %v", tt.expectedWarning, logger.InfoCalls)
+			}
+			if tt.expectedWarning == "" && strings.Contains(warnings, "Warning:") {
+				t.Errorf("Expected no warnings, got: %v", logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestPrepareLabelsToEnsure_Descriptions tests that auto-generated labels are described by the matching pattern
+func TestPrepareLabelsToEnsure_Descriptions(t *testing.T) {
+	descriptions := map[string]string{
+		"area/*":      "Affects area: {suffix}",
+		"area/docs/*": "Documentation area",
+		"priority/":   "Priority level",
+	}
+
+	tests := []struct {
+		name                string
+		labelName           string
+		expectedDescription string
+	}{
+		{
+			name:                "prefix match uses custom description",
+			labelName:           "area/frontend",
+			expectedDescription: "Affects area: frontend",
+		},
+		{
+			name:                "longest prefix wins",
+			labelName:           "area/docs/api",
+			expectedDescription: "Documentation area",
+		},
+		{
+			name:                "pattern without trailing star",
+			labelName:           "priority/high",
+			expectedDescription: "Priority level",
+		},
+		{
+			name:                "unmatched label keeps default description",
+			labelName:           "bug",
+			expectedDescription: config.DefaultLabelDescription,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := prepareLabelsToEnsure(context.Background(), nil, []string{tt.labelName}, descriptions)
+			if len(labels) != 1 {
+				t.Fatalf("Expected 1 label, got %d", len(labels))
+			}
+			if labels[0].Description != tt.expectedDescription {
+				t.Errorf("Expected description %q, got %q", tt.expectedDescription, labels[0].Description)
+			}
+		})
+	}
+
+	t.Run("explicit labels keep their own description", func(t *testing.T) {
+		explicit := []types.Label{{Name: "area/backend", Description: "Server code", Color: "0e8a16"}}
+		labels := prepareLabelsToEnsure(context.Background(), explicit, []string{"area/backend"}, descriptions)
+		if len(labels) != 1 || labels[0].Description != "Server code" {
+			t.Errorf("Expected explicit description to be kept, got %+v", labels)
+		}
+	})
+}
+
+// TestCleanupItems_Concurrency tests deletion and error counts when deletions run concurrently
+func TestCleanupItems_Concurrency(t *testing.T) {
+	tests := []struct {
+		name            string
+		concurrency     int
+		cancelled       bool
+		expectedDeleted int
+		expectedErrors  int
+	}{
+		{name: "serial by default", concurrency: 0, expectedDeleted: 16, expectedErrors: 4},
+		{name: "concurrent deletions", concurrency: 4, expectedDeleted: 16, expectedErrors: 4},
+		{name: "more workers than items", concurrency: 50, expectedDeleted: 16, expectedErrors: 4},
+		{name: "cancelled context deletes nothing", concurrency: 4, cancelled: true, expectedDeleted: 0, expectedErrors: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var issues []types.Issue
+			for i := 1; i <= 20; i++ {
+				issues = append(issues, types.Issue{NodeID: fmt.Sprintf("I_%d", i), Title: fmt.Sprintf("Issue %d", i)})
+			}
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			if tt.cancelled {
+				cancel()
+			}
+
+			var inFlight, maxInFlight int32
+			deleteFunc := func(ctx context.Context, nodeID string) error {
+				current := atomic.AddInt32(&inFlight, 1)
+				defer atomic.AddInt32(&inFlight, -1)
+				for {
+					seen := atomic.LoadInt32(&maxInFlight)
+					if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+
+				var number int
+				fmt.Sscanf(nodeID, "I_%d", &number)
+				if number%5 == 0 {
+					return fmt.Errorf("forbidden")
+				}
+				return nil
+			}
+
+			summary := &CleanupSummary{}
+			funcs := issueCleanup(
+				func(_ context.Context, fn func([]types.Issue) error) error { return fn(issues) },
+				deleteFunc,
+			)
+			errs := cleanupItems(ctx, funcs, CleanupOptions{CleanIssues: true, Concurrency: tt.concurrency}, summary, &testutil.MockLogger{})
+
+			if summary.IssuesDeleted != tt.expectedDeleted {
+				t.Errorf("Expected %d issues deleted, got %d", tt.expectedDeleted, summary.IssuesDeleted)
+			}
+			if len(errs) != tt.expectedErrors {
+				t.Errorf("Expected %d errors, got %d: %v", tt.expectedErrors, len(errs), errs)
+			}
+			limit := int32(tt.concurrency)
+			if limit < 1 {
+				limit = 1
+			}
+			if maxInFlight > limit {
+				t.Errorf("Expected at most %d deletions at once, got %d", limit, maxInFlight)
+			}
+		})
+	}
+}
+
+// TestCleanupBeforeHydration_Pages tests that items on every listed page are deleted and preserve rules still apply
+func TestCleanupBeforeHydration_Pages(t *testing.T) {
+	tests := []struct {
+		name              string
+		pageSize          int
+		expectedPages     int
+		expectedRemaining []string
+	}{
+		{name: "several pages", pageSize: 2, expectedPages: 3, expectedRemaining: []string{"Keep me"}},
+		{name: "single page", expectedPages: 1, expectedRemaining: []string{"Keep me"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(MockConfig{ListPageSize: tt.pageSize})
+			for i, title := range []string{"Issue 1", "Keep me", "Issue 3", "Issue 4", "Issue 5"} {
+				client.CreatedIssues = append(client.CreatedIssues, types.Issue{NodeID: fmt.Sprintf("I_%d", i+1), Title: title})
+			}
+			preserve := &config.PreserveConfig{}
+			preserve.Issues.PreserveByTitle = []string{"Keep me"}
+
+			summary, err := CleanupBeforeHydration(context.Background(), client, CleanupOptions{CleanIssues: true, PreserveConfig: preserve}, &testutil.MockLogger{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if client.ListedPages != tt.expectedPages {
+				t.Errorf("Expected %d listed pages, got %d", tt.expectedPages, client.ListedPages)
+			}
+			if summary.IssuesDeleted != 4 || summary.IssuesPreserved != 1 {
+				t.Errorf("Expected 4 deleted and 1 preserved, got %d deleted and %d preserved", summary.IssuesDeleted, summary.IssuesPreserved)
+			}
+			var remaining []string
+			for _, issue := range client.CreatedIssues {
+				remaining = append(remaining, issue.Title)
+			}
+			if !reflect.DeepEqual(remaining, tt.expectedRemaining) {
+				t.Errorf("Expected remaining issues %v, got %v", tt.expectedRemaining, remaining)
+			}
+		})
+	}
+}
+
+// TestCleanupDiscussions_Close tests that close mode closes open discussions instead of deleting them
+func TestCleanupDiscussions_Close(t *testing.T) {
+	tests := []struct {
+		name              string
+		closeDiscussions  bool
+		expectedRemaining int
+		expectedClosed    map[string]string
+	}{
+		{name: "deletes by default", expectedRemaining: 0, expectedClosed: nil},
+		{
+			name:              "closes open discussions as outdated",
+			closeDiscussions:  true,
+			expectedRemaining: 3,
+			expectedClosed:    map[string]string{"D_1": "OUTDATED", "D_2": "OUTDATED"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			client.CreatedDiscussions = []types.Discussion{
+				{NodeID: "D_1", Title: "Open one", State: "OPEN"},
+				{NodeID: "D_2", Title: "Open two", State: "OPEN"},
+				{NodeID: "D_3", Title: "Already closed", State: "CLOSED"},
+			}
+
+			summary := &CleanupSummary{}
+			options := CleanupOptions{CleanDiscussions: true, CloseDiscussions: tt.closeDiscussions}
+			if errs := cleanupDiscussions(context.Background(), client, options, summary, &testutil.MockLogger{}); len(errs) != 0 {
+				t.Fatalf("Unexpected errors: %v", errs)
+			}
+
+			if len(client.CreatedDiscussions) != tt.expectedRemaining {
+				t.Errorf("Expected %d discussions to remain, got %d", tt.expectedRemaining, len(client.CreatedDiscussions))
+			}
+			if !reflect.DeepEqual(client.ClosedDiscussions, tt.expectedClosed) {
+				t.Errorf("Expected closed discussions %v, got %v", tt.expectedClosed, client.ClosedDiscussions)
+			}
+			if tt.closeDiscussions && summary.DiscussionsDeleted != len(tt.expectedClosed) {
+				t.Errorf("Expected %d discussions counted, got %d", len(tt.expectedClosed), summary.DiscussionsDeleted)
+			}
+		})
+	}
+}
+
+// TestCleanupBranches tests that branches matching the prefix are deleted, except the default and protected branches
+func TestCleanupBranches(t *testing.T) {
+	branches := []types.Branch{
+		{NodeID: "REF_1", Name: "demo/feature-a"},
+		{NodeID: "REF_2", Name: "demo/feature-b"},
+		{NodeID: "REF_3", Name: "demo/main"},
+		{NodeID: "REF_4", Name: "demo/protected", Protected: true},
+		{NodeID: "REF_5", Name: "feature/other"},
+	}
+
+	tests := []struct {
+		name             string
+		dryRun           bool
+		deleteError      bool
+		expectedDeleted  []string
+		expectedCount    int
+		expectedSkipped  int
+		expectedErrors   int
+		expectedMessages []string
+	}{
+		{
+			name:             "deletes matching branches",
+			expectedDeleted:  []string{"demo/feature-a", "demo/feature-b"},
+			expectedCount:    2,
+			expectedSkipped:  2,
+			expectedMessages: []string{"Skipping branch demo/main: it is the default branch", "Skipping branch demo/protected: it is protected", "Pruned 2 branches starting with 'demo/' (2 skipped)"},
+		},
+		{
+			name:             "dry-run deletes nothing",
+			dryRun:           true,
+			expectedCount:    2,
+			expectedSkipped:  2,
+			expectedMessages: []string{"Would delete branch: demo/feature-a", "Would prune 2 branches starting with 'demo/' (2 skipped)"},
+		},
+		{
+			name:            "failed deletions are collected",
+			deleteError:     true,
+			expectedSkipped: 2,
+			expectedErrors:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(MockConfig{
+				DefaultBranch: "demo/main",
+				Branches:      append([]types.Branch(nil), branches...),
+				DeleteBranch:  testutil.ErrorConfig{ShouldError: tt.deleteError},
+			})
+			logger := &testutil.MockLogger{}
+			summary := &CleanupSummary{}
+
+			options := CleanupOptions{PruneBranchPrefix: "demo/", DryRun: tt.dryRun}
+			errs := cleanupBranches(context.Background(), client, options, summary, logger)
+
+			if len(errs) != tt.expectedErrors {
+				t.Errorf("Expected %d errors, got %v", tt.expectedErrors, errs)
+			}
+			if !reflect.DeepEqual(client.DeletedBranches, tt.expectedDeleted) {
+				t.Errorf("Expected deleted branches %v, got %v", tt.expectedDeleted, client.DeletedBranches)
+			}
+			if summary.BranchesDeleted != tt.expectedCount || summary.BranchesSkipped != tt.expectedSkipped {
+				t.Errorf("Expected %d deleted and %d skipped, got %d and %d", tt.expectedCount, tt.expectedSkipped, summary.BranchesDeleted, summary.BranchesSkipped)
+			}
+			infoOutput := strings.Join(logger.InfoCalls, "\n")
+			for _, expected := range tt.expectedMessages {
+				if !strings.Contains(infoOutput, expected) {
+					t.Errorf("Expected info output to contain %q, got:\n%s", expected, infoOutput)
+				}
+			}
+		})
+	}
+}
+
+// TestCleanupBranches_DefaultBranchUnknown tests that no branch is deleted when the default branch cannot be determined
+func TestCleanupBranches_DefaultBranchUnknown(t *testing.T) {
+	client := NewFailingMockGitHubClient(MockConfig{
+		GetDefaultBranch: testutil.ErrorConfig{ShouldError: true},
+		Branches:         []types.Branch{{NodeID: "REF_1", Name: "demo/feature"}},
+	})
+
+	errs := cleanupBranches(context.Background(), client, CleanupOptions{PruneBranchPrefix: "demo/"}, &CleanupSummary{}, &testutil.MockLogger{})
+
+	if len(errs) != 1 || !strings.Contains(errs[0], "failed to determine the default branch") {
+		t.Errorf("Expected a default branch error, got %v", errs)
+	}
+	if len(client.DeletedBranches) != 0 {
+		t.Errorf("Expected no branches to be deleted, got %v", client.DeletedBranches)
+	}
+}
+
+// TestHydrateWithOptions_ErrorNamesSourceFile tests that a failed item's error names the file it came from,
+// in the format of common.FormatCreationError with or without a project
+func TestHydrateWithOptions_ErrorNamesSourceFile(t *testing.T) {
+	for _, createProject := range []bool{false, true} {
+		t.Run(fmt.Sprintf("create project %v", createProject), func(t *testing.T) {
+			tempDir := t.TempDir()
+			issuesPath := filepath.Join(tempDir, "issues.json")
+			issuesJSON := `[{"title": "First", "body": "Body"}, {"title": "Second", "body": "Body"}]`
+			if err := os.WriteFile(issuesPath, []byte(issuesJSON), 0644); err != nil {
+				t.Fatalf("Failed to create issues.json: %v", err)
+			}
+			cfg := createConfigurationFromPaths(context.Background(), issuesPath,
+				filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+			client := NewFailingMockGitHubClient(MockConfig{Issues: testutil.ErrorConfig{ShouldError: true}})
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludeIssues: true, CreateProject: createProject}
+			err := HydrateWithOptions(context.Background(), client, cfg, options, logger)
+
+			// With a project, item failures are logged rather than returned, in the same format
+			output := strings.Join(logger.InfoCalls, "\n")
+			expected := common.FormatCreationError("Issue", "Second", 1, issuesPath, stderrors.New("simulated issue creation failure for: Second"))
+			if !createProject {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				output = err.Error()
+			}
+			if !strings.Contains(output, expected) {
+				t.Errorf("Expected failure to contain %q, got: %s", expected, output)
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_NotAddedToProject tests that items created but not added to the project are counted and reported
+func TestHydrateWithOptions_NotAddedToProject(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesPath := filepath.Join(tempDir, "issues.json")
+	if err := os.WriteFile(issuesPath, []byte(`[{"title": "First", "body": "Body"}, {"title": "Second", "body": "Body"}]`), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), issuesPath,
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	client := NewFailingMockGitHubClient(MockConfig{FailProjectItemNodeIDs: map[string]bool{"mock-issue-id-2": true}})
+	logger := &testutil.MockLogger{}
+	report := NewRunReport()
+	options := HydrateOptions{IncludeIssues: true, CreateProject: true, Report: report}
+
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, logger); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	expected := "Warning: 1 items were created but not added to project"
+	output := strings.Join(logger.InfoCalls, "\n")
+	if !strings.Contains(output, expected) || !strings.Contains(output, "issue #2: Second") {
+		t.Errorf("Expected warning %q naming issue #2, got:\n%s", expected, output)
+	}
+
+	var added []bool
+	for _, section := range report.Sections {
+		if section.Name != "Issues" {
+			continue
+		}
+		for _, item := range section.Created {
+			added = append(added, item.AddedToProject)
+		}
+	}
+	if !reflect.DeepEqual(added, []bool{true, false}) {
+		t.Errorf("Expected AddedToProject [true false] in the report, got %v", added)
+	}
+}
+
+// TestHydrateWithOptions_LabelsFailOpen tests the fail-closed default and fail-open policy when labels cannot be listed
+func TestHydrateWithOptions_LabelsFailOpen(t *testing.T) {
+	tests := []struct {
+		name           string
+		failOpen       bool
+		expectError    bool
+		expectedIssues int
+	}{
+		{name: "fail closed by default", failOpen: false, expectError: true, expectedIssues: 0},
+		{name: "fail open continues hydration", failOpen: true, expectError: false, expectedIssues: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			issuesPath := filepath.Join(tempDir, "issues.json")
+			issuesJSON := `[{"title": "Labelled", "body": "Body", "labels": ["bug"]}]`
+			if err := os.WriteFile(issuesPath, []byte(issuesJSON), 0644); err != nil {
+				t.Fatalf("Failed to create issues.json: %v", err)
+			}
+			cfg := createConfigurationFromPaths(context.Background(), issuesPath,
+				filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+			client := NewFailingMockGitHubClient(MockConfig{ListLabels: testutil.ErrorConfig{ShouldError: true}})
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludeIssues: true, LabelsFailOpen: tt.failOpen}
+			err := HydrateWithOptions(context.Background(), client, cfg, options, logger)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "failed to ensure labels exist") {
+					t.Errorf("Expected label error, got: %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Warning: could not ensure labels exist") {
+					t.Errorf("Expected a warning about labels, got: %v", logger.InfoCalls)
+				}
+			}
+			if len(client.CreatedIssues) != tt.expectedIssues {
+				t.Errorf("Expected %d issues created, got %d", tt.expectedIssues, len(client.CreatedIssues))
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_LabelsOnly tests that only the labels defined in labels.json are ensured and no content is created
+func TestHydrateWithOptions_LabelsOnly(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"labels.json":      `[{"name": "bug", "color": "d73a4a"}, {"name": "demo", "color": "ededed"}]`,
+		"issues.json":      `[{"title": "Issue", "body": "Body", "labels": ["referenced"]}]`,
+		"discussions.json": `[{"title": "Discussion", "body": "Body", "category": "General"}]`,
+		"prs.json":         `[{"title": "PR", "body": "Body", "head": "feature", "base": "main"}]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	client := NewSuccessfulMockGitHubClient()
+	options := HydrateOptions{IncludeIssues: true, IncludeDiscussions: true, IncludePullRequests: true, LabelsOnly: true}
+
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(client.CreatedIssues) != 0 || len(client.CreatedDiscussions) != 0 || len(client.CreatedPRs) != 0 {
+		t.Errorf("Expected no content, got %d issues, %d discussions, %d pull requests",
+			len(client.CreatedIssues), len(client.CreatedDiscussions), len(client.CreatedPRs))
+	}
+	if !reflect.DeepEqual(client.CreatedLabels, []string{"bug", "demo"}) {
+		t.Errorf("Expected only the defined labels to be created, got %v", client.CreatedLabels)
+	}
+}
+
+// TestHydrateWithOptions_DiscussionOnlyLabel tests that a label only discussions reference is created before
+// the discussion is created, so that it is applied rather than skipped as missing
+func TestHydrateWithOptions_DiscussionOnlyLabel(t *testing.T) {
+	tempDir := t.TempDir()
+	files := map[string]string{
+		"issues.json":      `[{"title": "Issue", "body": "Body", "labels": ["bug"]}]`,
+		"discussions.json": `[{"title": "Discussion", "body": "Body", "category": "General", "labels": ["brand-new"]}]`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	client := NewSuccessfulMockGitHubClient("bug")
+	options := HydrateOptions{IncludeIssues: true, IncludeDiscussions: true}
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(client.CreatedLabels, []string{"brand-new"}) {
+		t.Errorf("Expected the discussion label to be created, got %v", client.CreatedLabels)
+	}
+	if len(client.CreatedDiscussions) != 1 || !reflect.DeepEqual(client.CreatedDiscussions[0].Labels, []string{"brand-new"}) {
+		t.Errorf("Expected the discussion label to be applied, got %+v", client.CreatedDiscussions)
+	}
+}
+
+// TestFormatSectionSummary tests that the section summary is only styled when color is enabled
+func TestFormatSectionSummary(t *testing.T) {
+	summary := &SectionSummary{Name: "Issues", Total: 3, Success: 2, Failures: 1, Created: []CreatedItem{{Number: 4}, {Number: 5}}}
+
+	tests := []struct {
+		name     string
+		color    bool
+		expected string
+	}{
+		{
+			name:     "plain",
+			expected: "Issues: 3 total, 2 successful, 1 failed (created #4, #5)",
+		},
+		{
+			name:     "colored",
+			color:    true,
+			expected: "\x1b[1mIssues\x1b[0m: 3 total, \x1b[32m2 successful\x1b[0m, \x1b[31m1 failed\x1b[0m (created #4, #5)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := formatSectionSummary(summary, common.Colorizer{Enabled: tt.color}); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestCleanupBeforeHydration_CloseComment tests that the close comment is added before each issue and pull request is closed
+func TestCleanupBeforeHydration_CloseComment(t *testing.T) {
+	tests := []struct {
+		name              string
+		comment           string
+		dryRun            bool
+		config            MockConfig
+		expectedCalls     []string
+		expectedErrors    int
+		expectedLogSubstr string
+	}{
+		{
+			name:              "comment precedes each close",
+			comment:           "Closed by gh-demo cleanup",
+			expectedCalls:     []string{"AddComment I_1", "DeleteIssue I_1", "AddComment PR_1", "DeletePR PR_1"},
+			expectedLogSubstr: "Commenting \"Closed by gh-demo cleanup\" on each issue before closing it",
+		},
+		{
+			name:              "dry run neither comments nor closes",
+			comment:           "Closed by gh-demo cleanup",
+			dryRun:            true,
+			expectedLogSubstr: "Would comment \"Closed by gh-demo cleanup\" on each pull request before closing it",
+		},
+		{
+			name:           "failed comment leaves the item open",
+			comment:        "Closed by gh-demo cleanup",
+			config:         MockConfig{AddComment: testutil.ErrorConfig{ShouldError: true}},
+			expectedCalls:  []string{"AddComment I_1", "AddComment PR_1"},
+			expectedErrors: 2,
+		},
+		{
+			name:          "no comment by default",
+			expectedCalls: []string{"DeleteIssue I_1", "DeletePR PR_1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &mutationRecordingClient{ConfigurableMockGitHubClient: NewFailingMockGitHubClient(tt.config)}
+			client.CreatedIssues = []types.Issue{{NodeID: "I_1", Title: "Old issue"}}
+			client.CreatedPRs = []types.PullRequest{{NodeID: "PR_1", Title: "Old PR"}}
+			logger := &testutil.MockLogger{}
+			options := CleanupOptions{CleanIssues: true, CleanPRs: true, DryRun: tt.dryRun, CloseComment: tt.comment}
+
+			summary, _ := CleanupBeforeHydration(context.Background(), client, options, logger)
+
+			if !reflect.DeepEqual(client.mutations, tt.expectedCalls) {
+				t.Errorf("Expected calls %v, got %v", tt.expectedCalls, client.mutations)
+			}
+			if len(summary.Errors) != tt.expectedErrors {
+				t.Errorf("Expected %d errors, got %v", tt.expectedErrors, summary.Errors)
+			}
+			if tt.expectedLogSubstr != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedLogSubstr) {
+				t.Errorf("Expected %q to be logged, got: %v", tt.expectedLogSubstr, logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestCleanupBeforeHydration_Items tests that the summary lists each deleted and preserved item with its type, title and node ID
+func TestCleanupBeforeHydration_Items(t *testing.T) {
+	preserve := &config.PreserveConfig{}
+	preserve.Issues.PreserveByTitle = []string{"Keep issue"}
+
+	tests := []struct {
+		name              string
+		dryRun            bool
+		config            MockConfig
+		expectedDeleted   []CleanupItem
+		expectedPreserved []CleanupItem
+	}{
+		{
+			name: "deleted and preserved items",
+			expectedDeleted: []CleanupItem{
+				{Type: "issue", Title: "Old issue", NodeID: "I_1"},
+				{Type: "discussion", Title: "Old discussion", NodeID: "D_1"},
+				{Type: "pull_request", Title: "Old PR", NodeID: "PR_1"},
+			},
+			expectedPreserved: []CleanupItem{{Type: "issue", Title: "Keep issue", NodeID: "I_2"}},
+		},
+		{
+			name:   "dry run lists the items that would be deleted",
+			dryRun: true,
+			expectedDeleted: []CleanupItem{
+				{Type: "issue", Title: "Old issue", NodeID: "I_1"},
+				{Type: "discussion", Title: "Old discussion", NodeID: "D_1"},
+				{Type: "pull_request", Title: "Old PR", NodeID: "PR_1"},
+			},
+			expectedPreserved: []CleanupItem{{Type: "issue", Title: "Keep issue", NodeID: "I_2"}},
+		},
+		{
+			name:   "failed deletions are not listed",
+			config: MockConfig{FailDeleteNodeIDs: map[string]bool{"D_1": true}},
+			expectedDeleted: []CleanupItem{
+				{Type: "issue", Title: "Old issue", NodeID: "I_1"},
+				{Type: "pull_request", Title: "Old PR", NodeID: "PR_1"},
+			},
+			expectedPreserved: []CleanupItem{{Type: "issue", Title: "Keep issue", NodeID: "I_2"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.config)
+			client.CreatedIssues = []types.Issue{{NodeID: "I_1", Title: "Old issue"}, {NodeID: "I_2", Title: "Keep issue"}}
+			client.CreatedDiscussions = []types.Discussion{{NodeID: "D_1", Title: "Old discussion"}}
+			client.CreatedPRs = []types.PullRequest{{NodeID: "PR_1", Title: "Old PR"}}
+			options := CleanupOptions{CleanIssues: true, CleanDiscussions: true, CleanPRs: true, DryRun: tt.dryRun, PreserveConfig: preserve}
+
+			summary, _ := CleanupBeforeHydration(context.Background(), client, options, &testutil.MockLogger{})
+
+			if !reflect.DeepEqual(summary.DeletedItems, tt.expectedDeleted) {
+				t.Errorf("Expected deleted items %+v, got %+v", tt.expectedDeleted, summary.DeletedItems)
+			}
+			if !reflect.DeepEqual(summary.PreservedItems, tt.expectedPreserved) {
+				t.Errorf("Expected preserved items %+v, got %+v", tt.expectedPreserved, summary.PreservedItems)
+			}
+		})
+	}
+}
+
+// TestCleanupLabels_Items tests that deleted and preserved labels are listed by name
+func TestCleanupLabels_Items(t *testing.T) {
+	preserve := &config.PreserveConfig{}
+	preserve.Labels.PreserveByName = []string{"keep"}
+	client := NewFailingMockGitHubClient(MockConfig{ExistingLabels: map[string]bool{"keep": true}})
+	summary := &CleanupSummary{}
+
+	cleanupLabels(context.Background(), client, CleanupOptions{PreserveConfig: preserve}, summary, &testutil.MockLogger{})
+	client.Config.ExistingLabels = map[string]bool{"stale": true}
+	cleanupLabels(context.Background(), client, CleanupOptions{PreserveConfig: preserve}, summary, &testutil.MockLogger{})
+
+	if expected := []CleanupItem{{Type: "label", Title: "stale"}}; !reflect.DeepEqual(summary.DeletedItems, expected) {
+		t.Errorf("Expected deleted items %+v, got %+v", expected, summary.DeletedItems)
+	}
+	if expected := []CleanupItem{{Type: "label", Title: "keep"}}; !reflect.DeepEqual(summary.PreservedItems, expected) {
+		t.Errorf("Expected preserved items %+v, got %+v", expected, summary.PreservedItems)
+	}
+}
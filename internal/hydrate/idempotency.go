@@ -0,0 +1,138 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// createFunc creates a single item and returns information about the created item
+type createFunc[T any] func(context.Context, T) (*types.CreatedItemInfo, error)
+
+// findByTitleFunc looks up an existing item by title, returning nil when no item matches
+type findByTitleFunc func(context.Context, string) (*types.CreatedItemInfo, error)
+
+// withTimeoutRecovery wraps a create function so that a timed-out create is not blindly retried.
+// A timeout does not tell us whether the server completed the request, so the repository is
+// re-listed by title first: a match is treated as success, otherwise the create is retried once.
+func withTimeoutRecovery[T any](create createFunc[T], find findByTitleFunc, getTitle func(T) string, itemType string, logger common.Logger) createFunc[T] {
+	return func(ctx context.Context, item T) (*types.CreatedItemInfo, error) {
+		info, err := create(ctx, item)
+		if err == nil || !errors.IsTimeoutError(err) || ctx.Err() != nil {
+			return info, err
+		}
+
+		title := getTitle(item)
+		logger.Debug("Creating %s '%s' timed out, checking whether it was created before retrying", itemType, title)
+
+		existing, findErr := find(ctx, title)
+		if findErr != nil {
+			// Without knowing whether the item exists, retrying could create a duplicate
+			logger.Debug("Could not check for existing %s '%s': %v", itemType, title, findErr)
+			return nil, err
+		}
+		if existing != nil {
+			logger.Info("Found %s '%s' after timeout, treating as created", itemType, title)
+			return existing, nil
+		}
+
+		logger.Debug("%s '%s' was not created, retrying", itemType, title)
+		return create(ctx, item)
+	}
+}
+
+// findIssueByTitle returns the open issue with the given title, if any
+func findIssueByTitle(client githubapi.GitHubClient) findByTitleFunc {
+	return func(ctx context.Context, title string) (*types.CreatedItemInfo, error) {
+		issues, err := client.ListIssues(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if issue.Title == title {
+				return &types.CreatedItemInfo{NodeID: issue.NodeID, Title: issue.Title, Type: "issue", Number: issue.Number}, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// findDiscussionByTitle returns the discussion with the given title, if any
+func findDiscussionByTitle(client githubapi.GitHubClient) findByTitleFunc {
+	return func(ctx context.Context, title string) (*types.CreatedItemInfo, error) {
+		discussions, err := client.ListDiscussions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, discussion := range discussions {
+			if discussion.Title == title {
+				return &types.CreatedItemInfo{NodeID: discussion.NodeID, Title: discussion.Title, Type: "discussion", Number: discussion.Number}, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// findPullRequestByTitle returns the open pull request with the given title, if any
+func findPullRequestByTitle(client githubapi.GitHubClient) findByTitleFunc {
+	return func(ctx context.Context, title string) (*types.CreatedItemInfo, error) {
+		pullRequests, err := client.ListPRs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, pullRequest := range pullRequests {
+			if pullRequest.Title == title {
+				return &types.CreatedItemInfo{NodeID: pullRequest.NodeID, Title: pullRequest.Title, Type: "pull_request", Number: pullRequest.Number}, nil
+			}
+		}
+		return nil, nil
+	}
+}
+
+// skipExistingDiscussions removes the discussions whose title is already used by a discussion in the
+// repository. When a discussion names a category, or defaultCategory supplies one, only discussions
+// in that category count as existing.
+func skipExistingDiscussions(ctx context.Context, client githubapi.GitHubClient, discussions []types.Discussion, defaultCategory string, logger common.Logger) ([]types.Discussion, error) {
+	if len(discussions) == 0 {
+		return discussions, nil
+	}
+
+	existing, err := client.ListDiscussions(ctx)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return nil, err
+		}
+		return nil, errors.APIError("skip_existing_discussions", "failed to list existing discussions", err)
+	}
+
+	remaining := make([]types.Discussion, 0, len(discussions))
+	for _, discussion := range discussions {
+		category := strings.TrimSpace(discussion.Category)
+		if category == "" {
+			category = strings.TrimSpace(defaultCategory)
+		}
+		if match, found := findExistingDiscussion(existing, discussion.Title, category); found {
+			logger.Info("Skipping discussion '%s': it already exists as #%d", discussion.Title, match.Number)
+			continue
+		}
+		remaining = append(remaining, discussion)
+	}
+	return remaining, nil
+}
+
+// findExistingDiscussion returns the discussion with the given title, in category when it is not empty
+func findExistingDiscussion(discussions []types.Discussion, title, category string) (types.Discussion, bool) {
+	for _, discussion := range discussions {
+		if discussion.Title != title {
+			continue
+		}
+		if category == "" || strings.EqualFold(discussion.Category, category) {
+			return discussion, true
+		}
+	}
+	return types.Discussion{}, false
+}
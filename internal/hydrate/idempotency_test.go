@@ -0,0 +1,196 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// timeoutMockGitHubClient simulates a create request that times out on the client side.
+// When serverCompletes is true the server still creates the item, as happens with slow responses.
+type timeoutMockGitHubClient struct {
+	*ConfigurableMockGitHubClient
+	serverCompletes bool
+	createCalls     int
+}
+
+func (m *timeoutMockGitHubClient) CreateIssue(ctx context.Context, issue types.Issue) (*types.CreatedItemInfo, error) {
+	m.createCalls++
+	if m.createCalls == 1 {
+		if m.serverCompletes {
+			issue.NodeID = "server-created-issue"
+			m.CreatedIssues = append(m.CreatedIssues, issue)
+		}
+		return nil, errors.ContextError("create_issue", context.DeadlineExceeded)
+	}
+	return m.ConfigurableMockGitHubClient.CreateIssue(ctx, issue)
+}
+
+// TestCreateIssues_TimeoutRecovery tests that timed-out creates are checked by title before retrying
+func TestCreateIssues_TimeoutRecovery(t *testing.T) {
+	tests := []struct {
+		name                string
+		idempotent          bool
+		serverCompletes     bool
+		expectedCreateCalls int
+		expectedIssues      int
+		expectErrors        bool
+	}{
+		{
+			name:                "item created before timeout is not duplicated",
+			idempotent:          true,
+			serverCompletes:     true,
+			expectedCreateCalls: 1,
+			expectedIssues:      1,
+		},
+		{
+			name:                "item missing after timeout is retried",
+			idempotent:          true,
+			serverCompletes:     false,
+			expectedCreateCalls: 2,
+			expectedIssues:      1,
+		},
+		{
+			name:                "timeout is reported when idempotency is disabled",
+			idempotent:          false,
+			serverCompletes:     true,
+			expectedCreateCalls: 1,
+			expectedIssues:      1,
+			expectErrors:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &timeoutMockGitHubClient{
+				ConfigurableMockGitHubClient: NewSuccessfulMockGitHubClient(),
+				serverCompletes:              tt.serverCompletes,
+			}
+			issues := []types.Issue{{Title: "Slow Issue", Body: "Created during a slow response"}}
+			options := HydrateOptions{IncludeIssues: true, Idempotent: tt.idempotent}
+
+			_, issueErrors, err := createIssues(context.Background(), client, issues, &testutil.MockLogger{}, options)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.expectErrors != (len(issueErrors) > 0) {
+				t.Errorf("Expected errors: %v, got: %v", tt.expectErrors, issueErrors)
+			}
+			if client.createCalls != tt.expectedCreateCalls {
+				t.Errorf("Expected %d create calls, got %d", tt.expectedCreateCalls, client.createCalls)
+			}
+			if len(client.CreatedIssues) != tt.expectedIssues {
+				t.Errorf("Expected %d issues in repository, got %d", tt.expectedIssues, len(client.CreatedIssues))
+			}
+		})
+	}
+}
+
+// TestWithTimeoutRecovery_FindFailure tests that a failed lookup returns the original timeout without retrying
+func TestWithTimeoutRecovery_FindFailure(t *testing.T) {
+	createCalls := 0
+	create := func(ctx context.Context, issue types.Issue) (*types.CreatedItemInfo, error) {
+		createCalls++
+		return nil, errors.ContextError("create_issue", context.DeadlineExceeded)
+	}
+	find := func(ctx context.Context, title string) (*types.CreatedItemInfo, error) {
+		return nil, errors.APIError("list_issues", "failed to list issues", context.Canceled)
+	}
+
+	recovering := withTimeoutRecovery(create, find, func(issue types.Issue) string { return issue.Title }, "issue", &testutil.MockLogger{})
+	_, err := recovering(context.Background(), types.Issue{Title: "Slow Issue"})
+
+	if err == nil || !strings.Contains(err.Error(), "timed out") {
+		t.Errorf("Expected original timeout error, got: %v", err)
+	}
+	if createCalls != 1 {
+		t.Errorf("Expected no retry when lookup fails, got %d create calls", createCalls)
+	}
+}
+
+// TestHydrateWithOptions_SkipExistingDiscussions tests that a discussion whose title already exists is
+// skipped and counted, while a new one is still created
+func TestHydrateWithOptions_SkipExistingDiscussions(t *testing.T) {
+	tempDir := t.TempDir()
+	discussionsJSON := `[
+		{"title": "Welcome", "body": "Body", "category": "General"},
+		{"title": "Roadmap", "body": "Body", "category": "Ideas"},
+		{"title": "Release notes", "body": "Body", "category": "Announcements"}
+	]`
+	if err := os.WriteFile(filepath.Join(tempDir, "discussions.json"), []byte(discussionsJSON), 0644); err != nil {
+		t.Fatalf("Failed to create discussions.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	tests := []struct {
+		name            string
+		skipExisting    bool
+		dryRun          bool
+		expectedCreated []string
+		expectedCounts  DryRunCounts
+	}{
+		{
+			name:            "existing title in the same category is skipped",
+			skipExisting:    true,
+			expectedCreated: []string{"Roadmap", "Release notes"},
+		},
+		{
+			name:           "dry run counts the skipped discussion",
+			skipExisting:   true,
+			dryRun:         true,
+			expectedCounts: DryRunCounts{Type: "discussions", Create: 2, Skip: 1},
+		},
+		{
+			name:            "existing titles are duplicated without skip-existing",
+			expectedCreated: []string{"Welcome", "Roadmap", "Release notes"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			// "Release notes" exists in another category, so only "Welcome" is a duplicate
+			existing := []types.Discussion{
+				{Title: "Welcome", Category: "general", Number: 1},
+				{Title: "Release notes", Category: "General", Number: 2},
+			}
+			client.CreatedDiscussions = append(client.CreatedDiscussions, existing...)
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludeDiscussions: true, SkipExisting: tt.skipExisting, DryRun: tt.dryRun}
+			summary := NewDryRunSummary()
+			if tt.dryRun {
+				options.DryRunSummary = summary
+			}
+
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.dryRun {
+				if len(summary.Counts) < 2 || summary.Counts[1] != tt.expectedCounts {
+					t.Errorf("Expected counts %+v, got %+v", tt.expectedCounts, summary.Counts)
+				}
+				return
+			}
+			var created []string
+			for _, discussion := range client.CreatedDiscussions[len(existing):] {
+				created = append(created, discussion.Title)
+			}
+			if !reflect.DeepEqual(created, tt.expectedCreated) {
+				t.Errorf("Expected created discussions %v, got %v", tt.expectedCreated, created)
+			}
+			if tt.skipExisting && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Skipping discussion 'Welcome': it already exists as #1") {
+				t.Errorf("Expected skipped discussion to be logged, got: %v", logger.InfoCalls)
+			}
+		})
+	}
+}
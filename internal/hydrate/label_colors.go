@@ -0,0 +1,110 @@
+package hydrate
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// labelColorTolerance is the CIE76 color difference below which two label colors are reported as
+// nearly identical. Differences around 2 are barely noticeable side by side; below 10, labels are
+// easily confused at a glance.
+const labelColorTolerance = 10.0
+
+// checkLabelColors warns about labels that share a color or have colors closer than
+// labelColorTolerance, since such labels are hard to tell apart. With strict, the conflicts are
+// returned as an error instead.
+func checkLabelColors(labels []types.Label, strict bool, logger common.Logger) error {
+	conflicts := findLabelColorConflicts(labels, labelColorTolerance)
+	if len(conflicts) == 0 {
+		return nil
+	}
+	if strict {
+		return errors.ValidationError("check_label_colors",
+			fmt.Sprintf("%d label color conflicts: %s", len(conflicts), strings.Join(conflicts, "; ")))
+	}
+	for _, conflict := range conflicts {
+		logger.Info("Warning: %s", conflict)
+	}
+	return nil
+}
+
+// findLabelColorConflicts describes each color shared by several labels, then each pair of distinct
+// colors whose difference is below tolerance. Labels with malformed colors are ignored, since
+// validation reports those separately.
+func findLabelColorConflicts(labels []types.Label, tolerance float64) []string {
+	var colors []string
+	names := make(map[string][]string)
+	for _, label := range labels {
+		color := strings.ToLower(strings.TrimPrefix(label.Color, "#"))
+		if _, ok := hexToLab(color); !ok {
+			continue
+		}
+		if _, found := names[color]; !found {
+			colors = append(colors, color)
+		}
+		names[color] = append(names[color], "'"+label.Name+"'")
+	}
+
+	var conflicts []string
+	for _, color := range colors {
+		if len(names[color]) > 1 {
+			conflicts = append(conflicts, fmt.Sprintf("labels %s share color %s", strings.Join(names[color], ", "), color))
+		}
+	}
+	for i, first := range colors {
+		for _, second := range colors[i+1:] {
+			if distance := colorDistance(first, second); distance < tolerance {
+				conflicts = append(conflicts, fmt.Sprintf("labels %s (%s) and %s (%s) have nearly identical colors",
+					strings.Join(names[first], ", "), first, strings.Join(names[second], ", "), second))
+			}
+		}
+	}
+	return conflicts
+}
+
+// colorDistance returns the CIE76 difference between two six digit hex colors, the Euclidean
+// distance between them in CIELAB space
+func colorDistance(first, second string) float64 {
+	a, _ := hexToLab(first)
+	b, _ := hexToLab(second)
+	return math.Sqrt((a[0]-b[0])*(a[0]-b[0]) + (a[1]-b[1])*(a[1]-b[1]) + (a[2]-b[2])*(a[2]-b[2]))
+}
+
+// hexToLab converts a six digit hex sRGB color to CIELAB under the D65 white point
+func hexToLab(color string) ([3]float64, bool) {
+	if len(color) != 6 {
+		return [3]float64{}, false
+	}
+	value, err := strconv.ParseUint(color, 16, 32)
+	if err != nil {
+		return [3]float64{}, false
+	}
+
+	linear := func(channel uint64) float64 {
+		c := float64(channel) / 255
+		if c <= 0.04045 {
+			return c / 12.92
+		}
+		return math.Pow((c+0.055)/1.055, 2.4)
+	}
+	r, g, b := linear(value>>16&0xff), linear(value>>8&0xff), linear(value&0xff)
+
+	x := (0.4124*r + 0.3576*g + 0.1805*b) / 0.95047
+	y := 0.2126*r + 0.7152*g + 0.0722*b
+	z := (0.0193*r + 0.1192*g + 0.9505*b) / 1.08883
+
+	f := func(t float64) float64 {
+		if t > 216.0/24389 {
+			return math.Cbrt(t)
+		}
+		return (24389.0/27*t + 16) / 116
+	}
+	fx, fy, fz := f(x), f(y), f(z)
+	return [3]float64{116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)}, true
+}
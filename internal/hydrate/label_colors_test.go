@@ -0,0 +1,95 @@
+package hydrate
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestFindLabelColorConflicts tests that shared colors and colors within the tolerance are reported
+func TestFindLabelColorConflicts(t *testing.T) {
+	tests := []struct {
+		name      string
+		labels    []types.Label
+		tolerance float64
+		expected  []string
+	}{
+		{
+			name:      "distinct colors",
+			labels:    []types.Label{{Name: "bug", Color: "d73a4a"}, {Name: "docs", Color: "0075ca"}},
+			tolerance: labelColorTolerance,
+		},
+		{
+			name:      "exact collision ignores case and hash",
+			labels:    []types.Label{{Name: "bug", Color: "D73A4A"}, {Name: "defect", Color: "#d73a4a"}, {Name: "docs", Color: "0075ca"}},
+			tolerance: labelColorTolerance,
+			expected:  []string{"labels 'bug', 'defect' share color d73a4a"},
+		},
+		{
+			name:      "near duplicate within tolerance",
+			labels:    []types.Label{{Name: "bug", Color: "d73a4a"}, {Name: "defect", Color: "d73a4b"}},
+			tolerance: labelColorTolerance,
+			expected:  []string{"labels 'bug' (d73a4a) and 'defect' (d73a4b) have nearly identical colors"},
+		},
+		{
+			name:      "near duplicate outside a smaller tolerance",
+			labels:    []types.Label{{Name: "bug", Color: "d73a4a"}, {Name: "defect", Color: "d0404a"}},
+			tolerance: 1,
+		},
+		{
+			name:      "malformed colors are ignored",
+			labels:    []types.Label{{Name: "bug", Color: "red"}, {Name: "defect", Color: "red"}},
+			tolerance: labelColorTolerance,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conflicts := findLabelColorConflicts(tt.labels, tt.tolerance)
+
+			if !reflect.DeepEqual(conflicts, tt.expected) {
+				t.Errorf("Expected conflicts %v, got %v", tt.expected, conflicts)
+			}
+		})
+	}
+}
+
+// TestCheckLabelColors tests that color conflicts are warned about, or returned as an error under strict
+func TestCheckLabelColors(t *testing.T) {
+	labels := []types.Label{{Name: "bug", Color: "d73a4a"}, {Name: "defect", Color: "d73a4a"}}
+
+	tests := []struct {
+		name          string
+		labels        []types.Label
+		strict        bool
+		expectError   bool
+		expectWarning bool
+	}{
+		{name: "no conflicts", labels: []types.Label{{Name: "bug", Color: "d73a4a"}}},
+		{name: "conflict is a warning", labels: labels, expectWarning: true},
+		{name: "conflict is an error under strict", labels: labels, strict: true, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &testutil.MockLogger{}
+
+			err := checkLabelColors(tt.labels, tt.strict, logger)
+
+			if tt.expectError {
+				if err == nil || !strings.Contains(err.Error(), "1 label color conflicts: labels 'bug', 'defect' share color d73a4a") {
+					t.Errorf("Expected a label color conflict error, got: %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("Unexpected error: %v", err)
+			}
+			warned := strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Warning: labels 'bug', 'defect' share color d73a4a")
+			if warned != tt.expectWarning {
+				t.Errorf("Expected warning logged to be %v, got: %v", tt.expectWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
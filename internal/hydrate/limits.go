@@ -0,0 +1,45 @@
+package hydrate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// checkItemCounts fails before anything is created when more than maxPerType issues, discussions
+// or pull requests would be created. With force, the types over the limit are only warned about.
+// A maxPerType of zero or less disables the check.
+func checkItemCounts(issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, maxPerType int, force bool, logger common.Logger) error {
+	if maxPerType <= 0 {
+		return nil
+	}
+
+	var violations []string
+	for _, count := range []struct {
+		itemType string
+		count    int
+	}{
+		{"issues", len(issues)},
+		{"discussions", len(discussions)},
+		{"pull requests", len(pullRequests)},
+	} {
+		if count.count > maxPerType {
+			violations = append(violations, fmt.Sprintf("%d %s", count.count, count.itemType))
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+	if force {
+		logger.Info("Warning: creating %s, more than the limit of %d per type", strings.Join(violations, ", "), maxPerType)
+		return nil
+	}
+
+	message := fmt.Sprintf("configuration would create %s, more than the limit of %d per type (use --force or raise --max-items-per-type to continue)",
+		strings.Join(violations, ", "), maxPerType)
+	return errors.ValidationError("check_item_counts", message)
+}
@@ -0,0 +1,83 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestHydrateWithOptions_MaxItemsPerType tests that hydration stops before creating anything when a type exceeds the limit, unless forced
+func TestHydrateWithOptions_MaxItemsPerType(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesJSON := `[{"title": "One", "body": "Body"}, {"title": "Two", "body": "Body"}, {"title": "Three", "body": "Body"}]`
+	if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(issuesJSON), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	tests := []struct {
+		name            string
+		maxItems        int
+		force           bool
+		expectError     bool
+		expectedCreated int
+		expectedLog     string
+	}{
+		{
+			name:            "under the limit proceeds",
+			maxItems:        3,
+			expectedCreated: 3,
+		},
+		{
+			name:        "over the limit aborts",
+			maxItems:    2,
+			expectError: true,
+		},
+		{
+			name:            "over the limit with force proceeds",
+			maxItems:        2,
+			force:           true,
+			expectedCreated: 3,
+			expectedLog:     "Warning: creating 3 issues, more than the limit of 2 per type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludeIssues: true, MaxItemsPerType: tt.maxItems, Force: tt.force}
+
+			err := HydrateWithOptions(context.Background(), client, cfg, options, logger)
+
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				for _, expected := range []string{"3 issues", "limit of 2 per type", "--force"} {
+					if !strings.Contains(err.Error(), expected) {
+						t.Errorf("Expected error to contain %q, got: %v", expected, err)
+					}
+				}
+				if len(client.CreatedIssues) != 0 || len(client.CreatedLabels) != 0 {
+					t.Errorf("Expected nothing to be created, got %d issues and %d labels", len(client.CreatedIssues), len(client.CreatedLabels))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(client.CreatedIssues) != tt.expectedCreated {
+				t.Errorf("Expected %d created issues, got %d", tt.expectedCreated, len(client.CreatedIssues))
+			}
+			if tt.expectedLog != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedLog) {
+				t.Errorf("Expected %q to be logged, got: %v", tt.expectedLog, logger.InfoCalls)
+			}
+		})
+	}
+}
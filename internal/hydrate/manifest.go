@@ -0,0 +1,218 @@
+package hydrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// ManifestEntry records one created item
+type ManifestEntry struct {
+	Title  string `json:"title"`
+	Number int    `json:"number,omitempty"`
+	URL    string `json:"url,omitempty"`
+	NodeID string `json:"node_id,omitempty"`
+}
+
+// Manifest records the items created by a run so that an interrupted run can be resumed
+// without creating duplicates. Items are identified by type and title. The names of the labels
+// the run created are recorded too, so that cleanup can delete them without touching labels
+// that existed before, and so is the project the run used, so that a teardown can find it. Only a
+// project marked ProjectCreated is deleted by a teardown; a reused project only has its items removed.
+// It is safe for concurrent use.
+type Manifest struct {
+	Issues         []ManifestEntry `json:"issues"`
+	Discussions    []ManifestEntry `json:"discussions"`
+	PullRequests   []ManifestEntry `json:"pull_requests"`
+	Labels         []string        `json:"labels"`
+	Project        *ManifestEntry  `json:"project,omitempty"`
+	ProjectCreated bool            `json:"project_created,omitempty"`
+
+	mu sync.Mutex
+}
+
+// NewManifest creates an empty manifest
+func NewManifest() *Manifest {
+	return &Manifest{
+		Issues:       []ManifestEntry{},
+		Discussions:  []ManifestEntry{},
+		PullRequests: []ManifestEntry{},
+		Labels:       []string{},
+	}
+}
+
+// LoadManifest reads a manifest written by a previous run
+func LoadManifest(ctx context.Context, path string) (*Manifest, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, errors.ContextError("load_manifest", err)
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		err = errors.FileError("read_manifest", "failed to read manifest", err)
+		return nil, errors.WithContextSafe(err, "path", path)
+	}
+
+	manifest := NewManifest()
+	if err := json.Unmarshal(data, manifest); err != nil {
+		err = errors.FileError("parse_manifest", "failed to parse manifest", err)
+		return nil, errors.WithContextSafe(err, "path", path)
+	}
+	return manifest, nil
+}
+
+// Save writes the manifest to path as indented JSON
+func (m *Manifest) Save(path string) error {
+	m.mu.Lock()
+	data, err := json.MarshalIndent(m, "", "  ")
+	m.mu.Unlock()
+	if err != nil {
+		return errors.FileError("marshal_manifest", "failed to encode manifest", err)
+	}
+
+	if err := os.WriteFile(path, append(data, '\n'), 0o644); err != nil {
+		err = errors.FileError("write_manifest", "failed to write manifest", err)
+		return errors.WithContextSafe(err, "path", path)
+	}
+	return nil
+}
+
+// entries returns the entry list for itemType ("issue", "discussion" or "pull_request")
+func (m *Manifest) entries(itemType string) *[]ManifestEntry {
+	switch itemType {
+	case "issue":
+		return &m.Issues
+	case "discussion":
+		return &m.Discussions
+	case "pull_request":
+		return &m.PullRequests
+	}
+	return nil
+}
+
+// Lookup returns the recorded entry for the item of itemType with the given title, if any
+func (m *Manifest) Lookup(itemType, title string) (ManifestEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := m.entries(itemType)
+	if entries == nil {
+		return ManifestEntry{}, false
+	}
+	for _, entry := range *entries {
+		if entry.Title == title {
+			return entry, true
+		}
+	}
+	return ManifestEntry{}, false
+}
+
+// Record adds a created item to the manifest
+func (m *Manifest) Record(info *types.CreatedItemInfo) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entries := m.entries(info.Type)
+	if entries == nil {
+		return
+	}
+	*entries = append(*entries, ManifestEntry{
+		Title:  info.Title,
+		Number: info.Number,
+		URL:    info.URL,
+		NodeID: info.NodeID,
+	})
+}
+
+// RecordLabel adds the name of a created label to the manifest
+func (m *Manifest) RecordLabel(name string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, label := range m.Labels {
+		if label == name {
+			return
+		}
+	}
+	m.Labels = append(m.Labels, name)
+}
+
+// RecordProject records the project used by the run and whether the run created it. A resumed run
+// reuses the project an earlier run created, so the project stays marked as created. It is safe to
+// call on a nil manifest.
+func (m *Manifest) RecordProject(project *types.ProjectV2, created bool) {
+	if m == nil || project == nil {
+		return
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.ProjectCreated = created || (m.ProjectCreated && m.Project != nil && m.Project.NodeID == project.ID)
+	m.Project = &ManifestEntry{Title: project.Title, Number: project.Number, URL: project.URL, NodeID: project.ID}
+}
+
+// HasLabel reports whether the manifest records the label as created by a run
+func (m *Manifest) HasLabel(name string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, label := range m.Labels {
+		if label == name {
+			return true
+		}
+	}
+	return false
+}
+
+// withManifestRecording wraps a create function so that every created item is recorded in manifest
+func withManifestRecording[T any](create createFunc[T], manifest *Manifest) createFunc[T] {
+	if manifest == nil {
+		return create
+	}
+	return func(ctx context.Context, item T) (*types.CreatedItemInfo, error) {
+		info, err := create(ctx, item)
+		if err == nil && info != nil {
+			manifest.Record(info)
+		}
+		return info, err
+	}
+}
+
+// skipRecorded returns the items whose titles are not yet recorded in manifest, logging each skipped item
+func skipRecorded[T any](items []T, manifest *Manifest, itemType string, getTitle func(T) string, logger common.Logger) []T {
+	if manifest == nil || len(items) == 0 {
+		return items
+	}
+
+	remaining := make([]T, 0, len(items))
+	for _, item := range items {
+		title := getTitle(item)
+		if entry, found := manifest.Lookup(itemType, title); found {
+			logger.Info("Skipping %s '%s': already created in a previous run%s", describeManifestType(itemType), title, describeManifestNumber(entry))
+			continue
+		}
+		remaining = append(remaining, item)
+	}
+	return remaining
+}
+
+// describeManifestType converts a manifest item type to the form used in log messages
+func describeManifestType(itemType string) string {
+	if itemType == "pull_request" {
+		return "pull request"
+	}
+	return itemType
+}
+
+// describeManifestNumber formats the recorded number of an entry, e.g. " (#12)"
+func describeManifestNumber(entry ManifestEntry) string {
+	if entry.Number > 0 {
+		return fmt.Sprintf(" (#%d)", entry.Number)
+	}
+	return ""
+}
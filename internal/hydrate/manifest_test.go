@@ -0,0 +1,160 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestHydrateWithOptions_ResumeFromManifest tests that items recorded in a manifest are skipped
+// and the remaining items are created and recorded
+func TestHydrateWithOptions_ResumeFromManifest(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesJSON := `[
+		{"title": "First", "body": "Body"},
+		{"title": "Second", "body": "Body"},
+		{"title": "Third", "body": "Body"}
+	]`
+	prsJSON := `[{"title": "Only PR", "body": "Body", "head": "feature", "base": "main"}]`
+	for name, content := range map[string]string{"issues.json": issuesJSON, "prs.json": prsJSON} {
+		if err := os.WriteFile(filepath.Join(tempDir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to create %s: %v", name, err)
+		}
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	manifestPath := filepath.Join(tempDir, "manifest.json")
+	previous := NewManifest()
+	previous.Issues = []ManifestEntry{{Title: "Second", Number: 7}}
+	previous.PullRequests = []ManifestEntry{{Title: "Only PR", Number: 8}}
+	if err := previous.Save(manifestPath); err != nil {
+		t.Fatalf("Failed to save manifest: %v", err)
+	}
+
+	manifest, err := LoadManifest(context.Background(), manifestPath)
+	if err != nil {
+		t.Fatalf("Failed to load manifest: %v", err)
+	}
+
+	client := NewSuccessfulMockGitHubClient()
+	logger := &testutil.MockLogger{}
+	options := HydrateOptions{IncludeIssues: true, IncludePullRequests: true, Manifest: manifest}
+
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, logger); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var created []string
+	for _, issue := range client.CreatedIssues {
+		created = append(created, issue.Title)
+	}
+	if strings.Join(created, ",") != "First,Third" {
+		t.Errorf("Expected only First and Third to be created, got %v", created)
+	}
+	if len(client.CreatedPRs) != 0 {
+		t.Errorf("Expected recorded pull request to be skipped, got %d created", len(client.CreatedPRs))
+	}
+	if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Skipping issue 'Second': already created in a previous run (#7)") {
+		t.Errorf("Expected skipped issue to be logged, got: %v", logger.InfoCalls)
+	}
+
+	var recorded []string
+	for _, entry := range manifest.Issues {
+		recorded = append(recorded, entry.Title)
+	}
+	if strings.Join(recorded, ",") != "Second,First,Third" {
+		t.Errorf("Expected manifest to record all issues, got %v", recorded)
+	}
+}
+
+// TestLoadManifest tests loading manifests from disk
+func TestLoadManifest(t *testing.T) {
+	tempDir := t.TempDir()
+
+	if _, err := LoadManifest(context.Background(), filepath.Join(tempDir, "missing.json")); err == nil {
+		t.Error("Expected error for missing manifest")
+	}
+
+	invalidPath := filepath.Join(tempDir, "invalid.json")
+	if err := os.WriteFile(invalidPath, []byte("not json"), 0644); err != nil {
+		t.Fatalf("Failed to write file: %v", err)
+	}
+	if _, err := LoadManifest(context.Background(), invalidPath); err == nil {
+		t.Error("Expected error for invalid manifest")
+	}
+}
+
+// TestManifest_LabelCleanupScopedToCreatedLabels tests that the labels a run creates are recorded
+// and that cleanup with the manifest deletes only those, preserving labels that existed before
+func TestManifest_LabelCleanupScopedToCreatedLabels(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesJSON := `[{"title": "Bug", "body": "Body", "labels": ["bug", "demo"]}]`
+	if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(issuesJSON), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	client := NewSuccessfulMockGitHubClient("bug", "documentation")
+	manifest := NewManifest()
+	options := HydrateOptions{IncludeIssues: true, Manifest: manifest}
+
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Join(manifest.Labels, ",") != "demo" {
+		t.Fatalf("Expected only the created label to be recorded, got %v", manifest.Labels)
+	}
+
+	summary, err := CleanupBeforeHydration(context.Background(), client, CleanupOptions{CleanLabels: true, LabelManifest: manifest}, &testutil.MockLogger{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if summary.LabelsDeleted != 1 {
+		t.Errorf("Expected 1 label deleted, got %d", summary.LabelsDeleted)
+	}
+	for _, label := range []string{"bug", "documentation"} {
+		if !client.Config.ExistingLabels[label] {
+			t.Errorf("Expected pre-existing label '%s' to be preserved", label)
+		}
+	}
+	if client.Config.ExistingLabels["demo"] {
+		t.Error("Expected recorded label 'demo' to be deleted")
+	}
+}
+
+// TestManifest_RecordProject tests that the manifest marks a project as created only when a run
+// created it, and keeps the mark when a resumed run reuses that project
+func TestManifest_RecordProject(t *testing.T) {
+	tests := []struct {
+		name            string
+		previous        *types.ProjectV2
+		previousCreated bool
+		project         *types.ProjectV2
+		created         bool
+		expected        bool
+	}{
+		{name: "reused project", project: &types.ProjectV2{ID: "project-1"}},
+		{name: "created project", project: &types.ProjectV2{ID: "project-1"}, created: true, expected: true},
+		{name: "resumed run reuses the created project", previous: &types.ProjectV2{ID: "project-1"}, previousCreated: true, project: &types.ProjectV2{ID: "project-1"}, expected: true},
+		{name: "resumed run reuses another project", previous: &types.ProjectV2{ID: "project-1"}, previousCreated: true, project: &types.ProjectV2{ID: "project-2"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manifest := NewManifest()
+			manifest.RecordProject(tt.previous, tt.previousCreated)
+			manifest.RecordProject(tt.project, tt.created)
+
+			if manifest.ProjectCreated != tt.expected {
+				t.Errorf("Expected project created %v, got %v", tt.expected, manifest.ProjectCreated)
+			}
+		})
+	}
+}
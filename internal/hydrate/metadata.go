@@ -0,0 +1,148 @@
+package hydrate
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// metadataMarker starts the metadata footer. It is an HTML comment, so it is not rendered, and lets
+// a body that already carries a footer be recognised so the footer is replaced rather than doubled.
+const metadataMarker = "<!-- gh-demo:metadata -->"
+
+// markerLabelDescription is the description of a marker label that labels.json does not define
+const markerLabelDescription = "Content created by gh-demo"
+
+// RunMetadata identifies the hydration run that created an item
+type RunMetadata struct {
+	RunID     string
+	Timestamp time.Time
+
+	// template renders the visible part of the footer; nil uses the built-in footer
+	template *template.Template
+}
+
+// NewRunMetadata returns the metadata for a run with the given ID started at timestamp
+func NewRunMetadata(runID string, timestamp time.Time) *RunMetadata {
+	return &RunMetadata{RunID: runID, Timestamp: timestamp.UTC()}
+}
+
+// Date returns the day the run started, e.g. "2025-01-01", for use in metadata templates
+func (m *RunMetadata) Date() string {
+	return m.Timestamp.Format(time.DateOnly)
+}
+
+// SetTemplate renders the visible part of the footer with the Go text/template text, which can use
+// {{.RunID}}, {{.Date}} and {{.Timestamp}}. The template is rendered once here so that mistakes are
+// reported before anything is created. An empty text keeps the built-in footer.
+func (m *RunMetadata) SetTemplate(text string) error {
+	if strings.TrimSpace(text) == "" {
+		m.template = nil
+		return nil
+	}
+
+	tmpl, err := template.New("metadata").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return errors.ConfigError("parse_metadata_template", "failed to parse metadata template", err)
+	}
+	if err := tmpl.Execute(&strings.Builder{}, m); err != nil {
+		return errors.ConfigError("render_metadata_template", "failed to render metadata template", err)
+	}
+	m.template = tmpl
+	return nil
+}
+
+// Footer returns the footer appended to bodies, e.g.
+// "<!-- gh-demo:metadata -->\n---\n<sub>Generated by gh-demo · run req_42 · 2025-01-01T12:00:00Z</sub>".
+// With a template, the rendered template follows the hidden marker instead.
+func (m *RunMetadata) Footer() string {
+	if m.template != nil {
+		var footer strings.Builder
+		if err := m.template.Execute(&footer, m); err == nil {
+			return metadataMarker + "\n" + footer.String()
+		}
+	}
+	return fmt.Sprintf("%s\n---\n<sub>Generated by gh-demo · run %s · %s</sub>",
+		metadataMarker, m.RunID, m.Timestamp.Format(time.RFC3339))
+}
+
+// withMetadataFooter appends footer to body after a blank line, first removing any footer an
+// earlier run appended
+func withMetadataFooter(body, footer string) string {
+	if index := strings.Index(body, metadataMarker); index >= 0 {
+		body = body[:index]
+	}
+	body = strings.TrimRight(body, "\n")
+	if body == "" {
+		return footer
+	}
+	return body + "\n\n" + footer
+}
+
+// applyMarkerLabel adds label to every item, so that the content a run created can be found by it.
+// An empty label changes nothing.
+func applyMarkerLabel(label string, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) {
+	if label == "" {
+		return
+	}
+
+	for i := range issues {
+		issues[i].Labels = mergeLabels(issues[i].Labels, []string{label})
+	}
+	for i := range discussions {
+		discussions[i].Labels = mergeLabels(discussions[i].Labels, []string{label})
+	}
+	for i := range pullRequests {
+		pullRequests[i].Labels = mergeLabels(pullRequests[i].Labels, []string{label})
+	}
+}
+
+// withMarkerLabel returns labels with the marker label added when labels does not already define it,
+// so that it is ensured even when auto-generated labels are disabled
+func withMarkerLabel(labels []types.Label, markerLabel string) []types.Label {
+	if markerLabel == "" {
+		return labels
+	}
+	for _, label := range labels {
+		if strings.EqualFold(label.Name, markerLabel) {
+			return labels
+		}
+	}
+
+	marker := types.Label{Name: markerLabel, Description: markerLabelDescription, Color: config.DefaultLabelColor}
+	return append(append(make([]types.Label, 0, len(labels)+1), labels...), marker)
+}
+
+// HasLabel reports whether labels contains name. Names are compared without regard to case, as
+// GitHub compares label names, so items match the marker label however it is written.
+func HasLabel(labels []string, name string) bool {
+	for _, label := range labels {
+		if strings.EqualFold(label, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// appendMetadata appends the run's metadata footer to the body of every item. A nil metadata changes nothing.
+func appendMetadata(metadata *RunMetadata, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) {
+	if metadata == nil {
+		return
+	}
+
+	footer := metadata.Footer()
+	for i := range issues {
+		issues[i].Body = withMetadataFooter(issues[i].Body, footer)
+	}
+	for i := range discussions {
+		discussions[i].Body = withMetadataFooter(discussions[i].Body, footer)
+	}
+	for i := range pullRequests {
+		pullRequests[i].Body = withMetadataFooter(pullRequests[i].Body, footer)
+	}
+}
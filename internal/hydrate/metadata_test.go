@@ -0,0 +1,160 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestRunMetadata_Footer tests the format of the metadata footer
+func TestRunMetadata_Footer(t *testing.T) {
+	timestamp := time.Date(2025, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600))
+	footer := NewRunMetadata("req_42", timestamp).Footer()
+
+	expected := "<!-- gh-demo:metadata -->\n---\n<sub>Generated by gh-demo · run req_42 · 2025-01-02T02:04:05Z</sub>"
+	if footer != expected {
+		t.Errorf("Expected footer %q, got %q", expected, footer)
+	}
+}
+
+// TestWithMetadataFooter tests that the footer is appended once and replaces an earlier run's footer
+func TestWithMetadataFooter(t *testing.T) {
+	first := NewRunMetadata("run-1", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).Footer()
+	second := NewRunMetadata("run-2", time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)).Footer()
+
+	tests := []struct {
+		name     string
+		body     string
+		footer   string
+		expected string
+	}{
+		{name: "appended after a blank line", body: "Body text\n", footer: first, expected: "Body text\n\n" + first},
+		{name: "empty body gets only the footer", body: "", footer: first, expected: first},
+		{name: "same footer is not doubled", body: "Body text\n\n" + first, footer: first, expected: "Body text\n\n" + first},
+		{name: "earlier footer is replaced", body: "Body text\n\n" + first, footer: second, expected: "Body text\n\n" + second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := withMetadataFooter(tt.body, tt.footer); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_AppendMetadata tests that created bodies carry the footer only when requested
+func TestHydrateWithOptions_AppendMetadata(t *testing.T) {
+	metadata := NewRunMetadata("req_7", time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	for _, tt := range []struct {
+		name     string
+		metadata *RunMetadata
+	}{{name: "without metadata"}, {name: "with metadata", metadata: metadata}} {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			issuesPath := filepath.Join(tempDir, "issues.json")
+			if err := os.WriteFile(issuesPath, []byte(`[{"title": "Issue", "body": "Body"}]`), 0644); err != nil {
+				t.Fatalf("Failed to create issues.json: %v", err)
+			}
+			cfg := createConfigurationFromPaths(context.Background(), issuesPath,
+				filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+			client := NewSuccessfulMockGitHubClient()
+			options := HydrateOptions{IncludeIssues: true, Metadata: tt.metadata}
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			body := client.CreatedIssues[0].Body
+			hasFooter := strings.HasSuffix(body, "run req_7 · 2025-06-01T12:00:00Z</sub>")
+			if hasFooter != (tt.metadata != nil) {
+				t.Errorf("Expected footer %v, got body %q", tt.metadata != nil, body)
+			}
+			if !strings.HasPrefix(body, "Body") {
+				t.Errorf("Expected original body to be kept, got %q", body)
+			}
+		})
+	}
+}
+
+// TestRunMetadata_SetTemplate tests that a custom template renders the footer after the hidden marker and that mistakes are reported
+func TestRunMetadata_SetTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		template    string
+		expected    string
+		expectError string
+	}{
+		{
+			name:     "custom template rendered",
+			template: "_Seeded by the demo team on {{.Date}} ({{.RunID}})_",
+			expected: "<!-- gh-demo:metadata -->\n_Seeded by the demo team on 2025-01-02 (req_42)_",
+		},
+		{
+			name:     "empty template keeps the built-in footer",
+			template: "  ",
+			expected: "<!-- gh-demo:metadata -->\n---\n<sub>Generated by gh-demo · run req_42 · 2025-01-02T03:04:05Z</sub>",
+		},
+		{
+			name:        "unparsable template",
+			template:    "{{.RunID",
+			expectError: "failed to parse metadata template",
+		},
+		{
+			name:        "unknown field",
+			template:    "{{.Author}}",
+			expectError: "failed to render metadata template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			metadata := NewRunMetadata("req_42", time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+
+			err := metadata.SetTemplate(tt.template)
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if footer := metadata.Footer(); footer != tt.expected {
+				t.Errorf("Expected footer %q, got %q", tt.expected, footer)
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_MarkerLabel tests that a custom marker label is ensured and added to created content
+func TestHydrateWithOptions_MarkerLabel(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesPath := filepath.Join(tempDir, "issues.json")
+	if err := os.WriteFile(issuesPath, []byte(`[{"title": "Issue", "body": "Body", "labels": ["bug"]}]`), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), issuesPath,
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	client := NewFailingMockGitHubClient(MockConfig{ExistingLabels: map[string]bool{"bug": true}})
+	options := HydrateOptions{IncludeIssues: true, MarkerLabel: "demo-content", NoAutoLabels: true}
+	if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(client.CreatedLabels, []string{"demo-content"}) {
+		t.Errorf("Expected marker label to be ensured, got created labels %v", client.CreatedLabels)
+	}
+	if labels := client.CreatedIssues[0].Labels; !reflect.DeepEqual(labels, []string{"bug", "demo-content"}) {
+		t.Errorf("Expected marker label to be added, got labels %v", labels)
+	}
+}
@@ -0,0 +1,88 @@
+package hydrate
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// Plan is the content a dry run would create. It is built only from the configuration, never from
+// the state of the repository, and every collection is sorted, so two dry runs of an unchanged
+// configuration serialize to byte-identical JSON that can be reviewed with diff.
+type Plan struct {
+	Labels       []types.Label       `json:"labels"`
+	Issues       []types.Issue       `json:"issues"`
+	Discussions  []types.Discussion  `json:"discussions"`
+	PullRequests []types.PullRequest `json:"pull_requests"`
+}
+
+// NewPlan returns an empty plan
+func NewPlan() *Plan {
+	return &Plan{
+		Labels:       []types.Label{},
+		Issues:       []types.Issue{},
+		Discussions:  []types.Discussion{},
+		PullRequests: []types.PullRequest{},
+	}
+}
+
+// recordLabels records the labels the run would ensure exist. It is safe to call on a nil plan.
+func (p *Plan) recordLabels(labels []types.Label) {
+	if p == nil {
+		return
+	}
+	p.Labels = append([]types.Label{}, labels...)
+	sort.Slice(p.Labels, func(i, j int) bool { return p.Labels[i].Name < p.Labels[j].Name })
+}
+
+// recordContent records the items the run would create, with their labels and assignees sorted.
+// It is safe to call on a nil plan.
+func (p *Plan) recordContent(issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) {
+	if p == nil {
+		return
+	}
+
+	p.Issues = make([]types.Issue, 0, len(issues))
+	for _, issue := range issues {
+		issue.Labels = sortedCopy(issue.Labels)
+		issue.Assignees = sortedCopy(issue.Assignees)
+		p.Issues = append(p.Issues, issue)
+	}
+	sort.SliceStable(p.Issues, func(i, j int) bool { return p.Issues[i].Title < p.Issues[j].Title })
+
+	p.Discussions = make([]types.Discussion, 0, len(discussions))
+	for _, discussion := range discussions {
+		discussion.Labels = sortedCopy(discussion.Labels)
+		p.Discussions = append(p.Discussions, discussion)
+	}
+	sort.SliceStable(p.Discussions, func(i, j int) bool { return p.Discussions[i].Title < p.Discussions[j].Title })
+
+	p.PullRequests = make([]types.PullRequest, 0, len(pullRequests))
+	for _, pullRequest := range pullRequests {
+		pullRequest.Labels = sortedCopy(pullRequest.Labels)
+		pullRequest.Assignees = sortedCopy(pullRequest.Assignees)
+		p.PullRequests = append(p.PullRequests, pullRequest)
+	}
+	sort.SliceStable(p.PullRequests, func(i, j int) bool { return p.PullRequests[i].Title < p.PullRequests[j].Title })
+}
+
+// JSON serializes the plan as indented JSON ending in a newline
+func (p *Plan) JSON() ([]byte, error) {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return nil, errors.FileError("marshal_plan", "failed to encode dry-run plan", err)
+	}
+	return append(data, '\n'), nil
+}
+
+// sortedCopy returns a sorted copy of values, leaving values itself unchanged
+func sortedCopy(values []string) []string {
+	if values == nil {
+		return nil
+	}
+	sorted := append([]string{}, values...)
+	sort.Strings(sorted)
+	return sorted
+}
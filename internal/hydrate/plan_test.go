@@ -0,0 +1,81 @@
+package hydrate
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// dryRunPlan runs a dry run of issuesJSON and returns the serialized plan
+func dryRunPlan(t *testing.T, issuesJSON string) []byte {
+	t.Helper()
+
+	tempDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(issuesJSON), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	plan := NewPlan()
+	options := HydrateOptions{IncludeIssues: true, DryRun: true, Plan: plan}
+	if err := HydrateWithOptions(context.Background(), NewSuccessfulMockGitHubClient(), cfg, options, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	data, err := plan.JSON()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	return data
+}
+
+// TestPlan_Deterministic tests that dry runs of the same configuration produce identical plans
+func TestPlan_Deterministic(t *testing.T) {
+	issuesJSON := `[
+		{"title": "Zeta", "body": "Body", "labels": ["ui", "bug"], "assignees": ["octocat", "hubot"]},
+		{"title": "Alpha", "body": "Body", "labels": ["docs"]}
+	]`
+	reorderedJSON := `[
+		{"title": "Alpha", "body": "Body", "labels": ["docs"]},
+		{"title": "Zeta", "body": "Body", "labels": ["bug", "ui"], "assignees": ["hubot", "octocat"]}
+	]`
+
+	first := dryRunPlan(t, issuesJSON)
+	second := dryRunPlan(t, issuesJSON)
+	if !bytes.Equal(first, second) {
+		t.Errorf("Expected identical plans, got:\n%s\n---\n%s", first, second)
+	}
+
+	reordered := dryRunPlan(t, reorderedJSON)
+	if !bytes.Equal(first, reordered) {
+		t.Errorf("Expected reordering the configuration not to change the plan, got:\n%s\n---\n%s", first, reordered)
+	}
+
+	plan := string(first)
+	if strings.Index(plan, `"Alpha"`) > strings.Index(plan, `"Zeta"`) {
+		t.Errorf("Expected issues sorted by title, got:\n%s", plan)
+	}
+	if strings.Index(plan, `"name": "bug"`) > strings.Index(plan, `"name": "docs"`) {
+		t.Errorf("Expected labels sorted by name, got:\n%s", plan)
+	}
+	if strings.Contains(plan, "issues.json") {
+		t.Errorf("Expected the plan not to depend on where the configuration lives, got:\n%s", plan)
+	}
+	if !strings.Contains(plan, config.DefaultLabelDescription) {
+		t.Errorf("Expected auto-generated labels to be planned, got:\n%s", plan)
+	}
+}
+
+// TestPlan_NilIsIgnored tests that recording into a nil plan is a no-op
+func TestPlan_NilIsIgnored(t *testing.T) {
+	var plan *Plan
+	plan.recordLabels(nil)
+	plan.recordContent(nil, nil, nil)
+}
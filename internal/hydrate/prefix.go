@@ -0,0 +1,43 @@
+package hydrate
+
+import (
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// applyTitlePrefix prepends prefix to the title of every item, and to the titles issues reference as
+// their original, their dependencies or their epic children, so that demo content is easy to recognise
+// and to clean up. Titles that already carry the prefix are left unchanged, and an empty prefix changes nothing.
+func applyTitlePrefix(prefix string, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) {
+	if prefix == "" {
+		return
+	}
+
+	for i := range issues {
+		issues[i].Title = withTitlePrefix(prefix, issues[i].Title)
+		if issues[i].DuplicateOfTitle != "" {
+			issues[i].DuplicateOfTitle = withTitlePrefix(prefix, issues[i].DuplicateOfTitle)
+		}
+		for j := range issues[i].DependsOnTitles {
+			issues[i].DependsOnTitles[j] = withTitlePrefix(prefix, issues[i].DependsOnTitles[j])
+		}
+		for j := range issues[i].EpicChildrenTitles {
+			issues[i].EpicChildrenTitles[j] = withTitlePrefix(prefix, issues[i].EpicChildrenTitles[j])
+		}
+	}
+	for i := range discussions {
+		discussions[i].Title = withTitlePrefix(prefix, discussions[i].Title)
+	}
+	for i := range pullRequests {
+		pullRequests[i].Title = withTitlePrefix(prefix, pullRequests[i].Title)
+	}
+}
+
+// withTitlePrefix returns title with prefix prepended unless it already starts with it
+func withTitlePrefix(prefix, title string) string {
+	if strings.HasPrefix(title, prefix) {
+		return title
+	}
+	return prefix + title
+}
@@ -0,0 +1,130 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestHydrateWithOptions_TitlePrefix tests that created titles carry the title prefix
+func TestHydrateWithOptions_TitlePrefix(t *testing.T) {
+	tests := []struct {
+		name           string
+		issuesJSON     string
+		prefix         string
+		expectedTitles []string
+	}{
+		{
+			name:           "prefix is prepended",
+			issuesJSON:     `[{"title": "First", "body": "Body"}, {"title": "Second", "body": "Body"}]`,
+			prefix:         "[DEMO] ",
+			expectedTitles: []string{"[DEMO] First", "[DEMO] Second"},
+		},
+		{
+			name:           "title already carrying the prefix is unchanged",
+			issuesJSON:     `[{"title": "[DEMO] First", "body": "Body"}, {"title": "Second", "body": "Body"}]`,
+			prefix:         "[DEMO] ",
+			expectedTitles: []string{"[DEMO] First", "[DEMO] Second"},
+		},
+		{
+			name:           "empty prefix preserves titles",
+			issuesJSON:     `[{"title": "First", "body": "Body"}, {"title": "Second", "body": "Body"}]`,
+			expectedTitles: []string{"First", "Second"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(tt.issuesJSON), 0644); err != nil {
+				t.Fatalf("Failed to create issues.json: %v", err)
+			}
+			cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+				filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+			client := NewSuccessfulMockGitHubClient()
+			options := HydrateOptions{IncludeIssues: true, TitlePrefix: tt.prefix}
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(client.CreatedIssues) != len(tt.expectedTitles) {
+				t.Fatalf("Expected %d issues, got %d", len(tt.expectedTitles), len(client.CreatedIssues))
+			}
+			for i, expected := range tt.expectedTitles {
+				if client.CreatedIssues[i].Title != expected {
+					t.Errorf("Expected title %q, got %q", expected, client.CreatedIssues[i].Title)
+				}
+			}
+		})
+	}
+}
+
+// TestApplyTitlePrefix_DuplicateOf tests that duplicate references follow the prefixed titles
+func TestApplyTitlePrefix_DuplicateOf(t *testing.T) {
+	issues := []types.Issue{{Title: "Login fails"}, {Title: "Cannot sign in", DuplicateOfTitle: "Login fails"}}
+	discussions := []types.Discussion{{Title: "Welcome"}}
+	pullRequests := []types.PullRequest{{Title: "Add feature"}}
+
+	applyTitlePrefix("[DEMO] ", issues, discussions, pullRequests)
+
+	if issues[1].DuplicateOfTitle != "[DEMO] Login fails" {
+		t.Errorf("Expected duplicate reference to be prefixed, got %q", issues[1].DuplicateOfTitle)
+	}
+	if discussions[0].Title != "[DEMO] Welcome" || pullRequests[0].Title != "[DEMO] Add feature" {
+		t.Errorf("Expected discussion and pull request titles to be prefixed, got %q and %q", discussions[0].Title, pullRequests[0].Title)
+	}
+}
+
+// TestCleanupBeforeHydration_TitlePrefix tests that cleanup only deletes items carrying the title prefix
+func TestCleanupBeforeHydration_TitlePrefix(t *testing.T) {
+	tests := []struct {
+		name              string
+		prefix            string
+		expectedDeleted   int
+		expectedRemaining []string
+	}{
+		{
+			name:              "only prefixed items are deleted",
+			prefix:            "[DEMO] ",
+			expectedDeleted:   1,
+			expectedRemaining: []string{"Real issue"},
+		},
+		{
+			name:            "empty prefix deletes everything",
+			expectedDeleted: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			client.CreatedIssues = []types.Issue{
+				{NodeID: "I_1", Title: "[DEMO] Demo issue"},
+				{NodeID: "I_2", Title: "Real issue"},
+			}
+
+			options := CleanupOptions{CleanIssues: true, TitlePrefix: tt.prefix}
+			summary, err := CleanupBeforeHydration(context.Background(), client, options, &testutil.MockLogger{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if summary.IssuesDeleted != tt.expectedDeleted {
+				t.Errorf("Expected %d issues deleted, got %d", tt.expectedDeleted, summary.IssuesDeleted)
+			}
+			if len(client.CreatedIssues) != len(tt.expectedRemaining) {
+				t.Fatalf("Expected remaining issues %v, got %v", tt.expectedRemaining, client.CreatedIssues)
+			}
+			for i, title := range tt.expectedRemaining {
+				if client.CreatedIssues[i].Title != title {
+					t.Errorf("Expected remaining issue %q, got %q", title, client.CreatedIssues[i].Title)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package hydrate
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 
 	"github.com/chrisreddington/gh-demo/internal/config"
@@ -21,24 +22,34 @@ func checkPreservationByID(nodeID string, preserveByID []string) bool {
 
 // checkPreservationByTitle checks if an item should be preserved based on its title patterns
 func checkPreservationByTitle(ctx context.Context, title string, preserveByTitle []string) bool {
+	return findMatchingTitlePattern(ctx, title, preserveByTitle) != ""
+}
+
+// findMatchingTitlePattern returns the first preserve pattern matching the title, or an empty string
+func findMatchingTitlePattern(ctx context.Context, title string, preserveByTitle []string) string {
 	for _, pattern := range preserveByTitle {
 		if isMatchOrRegex(ctx, title, pattern) {
-			return true
+			return pattern
 		}
 	}
-	return false
+	return ""
 }
 
 // checkPreservationByLabels checks if an item should be preserved based on its labels
 func checkPreservationByLabels(itemLabels []string, preserveByLabel []string) bool {
+	return findMatchingLabel(itemLabels, preserveByLabel) != ""
+}
+
+// findMatchingLabel returns the first item label that appears in the preserve list, or an empty string
+func findMatchingLabel(itemLabels []string, preserveByLabel []string) string {
 	for _, preserveLabel := range preserveByLabel {
 		for _, itemLabel := range itemLabels {
 			if itemLabel == preserveLabel {
-				return true
+				return itemLabel
 			}
 		}
 	}
-	return false
+	return ""
 }
 
 // checkPreservationByCategory checks if a discussion should be preserved based on its category
@@ -63,28 +74,76 @@ func checkPreservationByName(name string, preserveByName []string) bool {
 
 // ShouldPreserveIssue checks if an issue should be preserved based on the configuration.
 func ShouldPreserveIssue(ctx context.Context, preserveConfig *config.PreserveConfig, issue types.Issue) bool {
-	return checkPreservationByID(issue.NodeID, preserveConfig.Issues.PreserveByID) ||
-		checkPreservationByTitle(ctx, issue.Title, preserveConfig.Issues.PreserveByTitle) ||
-		checkPreservationByLabels(issue.Labels, preserveConfig.Issues.PreserveByLabel)
+	return IssuePreservationReason(ctx, preserveConfig, issue) != ""
 }
 
 // ShouldPreserveDiscussion checks if a discussion should be preserved based on the configuration.
 func ShouldPreserveDiscussion(ctx context.Context, preserveConfig *config.PreserveConfig, discussion types.Discussion) bool {
-	return checkPreservationByID(discussion.NodeID, preserveConfig.Discussions.PreserveByID) ||
-		checkPreservationByTitle(ctx, discussion.Title, preserveConfig.Discussions.PreserveByTitle) ||
-		checkPreservationByCategory(discussion.Category, preserveConfig.Discussions.PreserveByCategory)
+	return DiscussionPreservationReason(ctx, preserveConfig, discussion) != ""
 }
 
 // ShouldPreservePR checks if a pull request should be preserved based on the configuration.
 func ShouldPreservePR(ctx context.Context, preserveConfig *config.PreserveConfig, pullRequest types.PullRequest) bool {
-	return checkPreservationByID(pullRequest.NodeID, preserveConfig.PullRequests.PreserveByID) ||
-		checkPreservationByTitle(ctx, pullRequest.Title, preserveConfig.PullRequests.PreserveByTitle) ||
-		checkPreservationByLabels(pullRequest.Labels, preserveConfig.PullRequests.PreserveByLabel)
+	return PRPreservationReason(ctx, preserveConfig, pullRequest) != ""
 }
 
 // ShouldPreserveLabel checks if a label should be preserved based on the configuration.
 func ShouldPreserveLabel(ctx context.Context, preserveConfig *config.PreserveConfig, labelName string) bool {
-	return checkPreservationByName(labelName, preserveConfig.Labels.PreserveByName)
+	return LabelPreservationReason(ctx, preserveConfig, labelName) != ""
+}
+
+// IssuePreservationReason describes which preserve rule matched an issue.
+// It returns an empty string when the issue is not preserved.
+func IssuePreservationReason(ctx context.Context, preserveConfig *config.PreserveConfig, issue types.Issue) string {
+	if checkPreservationByID(issue.NodeID, preserveConfig.Issues.PreserveByID) {
+		return fmt.Sprintf("id '%s'", issue.NodeID)
+	}
+	if pattern := findMatchingTitlePattern(ctx, issue.Title, preserveConfig.Issues.PreserveByTitle); pattern != "" {
+		return fmt.Sprintf("title matches '%s'", pattern)
+	}
+	if label := findMatchingLabel(issue.Labels, preserveConfig.Issues.PreserveByLabel); label != "" {
+		return fmt.Sprintf("label '%s'", label)
+	}
+	return ""
+}
+
+// DiscussionPreservationReason describes which preserve rule matched a discussion.
+// It returns an empty string when the discussion is not preserved.
+func DiscussionPreservationReason(ctx context.Context, preserveConfig *config.PreserveConfig, discussion types.Discussion) string {
+	if checkPreservationByID(discussion.NodeID, preserveConfig.Discussions.PreserveByID) {
+		return fmt.Sprintf("id '%s'", discussion.NodeID)
+	}
+	if pattern := findMatchingTitlePattern(ctx, discussion.Title, preserveConfig.Discussions.PreserveByTitle); pattern != "" {
+		return fmt.Sprintf("title matches '%s'", pattern)
+	}
+	if checkPreservationByCategory(discussion.Category, preserveConfig.Discussions.PreserveByCategory) {
+		return fmt.Sprintf("category '%s'", discussion.Category)
+	}
+	return ""
+}
+
+// PRPreservationReason describes which preserve rule matched a pull request.
+// It returns an empty string when the pull request is not preserved.
+func PRPreservationReason(ctx context.Context, preserveConfig *config.PreserveConfig, pullRequest types.PullRequest) string {
+	if checkPreservationByID(pullRequest.NodeID, preserveConfig.PullRequests.PreserveByID) {
+		return fmt.Sprintf("id '%s'", pullRequest.NodeID)
+	}
+	if pattern := findMatchingTitlePattern(ctx, pullRequest.Title, preserveConfig.PullRequests.PreserveByTitle); pattern != "" {
+		return fmt.Sprintf("title matches '%s'", pattern)
+	}
+	if label := findMatchingLabel(pullRequest.Labels, preserveConfig.PullRequests.PreserveByLabel); label != "" {
+		return fmt.Sprintf("label '%s'", label)
+	}
+	return ""
+}
+
+// LabelPreservationReason describes which preserve rule matched a label.
+// It returns an empty string when the label is not preserved.
+func LabelPreservationReason(ctx context.Context, preserveConfig *config.PreserveConfig, labelName string) string {
+	if checkPreservationByName(labelName, preserveConfig.Labels.PreserveByName) {
+		return fmt.Sprintf("name '%s'", labelName)
+	}
+	return ""
 }
 
 // isMatchOrRegex checks if a string matches either exactly or as a regex pattern.
@@ -572,3 +572,59 @@ func TestPreservePredicateFunctions(t *testing.T) {
 		}
 	})
 }
+
+// TestPreservationReasons tests that each preserve rule produces a descriptive reason
+func TestPreservationReasons(t *testing.T) {
+	ctx := context.Background()
+	preserveConfig := &config.PreserveConfig{}
+	preserveConfig.Issues.PreserveByID = []string{"I_pinned"}
+	preserveConfig.Issues.PreserveByLabel = []string{"keep"}
+	preserveConfig.Discussions.PreserveByCategory = []string{"Announcements"}
+	preserveConfig.PullRequests.PreserveByTitle = []string{"^feat:.*"}
+	preserveConfig.Labels.PreserveByName = []string{"bug"}
+
+	tests := []struct {
+		name     string
+		reason   string
+		expected string
+	}{
+		{
+			name:     "issue preserved by id",
+			reason:   IssuePreservationReason(ctx, preserveConfig, types.Issue{NodeID: "I_pinned", Title: "Anything"}),
+			expected: "id 'I_pinned'",
+		},
+		{
+			name:     "issue preserved by label",
+			reason:   IssuePreservationReason(ctx, preserveConfig, types.Issue{Title: "Anything", Labels: []string{"other", "keep"}}),
+			expected: "label 'keep'",
+		},
+		{
+			name:     "issue not preserved",
+			reason:   IssuePreservationReason(ctx, preserveConfig, types.Issue{Title: "Anything"}),
+			expected: "",
+		},
+		{
+			name:     "discussion preserved by category",
+			reason:   DiscussionPreservationReason(ctx, preserveConfig, types.Discussion{Title: "News", Category: "Announcements"}),
+			expected: "category 'Announcements'",
+		},
+		{
+			name:     "pull request preserved by title pattern",
+			reason:   PRPreservationReason(ctx, preserveConfig, types.PullRequest{Title: "feat: add login"}),
+			expected: "title matches '^feat:.*'",
+		},
+		{
+			name:     "label preserved by name",
+			reason:   LabelPreservationReason(ctx, preserveConfig, "bug"),
+			expected: "name 'bug'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.reason != tt.expected {
+				t.Errorf("Expected reason %q, got %q", tt.expected, tt.reason)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// EnsureProject finds the ProjectV2 titled projectConfig.Title or creates it, then reconciles it with
+// the configuration: missing fields are created, missing single select options are added and the
+// description is updated when it differs. Repeated runs therefore converge on one project instead of
+// creating duplicates. Reconciliation failures are logged as warnings since the project itself is usable.
+func EnsureProject(ctx context.Context, client githubapi.GitHubClient, projectConfig types.ProjectV2Configuration, logger common.Logger) (*types.ProjectV2, error) {
+	project, _, err := ensureProject(ctx, client, projectConfig, logger)
+	return project, err
+}
+
+// ensureProject is EnsureProject, also reporting whether the project was created rather than reused
+func ensureProject(ctx context.Context, client githubapi.GitHubClient, projectConfig types.ProjectV2Configuration, logger common.Logger) (*types.ProjectV2, bool, error) {
+	project, err := client.FindProjectV2ByTitle(ctx, projectConfig.Title)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return nil, false, err
+		}
+		return nil, false, errors.ProjectError("find_project", "failed to look up existing ProjectV2", err)
+	}
+
+	created := false
+	if project != nil {
+		logger.Info("Reusing existing ProjectV2 '%s' (Number: %d, URL: %s)", project.Title, project.Number, project.URL)
+	} else {
+		logger.Info("Creating ProjectV2 '%s'", projectConfig.Title)
+
+		project, err = client.CreateProjectV2(ctx, projectConfig)
+		if err != nil {
+			// Check for permission errors and provide helpful guidance
+			if layeredErr := errors.AsLayeredError(err); layeredErr != nil && errors.IsLayer(err, "project") && layeredErr.Context["type"] == "permission" {
+				logger.Info("Failed to create project due to insufficient permissions")
+				logger.Info("Ensure your GitHub token has 'write:org' or 'write:user' scope")
+				return nil, false, err
+			}
+			return nil, false, errors.ProjectError("create_project", "failed to create ProjectV2", err)
+		}
+		created = true
+
+		logger.Info("Successfully created ProjectV2 '%s' (Number: %d, URL: %s)",
+			project.Title, project.Number, project.URL)
+	}
+
+	if err := reconcileProject(ctx, client, project, projectConfig, created, logger); err != nil {
+		if errors.IsContextError(err) {
+			return nil, false, err
+		}
+		logger.Info("Warning: Failed to configure some project settings: %v", err)
+	}
+
+	return project, created, nil
+}
+
+// reconcileProject brings the fields and description of project in line with projectConfig.
+// A newly created project has no custom fields, so its field list is not fetched.
+func reconcileProject(ctx context.Context, client githubapi.GitHubClient, project *types.ProjectV2, projectConfig types.ProjectV2Configuration, created bool, logger common.Logger) error {
+	errorCollector := errors.NewErrorCollector("reconcile_project")
+
+	description := strings.TrimSpace(projectConfig.Description)
+	if description != "" && description != project.Description {
+		if err := client.UpdateProjectV2Description(ctx, project.ID, description); err != nil {
+			errorCollector.Add(errors.ProjectError("update_project_description", "failed to update project description", err))
+		} else {
+			logger.Info("Updated description of ProjectV2 '%s'", project.Title)
+			project.Description = description
+		}
+	}
+
+	if len(projectConfig.Fields) > 0 {
+		var existingFields []types.ProjectV2Field
+		if !created {
+			var err error
+			existingFields, err = client.ListProjectV2Fields(ctx, project.ID)
+			if err != nil {
+				errorCollector.Add(errors.ProjectError("list_project_fields", "failed to list project fields", err))
+				return errorCollector.Result()
+			}
+		}
+		if err := reconcileProjectFields(ctx, client, project.ID, projectConfig.Fields, existingFields, logger); err != nil {
+			errorCollector.Add(err)
+		}
+	}
+
+	// Note: Views are not currently supported by GitHub's GraphQL API
+	// They can only be created through the web interface
+	if len(projectConfig.Views) > 0 {
+		logger.Info("Note: Custom project views cannot be created via API - please configure them manually in the GitHub web interface")
+	}
+
+	return errorCollector.Result()
+}
+
+// reconcileProjectFields creates configured fields that do not exist yet and adds missing options
+// to existing single select fields. Fields and options are matched by name, case-insensitively,
+// and nothing is removed.
+func reconcileProjectFields(ctx context.Context, client githubapi.GitHubClient, projectID string, configured, existing []types.ProjectV2Field, logger common.Logger) error {
+	errorCollector := errors.NewErrorCollector("reconcile_project_fields")
+
+	existingByName := make(map[string]types.ProjectV2Field, len(existing))
+	for _, field := range existing {
+		existingByName[strings.ToLower(field.Name)] = field
+	}
+
+	var missing []types.ProjectV2Field
+	for _, field := range configured {
+		current, found := existingByName[strings.ToLower(field.Name)]
+		if !found {
+			missing = append(missing, field)
+			continue
+		}
+
+		if field.Type != "single_select" || current.Type != "single_select" {
+			logger.Debug("Project field '%s' already exists", field.Name)
+			continue
+		}
+
+		options := missingOptions(current.Options, field.Options)
+		if len(options) == 0 {
+			logger.Debug("Project field '%s' already has all configured options", field.Name)
+			continue
+		}
+
+		// GitHub replaces the whole option list, so keep the existing options first
+		merged := append(append([]types.ProjectV2FieldOption{}, current.Options...), options...)
+		if err := client.UpdateProjectV2FieldOptions(ctx, current.ID, merged); err != nil {
+			err = errors.ProjectError("update_project_field", "failed to add options to project field", err)
+			errorCollector.Add(errors.WithContextSafe(err, "field_name", field.Name))
+			continue
+		}
+		logger.Info("Added %d options to project field '%s'", len(options), field.Name)
+	}
+
+	if len(missing) > 0 {
+		logger.Info("Creating %d custom fields for project", len(missing))
+		if err := client.ConfigureProjectV2Fields(ctx, projectID, missing); err != nil {
+			errorCollector.Add(errors.ProjectError("configure_project_fields", "failed to configure custom fields", err))
+		} else {
+			logger.Info("Successfully configured all custom fields")
+		}
+	}
+
+	return errorCollector.Result()
+}
+
+// missingOptions returns the configured options whose names are not among the existing options
+func missingOptions(existing, configured []types.ProjectV2FieldOption) []types.ProjectV2FieldOption {
+	names := make(map[string]struct{}, len(existing))
+	for _, option := range existing {
+		names[strings.ToLower(option.Name)] = struct{}{}
+	}
+
+	var missing []types.ProjectV2FieldOption
+	for _, option := range configured {
+		if _, found := names[strings.ToLower(option.Name)]; !found {
+			missing = append(missing, option)
+		}
+	}
+	return missing
+}
@@ -0,0 +1,180 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// testProjectConfig returns a project configuration with one text and one single select field
+func testProjectConfig(description string, statusOptions ...string) types.ProjectV2Configuration {
+	var options []types.ProjectV2FieldOption
+	for _, name := range statusOptions {
+		options = append(options, types.ProjectV2FieldOption{Name: name})
+	}
+	return types.ProjectV2Configuration{
+		Title:       "Demo Board",
+		Description: description,
+		Fields: []types.ProjectV2Field{
+			{Name: "Notes", Type: "text"},
+			{Name: "Status", Type: "single_select", Options: options},
+		},
+	}
+}
+
+// TestEnsureProject tests that repeated runs reuse and reconcile the project instead of duplicating it
+func TestEnsureProject(t *testing.T) {
+	t.Run("first run creates project and fields", func(t *testing.T) {
+		client := NewSuccessfulMockGitHubClient()
+
+		project, err := EnsureProject(context.Background(), client, testProjectConfig("Demo", "Todo", "Done"), &testutil.MockLogger{})
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		if len(client.Projects) != 1 {
+			t.Fatalf("Expected 1 project created, got %d", len(client.Projects))
+		}
+		if project.ID != client.Projects[0].ID {
+			t.Errorf("Expected created project to be returned, got %s", project.ID)
+		}
+		if fields := client.ProjectFields[project.ID]; len(fields) != 2 {
+			t.Errorf("Expected 2 fields created, got %v", fields)
+		}
+		if client.DescriptionUpdates != 1 || client.Projects[0].Description != "Demo" {
+			t.Errorf("Expected description to be set once, got %d updates (%q)", client.DescriptionUpdates, client.Projects[0].Description)
+		}
+	})
+
+	t.Run("second run reuses project and reconciles fields", func(t *testing.T) {
+		client := NewSuccessfulMockGitHubClient()
+		logger := &testutil.MockLogger{}
+
+		first, err := EnsureProject(context.Background(), client, testProjectConfig("Demo", "Todo"), logger)
+		if err != nil {
+			t.Fatalf("Unexpected error on first run: %v", err)
+		}
+
+		updatedConfig := testProjectConfig("Demo", "Todo", "In Progress")
+		updatedConfig.Fields = append(updatedConfig.Fields, types.ProjectV2Field{Name: "Estimate", Type: "number"})
+
+		second, err := EnsureProject(context.Background(), client, updatedConfig, logger)
+		if err != nil {
+			t.Fatalf("Unexpected error on second run: %v", err)
+		}
+
+		if len(client.Projects) != 1 {
+			t.Errorf("Expected project to be reused, got %d projects", len(client.Projects))
+		}
+		if second.ID != first.ID {
+			t.Errorf("Expected project %s to be reused, got %s", first.ID, second.ID)
+		}
+
+		fields := client.ProjectFields[first.ID]
+		if len(fields) != 3 {
+			t.Fatalf("Expected only the missing field to be added (3 total), got %v", fields)
+		}
+		var statusOptions []string
+		for _, option := range fields[1].Options {
+			statusOptions = append(statusOptions, option.Name)
+		}
+		if strings.Join(statusOptions, ",") != "Todo,In Progress" {
+			t.Errorf("Expected existing option kept and missing option added, got %v", statusOptions)
+		}
+		if client.DescriptionUpdates != 1 {
+			t.Errorf("Expected unchanged description not to be updated again, got %d updates", client.DescriptionUpdates)
+		}
+		if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Reusing existing ProjectV2 'Demo Board'") {
+			t.Errorf("Expected reuse to be logged, got: %v", logger.InfoCalls)
+		}
+	})
+
+	t.Run("changed description is updated", func(t *testing.T) {
+		client := NewSuccessfulMockGitHubClient()
+
+		if _, err := EnsureProject(context.Background(), client, testProjectConfig("Old description", "Todo"), &testutil.MockLogger{}); err != nil {
+			t.Fatalf("Unexpected error on first run: %v", err)
+		}
+		if _, err := EnsureProject(context.Background(), client, testProjectConfig("New description", "Todo"), &testutil.MockLogger{}); err != nil {
+			t.Fatalf("Unexpected error on second run: %v", err)
+		}
+
+		if client.DescriptionUpdates != 2 {
+			t.Errorf("Expected 2 description updates, got %d", client.DescriptionUpdates)
+		}
+		if got := client.Projects[0].Description; got != "New description" {
+			t.Errorf("Expected description %q, got %q", "New description", got)
+		}
+	})
+
+	t.Run("project creation failure is returned", func(t *testing.T) {
+		client := NewFailingMockGitHubClient(MockConfig{FailProjectCreation: true})
+
+		if _, err := EnsureProject(context.Background(), client, testProjectConfig(""), &testutil.MockLogger{}); err == nil {
+			t.Fatal("Expected error but got none")
+		}
+	})
+}
+
+// TestCleanupBeforeHydration_ClearProjectItems tests clearing a project board while keeping the project
+func TestCleanupBeforeHydration_ClearProjectItems(t *testing.T) {
+	tests := []struct {
+		name               string
+		dryRun             bool
+		clearError         bool
+		expectError        bool
+		expectedRemaining  int
+		expectedLogMessage string
+	}{
+		{
+			name:               "removes every item",
+			expectedRemaining:  0,
+			expectedLogMessage: "Removed all items from project 'Demo Board'",
+		},
+		{
+			name:               "dry-run only reports the items",
+			dryRun:             true,
+			expectedRemaining:  2,
+			expectedLogMessage: "Would remove 2 items from project 'Demo Board'",
+		},
+		{
+			name:              "removal error is collected",
+			clearError:        true,
+			expectError:       true,
+			expectedRemaining: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(MockConfig{
+				ClearProjectItems: testutil.ErrorConfig{ShouldError: tt.clearError},
+			})
+			client.Projects = []types.ProjectV2{{ID: "PVT_1", Title: "Demo Board"}}
+			client.ProjectItems = map[string][]string{"PVT_1": {"issue-1", "pr-1"}}
+			logger := &testutil.MockLogger{}
+
+			options := CleanupOptions{ClearProjectItems: true, ProjectTitle: "Demo Board", DryRun: tt.dryRun}
+			_, err := CleanupBeforeHydration(context.Background(), client, options, logger)
+
+			if tt.expectError && err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if !tt.expectError && err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if remaining := len(client.ProjectItems["PVT_1"]); remaining != tt.expectedRemaining {
+				t.Errorf("Expected %d items to remain, got %d", tt.expectedRemaining, remaining)
+			}
+			if len(client.Projects) != 1 {
+				t.Errorf("Expected the project to be kept, got %v", client.Projects)
+			}
+			if tt.expectedLogMessage != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedLogMessage) {
+				t.Errorf("Expected log containing %q, got: %v", tt.expectedLogMessage, logger.InfoCalls)
+			}
+		})
+	}
+}
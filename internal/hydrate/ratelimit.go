@@ -0,0 +1,86 @@
+package hydrate
+
+import (
+	"context"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// now returns the current time. Tests replace it, along with sleep, to simulate a rate limit reset.
+var now = time.Now
+
+// rateLimitProgressInterval is how often a rate limit wait logs the time that remains
+const rateLimitProgressInterval = time.Minute
+
+// withRateLimitWait wraps a create function so that a create rejected by GitHub's primary rate limit
+// sleeps until the limit resets and is then retried once, rather than failing. GitHub rejects a
+// rate-limited request before running it, so the retry cannot create a duplicate. A reset further
+// away than maxWait, or a secondary rate limit, fails the create as before.
+func withRateLimitWait[T any](create createFunc[T], client githubapi.GitHubClient, maxWait time.Duration, logger common.Logger) createFunc[T] {
+	if maxWait <= 0 {
+		return create
+	}
+
+	return func(ctx context.Context, item T) (*types.CreatedItemInfo, error) {
+		info, err := create(ctx, item)
+		if err == nil || !githubapi.IsRateLimitError(err) {
+			return info, err
+		}
+
+		wait, found := rateLimitReset(ctx, client, maxWait, logger)
+		if !found {
+			return info, err
+		}
+		if waitErr := waitForRateLimitReset(ctx, wait, logger); waitErr != nil {
+			return nil, waitErr
+		}
+		return create(ctx, item)
+	}
+}
+
+// rateLimitReset returns how long until the exhausted primary rate limit resets. It reports false
+// when the limit is not exhausted, since a secondary rate limit does not say when it lifts, when the
+// rate limit cannot be read, or when the reset is further away than maxWait.
+func rateLimitReset(ctx context.Context, client githubapi.GitHubClient, maxWait time.Duration, logger common.Logger) (time.Duration, bool) {
+	rateLimit, err := client.GetRateLimit(ctx)
+	if err != nil {
+		logger.Debug("Could not read the rate limit to wait for its reset: %v", err)
+		return 0, false
+	}
+	if rateLimit.Remaining > 0 {
+		logger.Debug("Rate limited with %d points remaining, so not waiting for the primary rate limit to reset", rateLimit.Remaining)
+		return 0, false
+	}
+
+	wait := rateLimit.ResetAt.Sub(now())
+	if wait < 0 {
+		wait = 0
+	}
+	if wait > maxWait {
+		logger.Info("Warning: the rate limit resets at %s, more than %s away, so not waiting for it", rateLimit.ResetAt.Format(time.RFC3339), maxWait)
+		return 0, false
+	}
+	return wait, true
+}
+
+// waitForRateLimitReset sleeps for wait, logging the time that remains every rateLimitProgressInterval
+// so that an unattended run shows it is still alive
+func waitForRateLimitReset(ctx context.Context, wait time.Duration, logger common.Logger) error {
+	logger.Info("Rate limit exhausted, waiting %s for it to reset", wait.Round(time.Second))
+	for remaining := wait; remaining > 0; {
+		step := min(remaining, rateLimitProgressInterval)
+		if err := sleep(ctx, step); err != nil {
+			return errors.ContextError("wait_for_rate_limit", err)
+		}
+		remaining -= step
+		if remaining > 0 {
+			logger.Info("Still waiting for the rate limit to reset, %s remaining", remaining.Round(time.Second))
+		}
+	}
+	logger.Info("Rate limit reset, resuming")
+	return nil
+}
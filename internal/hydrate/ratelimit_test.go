@@ -0,0 +1,114 @@
+package hydrate
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+	"github.com/cli/go-gh/v2/pkg/api"
+)
+
+// TestWithRateLimitWait tests that a create rejected by the exhausted rate limit waits for the reset and resumes
+func TestWithRateLimitWait(t *testing.T) {
+	start := time.Date(2024, 1, 2, 15, 0, 0, 0, time.UTC)
+	rateLimited := errors.APIError("create_issue", "failed to create issue",
+		&api.GraphQLError{Errors: []api.GraphQLErrorItem{{Type: "RATE_LIMITED", Message: "API rate limit exceeded"}}})
+
+	tests := []struct {
+		name             string
+		maxWait          time.Duration
+		rateLimit        *types.RateLimit
+		firstErr         error
+		expectError      bool
+		expectedEvents   []string
+		expectedProgress string
+	}{
+		{
+			name:             "resumes after the reset",
+			maxWait:          time.Hour,
+			rateLimit:        &types.RateLimit{Limit: 5000, ResetAt: start.Add(150 * time.Second)},
+			firstErr:         rateLimited,
+			expectedEvents:   []string{"create A", "sleep 1m0s", "sleep 1m0s", "sleep 30s", "create A"},
+			expectedProgress: "Still waiting for the rate limit to reset, 30s remaining",
+		},
+		{
+			name:             "reset already passed",
+			maxWait:          time.Hour,
+			rateLimit:        &types.RateLimit{Limit: 5000, ResetAt: start.Add(-time.Second)},
+			firstErr:         rateLimited,
+			expectedEvents:   []string{"create A", "create A"},
+			expectedProgress: "Rate limit reset, resuming",
+		},
+		{
+			name:             "reset beyond the maximum wait",
+			maxWait:          time.Minute,
+			rateLimit:        &types.RateLimit{Limit: 5000, ResetAt: start.Add(time.Hour)},
+			firstErr:         rateLimited,
+			expectError:      true,
+			expectedEvents:   []string{"create A"},
+			expectedProgress: "more than 1m0s away, so not waiting for it",
+		},
+		{
+			name:           "secondary rate limit is not waited for",
+			maxWait:        time.Hour,
+			rateLimit:      &types.RateLimit{Limit: 5000, Remaining: 4000, ResetAt: start.Add(time.Minute)},
+			firstErr:       rateLimited,
+			expectError:    true,
+			expectedEvents: []string{"create A"},
+		},
+		{
+			name:           "other errors are returned",
+			maxWait:        time.Hour,
+			rateLimit:      &types.RateLimit{Limit: 5000, ResetAt: start.Add(time.Minute)},
+			firstErr:       errors.APIError("create_issue", "failed to create issue", nil),
+			expectError:    true,
+			expectedEvents: []string{"create A"},
+		},
+		{
+			name:           "disabled without a maximum wait",
+			rateLimit:      &types.RateLimit{Limit: 5000, ResetAt: start.Add(time.Minute)},
+			firstErr:       rateLimited,
+			expectError:    true,
+			expectedEvents: []string{"create A"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var events []string
+			recordSleeps(t, &events)
+			originalNow := now
+			now = func() time.Time { return start }
+			t.Cleanup(func() { now = originalNow })
+
+			client := NewFailingMockGitHubClient(MockConfig{RateLimit: tt.rateLimit})
+			logger := &testutil.MockLogger{}
+			calls := 0
+			create := withRateLimitWait(func(ctx context.Context, issue types.Issue) (*types.CreatedItemInfo, error) {
+				events = append(events, "create "+issue.Title)
+				calls++
+				if calls == 1 {
+					return nil, tt.firstErr
+				}
+				return &types.CreatedItemInfo{Title: issue.Title}, nil
+			}, client, tt.maxWait, logger)
+
+			_, err := create(context.Background(), types.Issue{Title: "A"})
+
+			if tt.expectError != (err != nil) {
+				t.Errorf("Expected error %v, got: %v", tt.expectError, err)
+			}
+			if !reflect.DeepEqual(events, tt.expectedEvents) {
+				t.Errorf("Expected events %v, got %v", tt.expectedEvents, events)
+			}
+			if tt.expectedProgress != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedProgress) {
+				t.Errorf("Expected log to contain %q, got: %v", tt.expectedProgress, logger.InfoCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+package hydrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// labelsHTTPClient fetches remote labels files; tests replace it with the client of an httptest server
+var labelsHTTPClient = http.DefaultClient
+
+// readLabels reads the label definitions from labelsPath, which is either a local file or an
+// https:// URL of a shared labels file. A URL is fetched within timeout, or config.APITimeout when
+// timeout is zero.
+func readLabels(ctx context.Context, labelsPath string, timeout time.Duration) ([]types.Label, error) {
+	if !strings.Contains(labelsPath, "://") {
+		return ReadLabelsJSON(ctx, labelsPath)
+	}
+	if timeout <= 0 {
+		timeout = config.APITimeout
+	}
+	return fetchLabelsJSON(ctx, labelsPath, timeout)
+}
+
+// fetchLabelsJSON downloads and parses a labels file with a single GET request. Only https URLs are
+// accepted, so a shared label set cannot be tampered with in transit.
+func fetchLabelsJSON(ctx context.Context, labelsURL string, timeout time.Duration) ([]types.Label, error) {
+	parsed, err := url.Parse(labelsURL)
+	if err != nil || parsed.Scheme != "https" || parsed.Host == "" {
+		return nil, errors.ValidationError("fetch_labels", fmt.Sprintf("labels URL '%s' must be an https:// URL", labelsURL))
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	request, err := http.NewRequestWithContext(fetchCtx, http.MethodGet, labelsURL, nil)
+	if err != nil {
+		return nil, errors.APIError("fetch_labels", "failed to build labels request", err)
+	}
+	response, err := labelsHTTPClient.Do(request)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return nil, errors.ContextError("fetch_labels", err)
+		}
+		return nil, errors.APIError("fetch_labels", fmt.Sprintf("failed to fetch labels from %s", labelsURL), err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, errors.APIError("fetch_labels", fmt.Sprintf("fetching labels from %s returned %s", labelsURL, response.Status), nil)
+	}
+	content, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, errors.APIError("fetch_labels", fmt.Sprintf("failed to read labels from %s", labelsURL), err)
+	}
+
+	var labels []types.Label
+	if err := json.Unmarshal(content, &labels); err != nil {
+		err = errors.WrapWithOperation(err, "file", "parse_labels", "invalid JSON in labels file")
+		return nil, errors.WithContextSafe(err, "url", labelsURL)
+	}
+	return labels, nil
+}
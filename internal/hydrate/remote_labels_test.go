@@ -0,0 +1,103 @@
+package hydrate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestReadLabels_URL tests that labels are fetched from an https URL and that failed fetches are reported
+func TestReadLabels_URL(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/labels.json":
+			w.Write([]byte(`[{"name": "bug", "color": "d73a4a"}, {"name": "triage", "color": "fbca04", "description": "Needs triage"}]`))
+		case "/invalid.json":
+			w.Write([]byte(`not json`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+	original := labelsHTTPClient
+	labelsHTTPClient = server.Client()
+	defer func() { labelsHTTPClient = original }()
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedLabels []string
+		expectError    string
+	}{
+		{
+			name:           "labels are fetched from the URL",
+			path:           server.URL + "/labels.json",
+			expectedLabels: []string{"bug", "triage"},
+		},
+		{
+			name:        "non-200 response",
+			path:        server.URL + "/missing.json",
+			expectError: "returned 404 Not Found",
+		},
+		{
+			name:        "invalid JSON",
+			path:        server.URL + "/invalid.json",
+			expectError: "invalid JSON in labels file",
+		},
+		{
+			name:        "only https is allowed",
+			path:        strings.Replace(server.URL, "https://", "http://", 1) + "/labels.json",
+			expectError: "must be an https:// URL",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels, err := readLabels(context.Background(), tt.path, 0)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var names []string
+			for _, label := range labels {
+				names = append(names, label.Name)
+			}
+			if !reflect.DeepEqual(names, tt.expectedLabels) {
+				t.Errorf("Expected labels %v, got %v", tt.expectedLabels, names)
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_LabelsURL tests that labels from a shared labels URL are ensured like those from a local file
+func TestHydrateWithOptions_LabelsURL(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`[{"name": "shared", "color": "0e8a16"}]`))
+	}))
+	defer server.Close()
+	original := labelsHTTPClient
+	labelsHTTPClient = server.Client()
+	defer func() { labelsHTTPClient = original }()
+
+	cfg := createConfigurationFromPaths(context.Background(), "issues.json", "discussions.json", "prs.json")
+	cfg.LabelsPath = server.URL + "/labels.json"
+	client := NewSuccessfulMockGitHubClient()
+
+	if err := HydrateWithOptions(context.Background(), client, cfg, HydrateOptions{LabelsOnly: true}, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(client.CreatedLabels, []string{"shared"}) {
+		t.Errorf("Expected the shared label to be created, got %v", client.CreatedLabels)
+	}
+}
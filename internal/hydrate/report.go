@@ -0,0 +1,163 @@
+package hydrate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// RunReport collects the per-section results of a hydration run so they can be reported outside
+// the logs, for example as a GitHub Actions job summary. A nil RunReport ignores every section.
+type RunReport struct {
+	mu        sync.Mutex
+	Sections  []SectionSummary
+	ProjectID string // Node ID of the project created for the run, if any
+}
+
+// NewRunReport returns an empty report
+func NewRunReport() *RunReport {
+	return &RunReport{}
+}
+
+// AddSection records the results of one section. It is safe to call on a nil report.
+func (r *RunReport) AddSection(summary SectionSummary) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Sections = append(r.Sections, summary)
+}
+
+// RecordProject records the project created for the run. It is safe to call on a nil report.
+func (r *RunReport) RecordProject(project *types.ProjectV2) {
+	if r == nil || project == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.ProjectID = project.ID
+}
+
+// RecordProjectAssociation copies whether each item was added to the project onto the items the
+// report recorded as created. It is safe to call on a nil report.
+func (r *RunReport) RecordProjectAssociation(items []CreatedItem) {
+	if r == nil {
+		return
+	}
+	added := make(map[string]bool, len(items))
+	for _, item := range items {
+		added[item.NodeID] = item.AddedToProject
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for i := range r.Sections {
+		for j := range r.Sections[i].Created {
+			r.Sections[i].Created[j].AddedToProject = added[r.Sections[i].Created[j].NodeID]
+		}
+	}
+}
+
+// Markdown renders the report as a heading, a table with one row per section and a list of failures.
+func (r *RunReport) Markdown(heading string, dryRun bool) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var b strings.Builder
+	if dryRun {
+		heading += " (dry run)"
+	}
+	fmt.Fprintf(&b, "### %s\n\n", heading)
+
+	if len(r.Sections) == 0 {
+		b.WriteString("Nothing was hydrated.\n")
+		return b.String()
+	}
+
+	b.WriteString("| Section | Total | Successful | Failed | Created |\n")
+	b.WriteString("| --- | ---: | ---: | ---: | --- |\n")
+	var failures []string
+	for _, section := range r.Sections {
+		var numbers []string
+		for _, item := range section.Created {
+			if item.Number > 0 {
+				numbers = append(numbers, fmt.Sprintf("#%d", item.Number))
+			}
+		}
+		fmt.Fprintf(&b, "| %s | %d | %d | %d | %s |\n", section.Name, section.Total, section.Success, section.Failures, strings.Join(numbers, ", "))
+		failures = append(failures, section.Errors...)
+	}
+
+	if len(failures) > 0 {
+		b.WriteString("\n**Failures**\n\n")
+		for _, failure := range failures {
+			// Keep each failure on one list item
+			fmt.Fprintf(&b, "- %s\n", strings.Join(strings.Fields(failure), " "))
+		}
+	}
+	return b.String()
+}
+
+// MarkdownDocument renders the report as a standalone Markdown document for pasting into pull
+// request comments: a heading per section with its counts, a table of created items linking to
+// them and a list of errors.
+func (r *RunReport) MarkdownDocument(heading string, dryRun bool) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var b strings.Builder
+	if dryRun {
+		heading += " (dry run)"
+	}
+	fmt.Fprintf(&b, "# %s\n", heading)
+
+	if len(r.Sections) == 0 {
+		b.WriteString("\nNothing was hydrated.\n")
+		return b.String()
+	}
+
+	for _, section := range r.Sections {
+		fmt.Fprintf(&b, "\n## %s\n\n", section.Name)
+		fmt.Fprintf(&b, "%d total, %d successful, %d failed\n", section.Total, section.Success, section.Failures)
+
+		if len(section.Created) > 0 {
+			b.WriteString("\n| Item | Title |\n")
+			b.WriteString("| --- | --- |\n")
+			for _, item := range section.Created {
+				fmt.Fprintf(&b, "| %s | %s |\n", markdownItemLink(item), markdownTableCell(item.Title))
+			}
+		}
+
+		if len(section.Errors) > 0 {
+			b.WriteString("\n### Errors\n\n")
+			for _, failure := range section.Errors {
+				fmt.Fprintf(&b, "- %s\n", strings.Join(strings.Fields(failure), " "))
+			}
+		}
+	}
+	return b.String()
+}
+
+// markdownItemLink returns the item number linked to its URL, falling back to plain text when
+// either is unknown
+func markdownItemLink(item CreatedItem) string {
+	label := item.Type
+	if item.Number > 0 {
+		label = fmt.Sprintf("#%d", item.Number)
+	}
+	if label == "" {
+		label = "item"
+	}
+	if item.URL == "" {
+		return label
+	}
+	return fmt.Sprintf("[%s](%s)", label, item.URL)
+}
+
+// markdownTableCell escapes pipes so that a title cannot break the table row
+func markdownTableCell(text string) string {
+	return strings.ReplaceAll(strings.Join(strings.Fields(text), " "), "|", `\|`)
+}
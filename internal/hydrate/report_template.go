@@ -0,0 +1,80 @@
+package hydrate
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+)
+
+// ReportTemplateData is the data a user-supplied report template is rendered with
+type ReportTemplateData struct {
+	Owner    string           // Owner of the hydrated repository
+	Repo     string           // Name of the hydrated repository
+	DryRun   bool             // Nothing was created because the run was a dry run
+	Sections []SectionSummary // Results of each section, in the order they ran
+}
+
+// reportTemplateFuncs are the helper functions available to report templates
+var reportTemplateFuncs = template.FuncMap{
+	// link renders a created item as its number linked to its URL in Markdown
+	"link": markdownItemLink,
+	// urls returns the URLs of the items created in a section
+	"urls": func(section SectionSummary) []string {
+		var urls []string
+		for _, item := range section.Created {
+			if item.URL != "" {
+				urls = append(urls, item.URL)
+			}
+		}
+		return urls
+	},
+	// total, successes and failures add up the counts of every section
+	"total": func(sections []SectionSummary) int {
+		return sumSections(sections, func(s SectionSummary) int { return s.Total })
+	},
+	"successes": func(sections []SectionSummary) int {
+		return sumSections(sections, func(s SectionSummary) int { return s.Success })
+	},
+	"failures": func(sections []SectionSummary) int {
+		return sumSections(sections, func(s SectionSummary) int { return s.Failures })
+	},
+	"join": strings.Join,
+}
+
+// sumSections adds up one count of every section
+func sumSections(sections []SectionSummary, count func(SectionSummary) int) int {
+	sum := 0
+	for _, section := range sections {
+		sum += count(section)
+	}
+	return sum
+}
+
+// ParseReportTemplate parses a Go text/template for rendering the run report. Besides the built-in
+// functions, templates can use link, urls, total, successes, failures and join.
+func ParseReportTemplate(name, text string) (*template.Template, error) {
+	tmpl, err := template.New(name).Funcs(reportTemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errors.ConfigError("parse_report_template", "invalid report template", err)
+	}
+	return tmpl, nil
+}
+
+// RenderTemplate renders the report with a template returned by ParseReportTemplate
+func (r *RunReport) RenderTemplate(tmpl *template.Template, owner, repo string, dryRun bool) (string, error) {
+	r.mu.Lock()
+	data := ReportTemplateData{
+		Owner:    owner,
+		Repo:     repo,
+		DryRun:   dryRun,
+		Sections: append([]SectionSummary(nil), r.Sections...),
+	}
+	r.mu.Unlock()
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, data); err != nil {
+		return "", errors.ConfigError("render_report_template", "failed to render report template", err)
+	}
+	return b.String(), nil
+}
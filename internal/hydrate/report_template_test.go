@@ -0,0 +1,69 @@
+package hydrate
+
+import (
+	"strings"
+	"testing"
+)
+
+// TestRunReport_RenderTemplate tests rendering the report with custom templates and their helper functions
+func TestRunReport_RenderTemplate(t *testing.T) {
+	report := NewRunReport()
+	report.AddSection(SectionSummary{Name: "Issues", Total: 2, Success: 1, Failures: 1,
+		Created: []CreatedItem{{Title: "A", Type: "issue", Number: 7, URL: "https://github.com/o/r/issues/7"}}})
+	report.AddSection(SectionSummary{Name: "Labels", Total: 1, Success: 1})
+
+	tests := []struct {
+		name        string
+		template    string
+		expected    string
+		errorText   string
+		parseFailed bool
+	}{
+		{
+			name:     "fields and helpers",
+			template: `{{.Owner}}/{{.Repo}} dry={{.DryRun}} {{successes .Sections}}/{{total .Sections}} failed={{failures .Sections}}{{range .Sections}} {{.Name}}:{{join (urls .) ","}}{{range .Created}}{{link .}}{{end}}{{end}}`,
+			expected: "o/r dry=true 2/3 failed=1 Issues:https://github.com/o/r/issues/7[#7](https://github.com/o/r/issues/7) Labels:",
+		},
+		{
+			name:        "malformed template",
+			template:    `{{range .Sections}}`,
+			errorText:   "invalid report template",
+			parseFailed: true,
+		},
+		{
+			name:      "unknown field",
+			template:  `{{.Missing}}`,
+			errorText: "failed to render report template",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseReportTemplate("report.tmpl", tt.template)
+			if tt.parseFailed {
+				if err == nil || !strings.Contains(err.Error(), tt.errorText) {
+					t.Fatalf("Expected parse error containing %q, got: %v", tt.errorText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected parse error: %v", err)
+			}
+
+			output, err := report.RenderTemplate(tmpl, "o", "r", true)
+
+			if tt.errorText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorText) {
+					t.Fatalf("Expected render error containing %q, got: %v", tt.errorText, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected render error: %v", err)
+			}
+			if output != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, output)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+)
+
+// TestHydrateWithOptions_Report tests that each section's results are recorded in the report
+func TestHydrateWithOptions_Report(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesJSON := `[{"title": "First", "body": "Body", "labels": ["bug"]}, {"title": "Second", "body": "Body"}]`
+	if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(issuesJSON), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	report := NewRunReport()
+	options := HydrateOptions{IncludeIssues: true, Report: report}
+	if err := HydrateWithOptions(context.Background(), NewSuccessfulMockGitHubClient(), cfg, options, &testutil.MockLogger{}); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	markdown := report.Markdown("Results", false)
+	expected := []string{
+		"### Results\n",
+		"| Section | Total | Successful | Failed | Created |",
+		"| Labels | 1 | 1 | 0 |  |",
+		"| Issues | 2 | 2 | 0 | #1, #2 |",
+	}
+	for _, line := range expected {
+		if !strings.Contains(markdown, line) {
+			t.Errorf("Expected report to contain %q, got:\n%s", line, markdown)
+		}
+	}
+	if strings.Contains(markdown, "**Failures**") {
+		t.Errorf("Expected no failures section, got:\n%s", markdown)
+	}
+}
+
+// TestRunReport_MarkdownDocument tests the section headers, linked items and errors of the Markdown document
+func TestRunReport_MarkdownDocument(t *testing.T) {
+	report := NewRunReport()
+	report.AddSection(SectionSummary{Name: "Labels", Total: 1, Success: 1})
+	report.AddSection(SectionSummary{
+		Name: "Issues", Total: 3, Success: 2, Failures: 1,
+		Errors: []string{"failed to create issue 'Broken':\nHTTP 502"},
+		Created: []CreatedItem{
+			{Title: "First | pipe", Type: "issue", Number: 4, URL: "https://github.com/owner/repo/issues/4"},
+			{Title: "Second", Type: "issue"},
+		},
+	})
+
+	markdown := report.MarkdownDocument("Results", true)
+	expected := []string{
+		"# Results (dry run)\n",
+		"\n## Labels\n\n1 total, 1 successful, 0 failed\n",
+		"\n## Issues\n\n3 total, 2 successful, 1 failed\n",
+		"| Item | Title |",
+		`| [#4](https://github.com/owner/repo/issues/4) | First \| pipe |`,
+		"| issue | Second |",
+		"### Errors\n\n- failed to create issue 'Broken': HTTP 502\n",
+	}
+	for _, line := range expected {
+		if !strings.Contains(markdown, line) {
+			t.Errorf("Expected document to contain %q, got:\n%s", line, markdown)
+		}
+	}
+	if strings.Count(markdown, "### Errors") != 1 {
+		t.Errorf("Expected an errors section only for the failing section, got:\n%s", markdown)
+	}
+
+	if empty := NewRunReport().MarkdownDocument("Results", false); !strings.Contains(empty, "Nothing was hydrated.") {
+		t.Errorf("Expected empty report message, got:\n%s", empty)
+	}
+}
@@ -0,0 +1,126 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// Teardown removes what demo runs created, as one cleanup: the issues, discussions and pull requests
+// carrying markerLabel, the project and the labels. The project is the one manifest records or,
+// without one, the project titled options.ProjectTitle. It is deleted only when manifest records it
+// as created by the run and options.ClearProjectItems does not ask to keep it; otherwise, as for a
+// project that existed before the run, only its items are removed. The labels are those manifest records as created or,
+// without a manifest, the marker label itself, so labels that existed before are never deleted.
+// The other options, such as DryRun and PreserveConfig, apply as they do to any cleanup.
+func Teardown(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, markerLabel string, manifest *Manifest, logger common.Logger) (*CleanupSummary, error) {
+	if strings.TrimSpace(markerLabel) == "" {
+		return nil, errors.ValidationError("teardown", "teardown finds demo content by its marker label; set marker_label in metadata.json")
+	}
+
+	options.CleanIssues = true
+	options.CleanDiscussions = true
+	options.CleanPRs = true
+	options.CleanLabels = true
+	options.MarkerLabel = markerLabel
+	options.LabelManifest = manifest
+	if manifest == nil {
+		options.LabelManifest = &Manifest{Labels: []string{markerLabel}}
+	}
+	if manifest != nil && manifest.Project != nil {
+		options.ProjectID = manifest.Project.NodeID
+		options.ProjectTitle = manifest.Project.Title
+	}
+	if options.ProjectTitle != "" || options.ProjectID != "" {
+		options.DeleteProject = !options.ClearProjectItems && manifest != nil && manifest.ProjectCreated
+		options.ClearProjectItems = !options.DeleteProject
+	}
+
+	summary, err := CleanupBeforeHydration(ctx, client, options, logger)
+	if summary != nil {
+		logger.Info("%s", formatTeardownSummary(summary, options))
+	}
+	return summary, err
+}
+
+// formatTeardownSummary renders what a teardown removed as a single line
+func formatTeardownSummary(summary *CleanupSummary, options CleanupOptions) string {
+	prefix, verb := "Teardown summary", "deleted"
+	if options.DryRun {
+		prefix, verb = "Teardown summary (dry-run)", "would delete"
+	}
+	project := "none found"
+	switch {
+	case summary.ProjectsDeleted > 0:
+		project = verb
+	case options.ClearProjectItems && options.DryRun:
+		project = "would remove items"
+	case options.ClearProjectItems:
+		project = "items removed"
+	}
+	return fmt.Sprintf("%s: content marked '%s' (%d issues, %d discussions, %d pull requests %s), %d labels %s, project %s",
+		prefix, options.MarkerLabel, summary.IssuesDeleted, summary.DiscussionsDeleted, summary.PRsDeleted, verb,
+		summary.LabelsDeleted, verb, project)
+}
+
+// markedPages returns listPages limited to the items carrying markerLabel, compared without regard
+// to case as GitHub compares label names. An empty markerLabel returns listPages unchanged.
+func markedPages[T any](listPages func(context.Context, func([]T) error) error, markerLabel string, getLabels func(T) []string, logger common.Logger) func(context.Context, func([]T) error) error {
+	if markerLabel == "" {
+		return listPages
+	}
+	return func(ctx context.Context, fn func([]T) error) error {
+		return listPages(ctx, func(items []T) error {
+			marked := make([]T, 0, len(items))
+			for _, item := range items {
+				if HasLabel(getLabels(item), markerLabel) {
+					marked = append(marked, item)
+				}
+			}
+			logger.Debug("%d of %d listed items carry the marker label '%s'", len(marked), len(items), markerLabel)
+			return fn(marked)
+		})
+	}
+}
+
+// cleanupProject deletes the project with options.ProjectID or, without an ID, the open project
+// titled options.ProjectTitle. The issues and pull requests on the board are kept by GitHub.
+func cleanupProject(ctx context.Context, client githubapi.GitHubClient, options CleanupOptions, summary *CleanupSummary, logger common.Logger) []string {
+	var project *types.ProjectV2
+	var err error
+	if options.ProjectID != "" {
+		project, err = client.GetProjectV2(ctx, options.ProjectID)
+	} else {
+		project, err = client.FindProjectV2ByTitle(ctx, options.ProjectTitle)
+	}
+	if err != nil {
+		return handleListError(err, "find_project", "projects")
+	}
+	if project == nil {
+		logger.Info("No open project titled '%s' found; skipping project deletion", options.ProjectTitle)
+		return nil
+	}
+
+	if options.DryRun {
+		logger.Info("Would delete project '%s'", project.Title)
+	} else {
+		logger.Debug("Deleting project '%s'", project.Title)
+		if err := client.DeleteProjectV2(ctx, project.ID); err != nil {
+			if cancelled(ctx, err) {
+				return nil
+			}
+			collector := errors.NewErrorCollector("cleanup_project")
+			handleDeleteError(err, collector, logger, "project", project.Title, project.ID)
+			return convertErrorsToStringSlice(collector)
+		}
+		logger.Info("Deleted project '%s'", project.Title)
+	}
+	summary.ProjectsDeleted++
+	summary.DeletedItems = append(summary.DeletedItems, CleanupItem{Type: "project", Title: project.Title, NodeID: project.ID})
+	return nil
+}
@@ -0,0 +1,157 @@
+package hydrate
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestTeardown tests that marked content, the created project and the created labels are removed and
+// summarized together, and that a project the run did not create only has its items removed
+func TestTeardown(t *testing.T) {
+	tests := []struct {
+		name               string
+		options            CleanupOptions
+		markerLabel        string
+		manifest           *Manifest
+		expectError        string
+		expectedIssues     []string
+		expectedLabels     []string
+		expectedDeleted    []string
+		expectedSummary    string
+		expectProjectGone  bool
+		expectItemsCleared bool
+	}{
+		{
+			name:               "marked content, project by title and marker label",
+			options:            CleanupOptions{ProjectTitle: "Demo board"},
+			markerLabel:        "demo",
+			expectedIssues:     []string{"Real bug"},
+			expectedLabels:     []string{"bug", "seeded"},
+			expectedSummary:    "Teardown summary: content marked 'demo' (1 issues, 1 discussions, 1 pull requests deleted), 1 labels deleted, project items removed",
+			expectItemsCleared: true,
+		},
+		{
+			name:        "labels and created project recorded in the manifest",
+			markerLabel: "demo",
+			manifest: &Manifest{
+				Labels:         []string{"demo", "seeded"},
+				Project:        &ManifestEntry{Title: "Demo board", NodeID: "project-1"},
+				ProjectCreated: true,
+			},
+			expectedIssues:     []string{"Real bug"},
+			expectedLabels:     []string{"bug"},
+			expectedDeleted:    []string{"project-1"},
+			expectedSummary:    "Teardown summary: content marked 'demo' (1 issues, 1 discussions, 1 pull requests deleted), 2 labels deleted, project deleted",
+			expectProjectGone:  true,
+			expectItemsCleared: true,
+		},
+		{
+			name:        "reused project recorded in the manifest",
+			markerLabel: "demo",
+			manifest: &Manifest{
+				Labels:  []string{"demo"},
+				Project: &ManifestEntry{Title: "Demo board", NodeID: "project-1"},
+			},
+			expectedIssues:     []string{"Real bug"},
+			expectedLabels:     []string{"bug", "seeded"},
+			expectedSummary:    "project items removed",
+			expectItemsCleared: true,
+		},
+		{
+			name:        "created project kept with its items removed",
+			options:     CleanupOptions{ClearProjectItems: true},
+			markerLabel: "demo",
+			manifest: &Manifest{
+				Labels:         []string{"demo"},
+				Project:        &ManifestEntry{Title: "Demo board", NodeID: "project-1"},
+				ProjectCreated: true,
+			},
+			expectedIssues:     []string{"Real bug"},
+			expectedLabels:     []string{"bug", "seeded"},
+			expectedSummary:    "project items removed",
+			expectItemsCleared: true,
+		},
+		{
+			name:        "dry run removes nothing",
+			options:     CleanupOptions{DryRun: true},
+			markerLabel: "demo",
+			manifest: &Manifest{
+				Labels:         []string{"demo"},
+				Project:        &ManifestEntry{Title: "Demo board", NodeID: "project-1"},
+				ProjectCreated: true,
+			},
+			expectedIssues:  []string{"Demo issue", "Real bug"},
+			expectedLabels:  []string{"bug", "demo", "seeded"},
+			expectedSummary: "Teardown summary (dry-run): content marked 'demo' (1 issues, 1 discussions, 1 pull requests would delete), 1 labels would delete, project would delete",
+		},
+		{
+			name:            "no project configured",
+			markerLabel:     "demo",
+			expectedIssues:  []string{"Real bug"},
+			expectedLabels:  []string{"bug", "seeded"},
+			expectedSummary: "project none found",
+		},
+		{
+			name:           "marker label required",
+			expectError:    "set marker_label in metadata.json",
+			expectedIssues: []string{"Demo issue", "Real bug"},
+			expectedLabels: []string{"bug", "demo", "seeded"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient("bug", "demo", "seeded")
+			client.CreatedIssues = []types.Issue{
+				{NodeID: "issue1", Title: "Demo issue", Labels: []string{"Demo"}},
+				{NodeID: "issue2", Title: "Real bug", Labels: []string{"bug"}},
+			}
+			client.CreatedDiscussions = []types.Discussion{{NodeID: "discussion1", Title: "Demo idea", Labels: []string{"demo"}}}
+			client.CreatedPRs = []types.PullRequest{{NodeID: "pr1", Title: "Demo fix", Labels: []string{"demo", "bug"}}}
+			client.Projects = []types.ProjectV2{{ID: "project-1", Title: "Demo board"}}
+			client.ProjectItems = map[string][]string{"project-1": {"issue1"}}
+			logger := &testutil.MockLogger{}
+
+			_, err := Teardown(context.Background(), client, tt.options, tt.markerLabel, tt.manifest, logger)
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+			} else if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var issues []string
+			for _, issue := range client.CreatedIssues {
+				issues = append(issues, issue.Title)
+			}
+			if !reflect.DeepEqual(issues, tt.expectedIssues) {
+				t.Errorf("Expected remaining issues %v, got %v", tt.expectedIssues, issues)
+			}
+			labels, _ := client.ListLabels(context.Background())
+			sort.Strings(labels)
+			if !reflect.DeepEqual(labels, tt.expectedLabels) {
+				t.Errorf("Expected remaining labels %v, got %v", tt.expectedLabels, labels)
+			}
+			if !reflect.DeepEqual(client.DeletedProjects, tt.expectedDeleted) {
+				t.Errorf("Expected deleted projects %v, got %v", tt.expectedDeleted, client.DeletedProjects)
+			}
+			if tt.expectProjectGone != (len(client.Projects) == 0) {
+				t.Errorf("Expected project deleted %v, projects left: %v", tt.expectProjectGone, client.Projects)
+			}
+			if tt.expectItemsCleared != (len(client.ProjectItems["project-1"]) == 0) {
+				t.Errorf("Expected project items cleared %v, items left: %v", tt.expectItemsCleared, client.ProjectItems)
+			}
+			if tt.expectedSummary != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedSummary) {
+				t.Errorf("Expected a summary containing %q, got %v", tt.expectedSummary, logger.InfoCalls)
+			}
+		})
+	}
+}
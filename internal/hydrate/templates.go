@@ -0,0 +1,116 @@
+package hydrate
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// applyRepositoryIssueTemplates reads the repository's issue templates and applies them to issues.
+// The templates only supply defaults, so a failure to read them is warned about and hydration continues.
+func applyRepositoryIssueTemplates(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, logger common.Logger) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	templates, err := client.ListIssueTemplates(ctx)
+	if err != nil {
+		if errors.IsContextError(err) {
+			return err
+		}
+		logger.Info("Warning: could not read issue templates, continuing without them: %v", err)
+		return nil
+	}
+	logger.Debug("Found %d issue templates", len(templates))
+
+	applyIssueTemplates(issues, templates, logger)
+	return nil
+}
+
+// applyIssueTemplates gives each issue that matches a template the template's title prefix and
+// labels. An issue matches the template it names in its template field or, when it names none,
+// the template whose title prefix its title already starts with. Titles other issues reference as
+// their original, as a dependency or as an epic child are updated along with the issues they refer to.
+func applyIssueTemplates(issues []types.Issue, templates []types.IssueTemplate, logger common.Logger) {
+	if len(templates) == 0 {
+		return
+	}
+
+	renamed := make(map[string]string)
+	for i := range issues {
+		template, found := matchIssueTemplate(issues[i], templates)
+		if !found {
+			if issues[i].Template != "" {
+				logger.Info("Warning: issue '%s' uses issue template '%s', which was not found", issues[i].Title, issues[i].Template)
+			}
+			continue
+		}
+
+		if prefix := strings.TrimSpace(template.Title); prefix != "" && !strings.HasPrefix(issues[i].Title, prefix) {
+			title := prefix + " " + issues[i].Title
+			renamed[issues[i].Title] = title
+			issues[i].Title = title
+		}
+		issues[i].Labels = mergeLabels(issues[i].Labels, template.Labels)
+		logger.Debug("Applied issue template '%s' to issue '%s'", template.Name, issues[i].Title)
+	}
+
+	for i := range issues {
+		if title, found := renamed[issues[i].DuplicateOfTitle]; found {
+			issues[i].DuplicateOfTitle = title
+		}
+		for j, dependency := range issues[i].DependsOnTitles {
+			if title, found := renamed[dependency]; found {
+				issues[i].DependsOnTitles[j] = title
+			}
+		}
+		for j, child := range issues[i].EpicChildrenTitles {
+			if title, found := renamed[child]; found {
+				issues[i].EpicChildrenTitles[j] = title
+			}
+		}
+	}
+}
+
+// matchIssueTemplate finds the template an issue names, by name or file name, or else the
+// template whose title prefix the issue's title starts with
+func matchIssueTemplate(issue types.Issue, templates []types.IssueTemplate) (types.IssueTemplate, bool) {
+	if name := strings.TrimSpace(issue.Template); name != "" {
+		for _, template := range templates {
+			if strings.EqualFold(template.Name, name) || strings.EqualFold(template.File, name) ||
+				strings.EqualFold(strings.TrimSuffix(template.File, path.Ext(template.File)), name) {
+				return template, true
+			}
+		}
+		return types.IssueTemplate{}, false
+	}
+
+	for _, template := range templates {
+		if prefix := strings.TrimSpace(template.Title); prefix != "" && strings.HasPrefix(issue.Title, prefix) {
+			return template, true
+		}
+	}
+	return types.IssueTemplate{}, false
+}
+
+// mergeLabels appends the labels in extra that are not already in labels
+func mergeLabels(labels, extra []string) []string {
+	for _, label := range extra {
+		exists := false
+		for _, existing := range labels {
+			if existing == label {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			labels = append(labels, label)
+		}
+	}
+	return labels
+}
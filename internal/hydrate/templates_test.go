@@ -0,0 +1,131 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestApplyIssueTemplates tests how templates are matched to issues and applied
+func TestApplyIssueTemplates(t *testing.T) {
+	templates := []types.IssueTemplate{
+		{File: "bug_report.md", Name: "Bug report", Title: "[BUG] ", Labels: []string{"bug", "needs-triage"}},
+		{File: "feature.md", Name: "Feature", Title: "[FEAT]", Labels: []string{"enhancement"}},
+	}
+
+	tests := []struct {
+		name            string
+		issue           types.Issue
+		expectedTitle   string
+		expectedLabels  []string
+		expectedWarning string
+	}{
+		{
+			name:           "named by template name",
+			issue:          types.Issue{Title: "Crash on save", Template: "bug report", Labels: []string{"bug"}},
+			expectedTitle:  "[BUG] Crash on save",
+			expectedLabels: []string{"bug", "needs-triage"},
+		},
+		{
+			name:           "named by file name",
+			issue:          types.Issue{Title: "Dark mode", Template: "feature"},
+			expectedTitle:  "[FEAT] Dark mode",
+			expectedLabels: []string{"enhancement"},
+		},
+		{
+			name:           "matched by title prefix",
+			issue:          types.Issue{Title: "[FEAT] Export", Labels: []string{"ui"}},
+			expectedTitle:  "[FEAT] Export",
+			expectedLabels: []string{"ui", "enhancement"},
+		},
+		{
+			name:           "unmatched issue is unchanged",
+			issue:          types.Issue{Title: "Question", Labels: []string{"question"}},
+			expectedTitle:  "Question",
+			expectedLabels: []string{"question"},
+		},
+		{
+			name:            "unknown template is warned about",
+			issue:           types.Issue{Title: "Question", Template: "support"},
+			expectedTitle:   "Question",
+			expectedWarning: "issue template 'support', which was not found",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logger := &testutil.MockLogger{}
+			issues := []types.Issue{tt.issue}
+
+			applyIssueTemplates(issues, templates, logger)
+
+			if issues[0].Title != tt.expectedTitle {
+				t.Errorf("Expected title %q, got %q", tt.expectedTitle, issues[0].Title)
+			}
+			if !reflect.DeepEqual(issues[0].Labels, tt.expectedLabels) {
+				t.Errorf("Expected labels %v, got %v", tt.expectedLabels, issues[0].Labels)
+			}
+			if tt.expectedWarning != "" && !strings.Contains(strings.Join(logger.InfoCalls, "\n"), tt.expectedWarning) {
+				t.Errorf("Expected warning containing %q, got: %v", tt.expectedWarning, logger.InfoCalls)
+			}
+		})
+	}
+}
+
+// TestApplyIssueTemplates_DuplicateOf tests that references to a renamed issue follow its new title
+func TestApplyIssueTemplates_DuplicateOf(t *testing.T) {
+	issues := []types.Issue{
+		{Title: "Crash", Template: "bug_report"},
+		{Title: "Crash again", DuplicateOfTitle: "Crash"},
+	}
+
+	applyIssueTemplates(issues, []types.IssueTemplate{{File: "bug_report.md", Name: "Bug", Title: "[BUG]"}}, &testutil.MockLogger{})
+
+	if issues[1].DuplicateOfTitle != "[BUG] Crash" {
+		t.Errorf("Expected duplicate reference to be renamed, got %q", issues[1].DuplicateOfTitle)
+	}
+}
+
+// TestHydrateWithOptions_UseIssueTemplates tests that template labels are applied to created issues only when requested
+func TestHydrateWithOptions_UseIssueTemplates(t *testing.T) {
+	for _, useTemplates := range []bool{false, true} {
+		t.Run(map[bool]string{false: "disabled", true: "enabled"}[useTemplates], func(t *testing.T) {
+			tempDir := t.TempDir()
+			issuesPath := filepath.Join(tempDir, "issues.json")
+			if err := os.WriteFile(issuesPath, []byte(`[{"title": "Crash", "body": "Body", "template": "bug_report"}]`), 0644); err != nil {
+				t.Fatalf("Failed to create issues.json: %v", err)
+			}
+			cfg := createConfigurationFromPaths(context.Background(), issuesPath,
+				filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+			client := NewFailingMockGitHubClient(MockConfig{
+				IssueTemplates: []types.IssueTemplate{{File: "bug_report.md", Name: "Bug report", Title: "[BUG] ", Labels: []string{"bug"}}},
+			})
+			options := HydrateOptions{IncludeIssues: true, UseIssueTemplates: useTemplates}
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, &testutil.MockLogger{}); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if len(client.CreatedIssues) != 1 {
+				t.Fatalf("Expected 1 issue created, got %d", len(client.CreatedIssues))
+			}
+			issue := client.CreatedIssues[0]
+			expectedTitle, expectedLabels := "Crash", []string(nil)
+			if useTemplates {
+				expectedTitle, expectedLabels = "[BUG] Crash", []string{"bug"}
+			}
+			if issue.Title != expectedTitle {
+				t.Errorf("Expected title %q, got %q", expectedTitle, issue.Title)
+			}
+			if !reflect.DeepEqual(issue.Labels, expectedLabels) {
+				t.Errorf("Expected labels %v, got %v", expectedLabels, issue.Labels)
+			}
+		})
+	}
+}
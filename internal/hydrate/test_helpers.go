@@ -3,9 +3,12 @@ package hydrate
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
 	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
 	"github.com/chrisreddington/gh-demo/internal/testutil"
 	"github.com/chrisreddington/gh-demo/internal/types"
 )
@@ -16,13 +19,49 @@ type MockConfig struct {
 	Issues                        testutil.ErrorConfig
 	PRs                           testutil.ErrorConfig
 	Discussions                   testutil.ErrorConfig
-	ListLabels                    testutil.ErrorConfig
+	ListLabels                    testutil.ErrorConfig   // Also applies to ListLabelsDetailed
+	LabelDetails                  map[string]types.Label // Color and description of existing labels, by name
 	CreateLabel                   testutil.ErrorConfig
+	UpdateLabel                   testutil.ErrorConfig
 	FailProjectCreation           bool
 	FailProjectItemAddition       bool
+	FailProjectItemNodeIDs        map[string]bool // node IDs whose addition to a project fails
 	FailProjectRetrieval          bool
 	FailProjectFieldConfiguration bool
 	FailProjectDescriptionUpdate  bool
+	Viewer                        testutil.ErrorConfig
+	ViewerLogin                   string
+	ViewerPermission              string
+	ExistingProjectNumbers        []int
+	AutoMerge                     testutil.ErrorConfig
+	RateLimit                     *types.RateLimit
+	ClearProjectItems             testutil.ErrorConfig
+	Features                      testutil.ErrorConfig
+	MarkDuplicate                 testutil.ErrorConfig
+	RepositoryFeatures            *types.RepositoryFeatures // nil means every feature is enabled
+	DefaultBranch                 string                    // Empty means "main"
+	GetDefaultBranch              testutil.ErrorConfig
+	IssueTemplates                []types.IssueTemplate
+	ListIssueTemplates            testutil.ErrorConfig
+	ProjectAccess                 testutil.ErrorConfig // Reported as a project permission error
+	GetProjectOwnerID             testutil.ErrorConfig // Reported as a project owner that does not exist
+	Branches                      []types.Branch
+	DiscussionCategories          []string // nil means "General" only
+	ListDiscussionCategories      testutil.ErrorConfig
+	MissingUsers                  map[string]bool // logins UserExists reports as not existing
+	UserLookup                    testutil.ErrorConfig
+	ListBranches                  testutil.ErrorConfig
+	DeleteBranch                  testutil.ErrorConfig
+	Codeowners                    []types.CodeownersRule
+	GetCodeowners                 testutil.ErrorConfig
+	RequestReviews                testutil.ErrorConfig
+	AddPullRequestReview          testutil.ErrorConfig
+	AddComment                    testutil.ErrorConfig
+	UpdateIssue                   testutil.ErrorConfig
+	WriteFile                     testutil.ErrorConfig
+	DeleteProject                 testutil.ErrorConfig
+	FailDeleteNodeIDs             map[string]bool // node IDs whose deletion fails
+	ListPageSize                  int             // Items per page of the List*Pages methods; 0 means a single page
 }
 
 // ConfigurableMockGitHubClient provides a configurable mock implementation of GitHubClient
@@ -32,6 +71,24 @@ type ConfigurableMockGitHubClient struct {
 	CreatedDiscussions []types.Discussion
 	CreatedPRs         []types.PullRequest
 	CreatedLabels      []string
+	UpdatedLabels      []string                          // names of labels updated through UpdateLabel
+	ProjectItems       map[string][]string               // project ID -> node IDs of items added to it
+	AutoMergePRs       map[string]string                 // pull request node ID -> auto-merge method
+	Projects           []types.ProjectV2                 // projects created through the mock, found by FindProjectV2ByTitle
+	ProjectFields      map[string][]types.ProjectV2Field // project ID -> configured fields
+	DescriptionUpdates int
+	DuplicateOf        map[string]int    // issue node ID -> number of the issue it was marked a duplicate of
+	ClosedDiscussions  map[string]string // discussion node ID -> close reason
+	DefaultBranchCalls int
+	DeletedBranches    []string                    // names of branches deleted through DeleteBranch
+	ReviewRequests     map[string][]string         // pull request node ID -> requested reviewers
+	Reviews            map[string][]types.PRReview // pull request node ID -> submitted reviews
+	UpdatedIssues      []string                    // node IDs of issues updated through UpdateIssue
+	Comments           map[string][]string         // issue or pull request node ID -> comments added through AddComment
+	ListedPages        int                         // pages passed to callbacks by the List*Pages methods
+	ProjectOwner       string                      // login set through SetProjectOwner
+	Files              map[string]string           // path -> content written through CreateOrUpdateFile
+	DeletedProjects    []string                    // IDs of projects deleted through DeleteProjectV2
 	logger             common.Logger
 }
 
@@ -39,9 +96,11 @@ func (m *ConfigurableMockGitHubClient) CreateIssue(ctx context.Context, issue ty
 	if err := m.Config.Issues.GetErrorOrDefault(fmt.Sprintf("simulated issue creation failure for: %s", issue.Title)); err != nil {
 		return nil, err
 	}
+	issue.NodeID = fmt.Sprintf("mock-issue-id-%d", len(m.CreatedIssues)+1)
+	issue.Number = len(m.CreatedIssues) + 1
 	m.CreatedIssues = append(m.CreatedIssues, issue)
 	return &types.CreatedItemInfo{
-		NodeID: fmt.Sprintf("mock-issue-id-%d", len(m.CreatedIssues)),
+		NodeID: issue.NodeID,
 		Title:  issue.Title,
 		Type:   "issue",
 		Number: len(m.CreatedIssues),
@@ -49,13 +108,49 @@ func (m *ConfigurableMockGitHubClient) CreateIssue(ctx context.Context, issue ty
 	}, nil
 }
 
+// UpdateIssue mock implementation replacing the title, body, labels and assignees of a created issue
+func (m *ConfigurableMockGitHubClient) UpdateIssue(ctx context.Context, nodeID string, issue types.Issue) (*types.CreatedItemInfo, error) {
+	if err := m.Config.UpdateIssue.GetErrorOrDefault(fmt.Sprintf("simulated issue update failure for: %s", issue.Title)); err != nil {
+		return nil, err
+	}
+	for i := range m.CreatedIssues {
+		if m.CreatedIssues[i].NodeID != nodeID {
+			continue
+		}
+		if issue.Title != "" {
+			m.CreatedIssues[i].Title = issue.Title
+		}
+		m.CreatedIssues[i].Body = issue.Body
+		m.CreatedIssues[i].Labels = issue.Labels
+		m.CreatedIssues[i].Assignees = issue.Assignees
+		m.UpdatedIssues = append(m.UpdatedIssues, nodeID)
+		return &types.CreatedItemInfo{
+			NodeID: nodeID,
+			Title:  m.CreatedIssues[i].Title,
+			Type:   "issue",
+			Number: m.CreatedIssues[i].Number,
+		}, nil
+	}
+	return nil, fmt.Errorf("issue %s not found", nodeID)
+}
+
 func (m *ConfigurableMockGitHubClient) CreateDiscussion(ctx context.Context, discussion types.Discussion) (*types.CreatedItemInfo, error) {
 	if err := m.Config.Discussions.GetErrorOrDefault(fmt.Sprintf("simulated discussion creation failure for: %s", discussion.Title)); err != nil {
 		return nil, err
 	}
+	// Like the real client, labels are added after creation and those that do not exist are skipped
+	var labels []string
+	for _, label := range discussion.Labels {
+		if m.Config.ExistingLabels[label] {
+			labels = append(labels, label)
+		}
+	}
+	discussion.Labels = labels
+	discussion.NodeID = fmt.Sprintf("mock-discussion-id-%d", len(m.CreatedDiscussions)+1)
+	discussion.Number = len(m.CreatedDiscussions) + 1
 	m.CreatedDiscussions = append(m.CreatedDiscussions, discussion)
 	return &types.CreatedItemInfo{
-		NodeID: fmt.Sprintf("mock-discussion-id-%d", len(m.CreatedDiscussions)),
+		NodeID: discussion.NodeID,
 		Title:  discussion.Title,
 		Type:   "discussion",
 		Number: len(m.CreatedDiscussions),
@@ -67,9 +162,11 @@ func (m *ConfigurableMockGitHubClient) CreatePR(ctx context.Context, pullRequest
 	if err := m.Config.PRs.GetErrorOrDefault(fmt.Sprintf("simulated PR creation failure for: %s (head: %s, base: %s)", pullRequest.Title, pullRequest.Head, pullRequest.Base)); err != nil {
 		return nil, err
 	}
+	pullRequest.NodeID = fmt.Sprintf("mock-pr-id-%d", len(m.CreatedPRs)+1)
+	pullRequest.Number = len(m.CreatedPRs) + 1
 	m.CreatedPRs = append(m.CreatedPRs, pullRequest)
 	return &types.CreatedItemInfo{
-		NodeID: fmt.Sprintf("mock-pr-id-%d", len(m.CreatedPRs)),
+		NodeID: pullRequest.NodeID,
 		Title:  pullRequest.Title,
 		Type:   "pull_request",
 		Number: len(m.CreatedPRs),
@@ -88,6 +185,22 @@ func (m *ConfigurableMockGitHubClient) ListLabels(ctx context.Context) ([]string
 	return labels, nil
 }
 
+func (m *ConfigurableMockGitHubClient) ListLabelsDetailed(ctx context.Context) ([]types.Label, error) {
+	names, err := m.ListLabels(ctx)
+	if err != nil {
+		return nil, err
+	}
+	labels := make([]types.Label, 0, len(names))
+	for _, name := range names {
+		label, found := m.Config.LabelDetails[name]
+		if !found {
+			label = types.Label{Name: name}
+		}
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
 func (m *ConfigurableMockGitHubClient) CreateLabel(ctx context.Context, label types.Label) error {
 	if err := m.Config.CreateLabel.GetErrorOrDefault(fmt.Sprintf("simulated create label failure for: %s", label.Name)); err != nil {
 		return err
@@ -100,11 +213,61 @@ func (m *ConfigurableMockGitHubClient) CreateLabel(ctx context.Context, label ty
 	return nil
 }
 
+// UpdateLabel mock implementation recording the update and replacing the label's color and description
+func (m *ConfigurableMockGitHubClient) UpdateLabel(ctx context.Context, name string, label types.Label) error {
+	if err := m.Config.UpdateLabel.GetErrorOrDefault(fmt.Sprintf("simulated update label failure for: %s", name)); err != nil {
+		return err
+	}
+	m.UpdatedLabels = append(m.UpdatedLabels, name)
+	if m.Config.LabelDetails == nil {
+		m.Config.LabelDetails = make(map[string]types.Label)
+	}
+	m.Config.LabelDetails[name] = types.Label{Name: name, Color: label.Color, Description: label.Description}
+	return nil
+}
+
 func (m *ConfigurableMockGitHubClient) SetLogger(logger common.Logger) {
 	m.logger = logger
 }
 
+// SetMaxPages mock implementation; the mock does not paginate
+func (m *ConfigurableMockGitHubClient) SetMaxPages(maxPages int) {}
+
+// SetNetworkProfile mock implementation; the mock makes no network calls
+func (m *ConfigurableMockGitHubClient) SetNetworkProfile(profile config.NetworkProfile) {}
+
+// SetScheduler mock implementation; the mock does not pace calls
+func (m *ConfigurableMockGitHubClient) SetScheduler(scheduler *githubapi.RequestScheduler) {}
+
+// SetProjectOwner mock implementation recording the project owner
+func (m *ConfigurableMockGitHubClient) SetProjectOwner(owner string) {
+	m.ProjectOwner = owner
+}
+
 // Listing operations for cleanup
+func (m *ConfigurableMockGitHubClient) EnablePullRequestAutoMerge(ctx context.Context, prNodeID, method string) error {
+	if err := m.Config.AutoMerge.GetErrorOrDefault(fmt.Sprintf("simulated auto-merge failure for: %s", prNodeID)); err != nil {
+		return err
+	}
+	if m.AutoMergePRs == nil {
+		m.AutoMergePRs = make(map[string]string)
+	}
+	m.AutoMergePRs[prNodeID] = method
+	return nil
+}
+
+// MarkIssueAsDuplicate mock implementation recording the duplicate link
+func (m *ConfigurableMockGitHubClient) MarkIssueAsDuplicate(ctx context.Context, issueNodeID string, originalNumber int) error {
+	if err := m.Config.MarkDuplicate.GetErrorOrDefault("simulated duplicate marking failure"); err != nil {
+		return err
+	}
+	if m.DuplicateOf == nil {
+		m.DuplicateOf = make(map[string]int)
+	}
+	m.DuplicateOf[issueNodeID] = originalNumber
+	return nil
+}
+
 func (m *ConfigurableMockGitHubClient) ListIssues(ctx context.Context) ([]types.Issue, error) {
 	// For testing, return created issues
 	return m.CreatedIssues, nil
@@ -120,8 +283,81 @@ func (m *ConfigurableMockGitHubClient) ListPRs(ctx context.Context) ([]types.Pul
 	return m.CreatedPRs, nil
 }
 
+// ListIssuePages mock implementation passing the created issues to fn in pages of Config.ListPageSize
+func (m *ConfigurableMockGitHubClient) ListIssuePages(ctx context.Context, fn func([]types.Issue) error) error {
+	return mockPages(m.CreatedIssues, m.Config.ListPageSize, &m.ListedPages, fn)
+}
+
+// ListDiscussionPages mock implementation passing the created discussions to fn in pages of Config.ListPageSize
+func (m *ConfigurableMockGitHubClient) ListDiscussionPages(ctx context.Context, fn func([]types.Discussion) error) error {
+	return mockPages(m.CreatedDiscussions, m.Config.ListPageSize, &m.ListedPages, fn)
+}
+
+// ListPRPages mock implementation passing the created pull requests to fn in pages of Config.ListPageSize
+func (m *ConfigurableMockGitHubClient) ListPRPages(ctx context.Context, fn func([]types.PullRequest) error) error {
+	return mockPages(m.CreatedPRs, m.Config.ListPageSize, &m.ListedPages, fn)
+}
+
+// mockPages passes a copy of items to fn in pages of size items, or in a single page when size is
+// not positive, counting each page in listed. The copy is taken first, so fn may delete the items it is given.
+func mockPages[T any](items []T, size int, listed *int, fn func([]T) error) error {
+	remaining := append([]T{}, items...)
+	if size <= 0 {
+		size = len(remaining)
+	}
+	for {
+		page := remaining
+		if len(page) > size {
+			page = remaining[:size]
+		}
+		*listed++
+		if err := fn(page); err != nil {
+			return err
+		}
+		remaining = remaining[len(page):]
+		if len(remaining) == 0 {
+			return nil
+		}
+	}
+}
+
+// GetIssue mock implementation returning a created issue by number
+func (m *ConfigurableMockGitHubClient) GetIssue(ctx context.Context, number int) (*types.Issue, error) {
+	for _, issue := range m.CreatedIssues {
+		if issue.Number == number {
+			return &issue, nil
+		}
+	}
+	return nil, fmt.Errorf("issue #%d not found", number)
+}
+
+// GetPullRequest mock implementation returning a created pull request by number
+func (m *ConfigurableMockGitHubClient) GetPullRequest(ctx context.Context, number int) (*types.PullRequest, error) {
+	for _, pullRequest := range m.CreatedPRs {
+		if pullRequest.Number == number {
+			return &pullRequest, nil
+		}
+	}
+	return nil, fmt.Errorf("pull request #%d not found", number)
+}
+
 // Deletion operations for cleanup
+// AddComment mock implementation recording the comment by node ID
+func (m *ConfigurableMockGitHubClient) AddComment(ctx context.Context, subjectNodeID, body string) error {
+	if err := m.Config.AddComment.GetErrorOrDefault(fmt.Sprintf("simulated comment failure for: %s", subjectNodeID)); err != nil {
+		return err
+	}
+	if m.Comments == nil {
+		m.Comments = make(map[string][]string)
+	}
+	m.Comments[subjectNodeID] = append(m.Comments[subjectNodeID], body)
+	return nil
+}
+
 func (m *ConfigurableMockGitHubClient) DeleteIssue(ctx context.Context, nodeID string) error {
+	if m.Config.FailDeleteNodeIDs[nodeID] {
+		return fmt.Errorf("simulated delete failure for: %s", nodeID)
+	}
 	// For testing, just remove from created issues if found
 	for i, issue := range m.CreatedIssues {
 		if issue.NodeID == nodeID {
@@ -133,6 +369,9 @@ func (m *ConfigurableMockGitHubClient) DeleteIssue(ctx context.Context, nodeID s
 }
 
 func (m *ConfigurableMockGitHubClient) DeleteDiscussion(ctx context.Context, nodeID string) error {
+	if m.Config.FailDeleteNodeIDs[nodeID] {
+		return fmt.Errorf("simulated delete failure for: %s", nodeID)
+	}
 	// For testing, just remove from created discussions if found
 	for i, discussion := range m.CreatedDiscussions {
 		if discussion.NodeID == nodeID {
@@ -143,7 +382,24 @@ func (m *ConfigurableMockGitHubClient) DeleteDiscussion(ctx context.Context, nod
 	return nil
 }
 
+// CloseDiscussion mock implementation marking the discussion closed and recording the reason
+func (m *ConfigurableMockGitHubClient) CloseDiscussion(ctx context.Context, nodeID, reason string) error {
+	if m.ClosedDiscussions == nil {
+		m.ClosedDiscussions = make(map[string]string)
+	}
+	m.ClosedDiscussions[nodeID] = reason
+	for i := range m.CreatedDiscussions {
+		if m.CreatedDiscussions[i].NodeID == nodeID {
+			m.CreatedDiscussions[i].State = "CLOSED"
+		}
+	}
+	return nil
+}
+
 func (m *ConfigurableMockGitHubClient) DeletePR(ctx context.Context, nodeID string) error {
+	if m.Config.FailDeleteNodeIDs[nodeID] {
+		return fmt.Errorf("simulated delete failure for: %s", nodeID)
+	}
 	// For testing, just remove from created PRs if found
 	for i, pullRequest := range m.CreatedPRs {
 		if pullRequest.NodeID == nodeID {
@@ -168,6 +424,36 @@ func (m *ConfigurableMockGitHubClient) DeleteLabel(ctx context.Context, name str
 	return nil
 }
 
+// ListBranches mock implementation returning the configured branches that start with prefix
+func (m *ConfigurableMockGitHubClient) ListBranches(ctx context.Context, prefix string) ([]types.Branch, error) {
+	if err := m.Config.ListBranches.GetErrorOrDefault("simulated list branches failure"); err != nil {
+		return nil, err
+	}
+	var branches []types.Branch
+	for _, branch := range m.Config.Branches {
+		if strings.HasPrefix(branch.Name, prefix) {
+			branches = append(branches, branch)
+		}
+	}
+	return branches, nil
+}
+
+// DeleteBranch mock implementation removing the branch from the configured branches
+func (m *ConfigurableMockGitHubClient) DeleteBranch(ctx context.Context, refID string) error {
+	for i, branch := range m.Config.Branches {
+		if branch.NodeID != refID {
+			continue
+		}
+		if err := m.Config.DeleteBranch.GetErrorOrDefault(fmt.Sprintf("simulated delete branch failure for: %s", branch.Name)); err != nil {
+			return err
+		}
+		m.Config.Branches = append(m.Config.Branches[:i], m.Config.Branches[i+1:]...)
+		m.DeletedBranches = append(m.DeletedBranches, branch.Name)
+		return nil
+	}
+	return nil
+}
+
 // ProjectV2 operations for testing compatibility
 
 func (m *ConfigurableMockGitHubClient) CreateProjectV2(ctx context.Context, config types.ProjectV2Configuration) (*types.ProjectV2, error) {
@@ -175,27 +461,134 @@ func (m *ConfigurableMockGitHubClient) CreateProjectV2(ctx context.Context, conf
 		return nil, errors.ProjectError("create_project", "mock project creation failure", fmt.Errorf("mock error"))
 	}
 
-	// Return a mock project for testing
-	return &types.ProjectV2{
-		NodeID:      "test-project-node-id",
-		ID:          "test-project-id",
-		Number:      1,
-		Title:       config.Title,
-		Description: config.Description,
-		Visibility:  config.Visibility,
-		URL:         "https://github.com/users/test/projects/1",
-	}, nil
+	// Return a mock project for testing; the first project keeps the historical test ID
+	projectID := "test-project-id"
+	if len(m.Projects) > 0 {
+		projectID = fmt.Sprintf("test-project-id-%d", len(m.Projects)+1)
+	}
+	project := types.ProjectV2{
+		NodeID:     projectID,
+		ID:         projectID,
+		Number:     len(m.Projects) + 1,
+		Title:      config.Title,
+		Visibility: config.Visibility,
+		URL:        fmt.Sprintf("https://github.com/users/test/projects/%d", len(m.Projects)+1),
+	}
+	m.Projects = append(m.Projects, project)
+	return &project, nil
+}
+
+// FindProjectV2ByTitle mock implementation returning a project previously created through the mock
+func (m *ConfigurableMockGitHubClient) FindProjectV2ByTitle(ctx context.Context, title string) (*types.ProjectV2, error) {
+	if m.Config.FailProjectRetrieval {
+		return nil, errors.ProjectError("find_project", "mock project retrieval failure", fmt.Errorf("mock error"))
+	}
+
+	for _, project := range m.Projects {
+		if project.Title == title {
+			found := project
+			return &found, nil
+		}
+	}
+	return nil, nil
+}
+
+// ListProjectV2Fields mock implementation returning the fields configured through the mock
+func (m *ConfigurableMockGitHubClient) ListProjectV2Fields(ctx context.Context, projectID string) ([]types.ProjectV2Field, error) {
+	if m.Config.FailProjectRetrieval {
+		return nil, errors.ProjectError("list_project_fields", "mock project retrieval failure", fmt.Errorf("mock error"))
+	}
+	return m.ProjectFields[projectID], nil
+}
+
+// UpdateProjectV2FieldOptions mock implementation replacing the options of a configured field
+func (m *ConfigurableMockGitHubClient) UpdateProjectV2FieldOptions(ctx context.Context, fieldID string, options []types.ProjectV2FieldOption) error {
+	if m.Config.FailProjectFieldConfiguration {
+		return errors.ProjectError("update_project_field", "mock project field configuration failure", fmt.Errorf("mock error"))
+	}
+
+	for projectID, fields := range m.ProjectFields {
+		for i := range fields {
+			if fields[i].ID == fieldID {
+				m.ProjectFields[projectID][i].Options = options
+				return nil
+			}
+		}
+	}
+	return errors.ValidationError("update_project_field", fmt.Sprintf("field %s not found", fieldID))
+}
+
+// ListProjectV2Items mock implementation returning the items added to the project through the mock
+func (m *ConfigurableMockGitHubClient) ListProjectV2Items(ctx context.Context, projectID string) ([]types.ProjectV2Item, error) {
+	if m.Config.FailProjectRetrieval {
+		return nil, errors.ProjectError("list_project_items", "mock project retrieval failure", fmt.Errorf("mock error"))
+	}
+
+	var items []types.ProjectV2Item
+	for _, nodeID := range m.ProjectItems[projectID] {
+		items = append(items, types.ProjectV2Item{ID: nodeID, Title: nodeID})
+	}
+	return items, nil
+}
+
+// ClearProjectV2Items mock implementation removing every item added to the project through the mock
+func (m *ConfigurableMockGitHubClient) ClearProjectV2Items(ctx context.Context, projectID string) error {
+	if err := m.Config.ClearProjectItems.GetErrorOrDefault("simulated project item removal failure"); err != nil {
+		return err
+	}
+	delete(m.ProjectItems, projectID)
+	return nil
+}
+
+// DeleteProjectV2 mock implementation removing the project from the projects found by title
+func (m *ConfigurableMockGitHubClient) DeleteProjectV2(ctx context.Context, projectID string) error {
+	if err := m.Config.DeleteProject.GetErrorOrDefault(fmt.Sprintf("simulated project deletion failure for: %s", projectID)); err != nil {
+		return err
+	}
+	remaining := m.Projects[:0]
+	for _, project := range m.Projects {
+		if project.ID != projectID {
+			remaining = append(remaining, project)
+		}
+	}
+	m.Projects = remaining
+	delete(m.ProjectItems, projectID)
+	m.DeletedProjects = append(m.DeletedProjects, projectID)
+	return nil
 }
 
 func (m *ConfigurableMockGitHubClient) AddItemToProjectV2(ctx context.Context, projectID, itemNodeID string) error {
-	if m.Config.FailProjectItemAddition {
+	if m.Config.FailProjectItemAddition || m.Config.FailProjectItemNodeIDs[itemNodeID] {
 		return errors.ProjectError("add_item_to_project", "mock project item addition failure", fmt.Errorf("mock error"))
 	}
 
-	// For testing, just return success
+	if m.ProjectItems == nil {
+		m.ProjectItems = make(map[string][]string)
+	}
+	m.ProjectItems[projectID] = append(m.ProjectItems[projectID], itemNodeID)
 	return nil
 }
 
+func (m *ConfigurableMockGitHubClient) GetProjectV2ByNumber(ctx context.Context, number int) (*types.ProjectV2, error) {
+	if m.Config.FailProjectRetrieval {
+		return nil, errors.ProjectError("get_project_by_number", "mock project retrieval failure", fmt.Errorf("mock error"))
+	}
+
+	for _, existing := range m.Config.ExistingProjectNumbers {
+		if existing == number {
+			projectID := fmt.Sprintf("mock-project-%d", number)
+			return &types.ProjectV2{
+				NodeID: projectID,
+				ID:     projectID,
+				Number: number,
+				Title:  fmt.Sprintf("Project %d", number),
+				URL:    fmt.Sprintf("https://github.com/users/test/projects/%d", number),
+			}, nil
+		}
+	}
+	return nil, errors.ValidationError("validate_project", fmt.Sprintf("project #%d not found", number))
+}
+
 func (m *ConfigurableMockGitHubClient) GetProjectV2(ctx context.Context, projectID string) (*types.ProjectV2, error) {
 	if m.Config.FailProjectRetrieval {
 		return nil, errors.ProjectError("get_project", "mock project retrieval failure", fmt.Errorf("mock error"))
@@ -219,7 +612,13 @@ func (m *ConfigurableMockGitHubClient) ConfigureProjectV2Fields(ctx context.Cont
 		return errors.ProjectError("configure_project_fields", "mock project field configuration failure", fmt.Errorf("mock error"))
 	}
 
-	// For testing, just return success
+	if m.ProjectFields == nil {
+		m.ProjectFields = make(map[string][]types.ProjectV2Field)
+	}
+	for _, field := range fields {
+		field.ID = fmt.Sprintf("%s-field-%d", projectID, len(m.ProjectFields[projectID])+1)
+		m.ProjectFields[projectID] = append(m.ProjectFields[projectID], field)
+	}
 	return nil
 }
 
@@ -229,10 +628,122 @@ func (m *ConfigurableMockGitHubClient) UpdateProjectV2Description(ctx context.Co
 		return errors.ProjectError("update_project_description", "mock project description update failure", fmt.Errorf("mock error"))
 	}
 
-	// For testing, just return success
+	m.DescriptionUpdates++
+	for i := range m.Projects {
+		if m.Projects[i].ID == projectID {
+			m.Projects[i].Description = description
+		}
+	}
+	return nil
+}
+
+// GetViewer mock implementation for authenticated user lookup
+func (m *ConfigurableMockGitHubClient) GetViewer(ctx context.Context) (*types.ViewerInfo, error) {
+	if err := m.Config.Viewer.GetErrorOrDefault("simulated viewer lookup failure"); err != nil {
+		return nil, err
+	}
+
+	login := m.Config.ViewerLogin
+	if login == "" {
+		login = "mock-user"
+	}
+	permission := m.Config.ViewerPermission
+	if permission == "" {
+		permission = "ADMIN"
+	}
+
+	return &types.ViewerInfo{
+		Login:                login,
+		RepositoryPermission: permission,
+	}, nil
+}
+
+// GetRepositoryFeatures mock implementation returning the configured features, or all enabled by default
+func (m *ConfigurableMockGitHubClient) GetRepositoryFeatures(ctx context.Context) (*types.RepositoryFeatures, error) {
+	if err := m.Config.Features.GetErrorOrDefault("simulated repository features lookup failure"); err != nil {
+		return nil, err
+	}
+
+	if m.Config.RepositoryFeatures != nil {
+		features := *m.Config.RepositoryFeatures
+		return &features, nil
+	}
+	return &types.RepositoryFeatures{HasIssuesEnabled: true, HasDiscussionsEnabled: true, HasProjectsEnabled: true}, nil
+}
+
+// GetDefaultBranch mock implementation returning the configured default branch, or "main" by default
+func (m *ConfigurableMockGitHubClient) GetDefaultBranch(ctx context.Context) (string, error) {
+	m.DefaultBranchCalls++
+	if err := m.Config.GetDefaultBranch.GetErrorOrDefault("simulated default branch lookup failure"); err != nil {
+		return "", err
+	}
+	if m.Config.DefaultBranch != "" {
+		return m.Config.DefaultBranch, nil
+	}
+	return "main", nil
+}
+
+// ListDiscussionCategories mock implementation returning the configured categories
+func (m *ConfigurableMockGitHubClient) ListDiscussionCategories(ctx context.Context) ([]string, error) {
+	if err := m.Config.ListDiscussionCategories.GetErrorOrDefault("simulated list discussion categories failure"); err != nil {
+		return nil, err
+	}
+	if m.Config.DiscussionCategories != nil {
+		return m.Config.DiscussionCategories, nil
+	}
+	return []string{"General"}, nil
+}
+
+// UserExists mock implementation reporting every login except the configured missing users as existing
+func (m *ConfigurableMockGitHubClient) UserExists(ctx context.Context, login string) (bool, error) {
+	if err := m.Config.UserLookup.GetErrorOrDefault(fmt.Sprintf("simulated user lookup failure for: %s", login)); err != nil {
+		return false, err
+	}
+	return !m.Config.MissingUsers[login], nil
+}
+
+// ListIssueTemplates mock implementation returning the configured issue templates
+func (m *ConfigurableMockGitHubClient) ListIssueTemplates(ctx context.Context) ([]types.IssueTemplate, error) {
+	if err := m.Config.ListIssueTemplates.GetErrorOrDefault("simulated issue templates lookup failure"); err != nil {
+		return nil, err
+	}
+	return m.Config.IssueTemplates, nil
+}
+
+// CheckProjectAccess mock implementation reporting a project permission error when configured to fail
+func (m *ConfigurableMockGitHubClient) CheckProjectAccess(ctx context.Context) error {
+	if err := m.Config.ProjectAccess.GetErrorOrDefault("simulated missing project scope"); err != nil {
+		return errors.ProjectPermissionError("check_project_access", "token is missing the 'project' scope needed to create projects", err)
+	}
 	return nil
 }
 
+// GetProjectOwnerID mock implementation returning an ID derived from the project owner, or a not found error when configured to fail
+func (m *ConfigurableMockGitHubClient) GetProjectOwnerID(ctx context.Context) (string, error) {
+	owner := m.ProjectOwner
+	if owner == "" {
+		owner = "owner"
+	}
+	if err := m.Config.GetProjectOwnerID.GetErrorOrDefault(fmt.Sprintf("simulated project owner lookup failure for: %s", owner)); err != nil {
+		return "", errors.ValidationError("validate_project_owner", err.Error())
+	}
+	return "mock-owner-id-" + owner, nil
+}
+
+// GetRateLimit mock implementation returning the configured rate limit, or an error when none is configured
+func (m *ConfigurableMockGitHubClient) GetRateLimit(ctx context.Context) (*types.RateLimit, error) {
+	if m.Config.RateLimit == nil {
+		return nil, errors.APIError("get_rate_limit", "rate limit information was not returned", nil)
+	}
+	rateLimit := *m.Config.RateLimit
+	return &rateLimit, nil
+}
+
+// APICallCounts mock implementation; the mock makes no GraphQL calls
+func (m *ConfigurableMockGitHubClient) APICallCounts() map[string]int {
+	return map[string]int{}
+}
+
 // Helper functions to create common mock configurations
 
 // NewSuccessfulMockGitHubClient creates a mock that succeeds for all operations
@@ -262,3 +773,47 @@ func NewFailingMockGitHubClient(config MockConfig) *ConfigurableMockGitHubClient
 		CreatedLabels:      testutil.EmptyCollections.Labels,
 	}
 }
+
+// GetCodeowners mock implementation returning the configured CODEOWNERS rules
+func (m *ConfigurableMockGitHubClient) GetCodeowners(ctx context.Context) ([]types.CodeownersRule, error) {
+	if err := m.Config.GetCodeowners.GetErrorOrDefault("simulated CODEOWNERS lookup failure"); err != nil {
+		return nil, err
+	}
+	return m.Config.Codeowners, nil
+}
+
+// CreateOrUpdateFile mock implementation recording the content written to each path
+func (m *ConfigurableMockGitHubClient) CreateOrUpdateFile(ctx context.Context, path, content, message string) error {
+	if err := m.Config.WriteFile.GetErrorOrDefault(fmt.Sprintf("simulated file write failure for: %s", path)); err != nil {
+		return err
+	}
+	if m.Files == nil {
+		m.Files = make(map[string]string)
+	}
+	m.Files[path] = content
+	return nil
+}
+
+// RequestReviews mock implementation recording the reviewers requested on each pull request
+func (m *ConfigurableMockGitHubClient) RequestReviews(ctx context.Context, prNodeID string, reviewers []string) error {
+	if err := m.Config.RequestReviews.GetErrorOrDefault(fmt.Sprintf("simulated review request failure for: %s", prNodeID)); err != nil {
+		return err
+	}
+	if m.ReviewRequests == nil {
+		m.ReviewRequests = make(map[string][]string)
+	}
+	m.ReviewRequests[prNodeID] = append(m.ReviewRequests[prNodeID], reviewers...)
+	return nil
+}
+
+// AddPullRequestReview mock implementation recording the reviews submitted on each pull request
+func (m *ConfigurableMockGitHubClient) AddPullRequestReview(ctx context.Context, prNodeID, body, event string) error {
+	if err := m.Config.AddPullRequestReview.GetErrorOrDefault(fmt.Sprintf("simulated review failure for: %s", prNodeID)); err != nil {
+		return err
+	}
+	if m.Reviews == nil {
+		m.Reviews = make(map[string][]types.PRReview)
+	}
+	m.Reviews[prNodeID] = append(m.Reviews[prNodeID], types.PRReview{Body: body, Event: event})
+	return nil
+}
@@ -0,0 +1,57 @@
+package hydrate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// withSimulatedDate starts body with a line giving the date the item was supposedly posted, e.g.
+// "_Originally posted on March 1, 2024_". GitHub sets the creation date itself, so the line is what
+// gives a freshly hydrated demo a history. An empty date leaves body unchanged.
+func withSimulatedDate(body, date string) (string, error) {
+	if date == "" {
+		return body, nil
+	}
+	posted, err := time.Parse(types.SimulatedDateLayout, date)
+	if err != nil {
+		return "", err
+	}
+	note := "_Originally posted on " + posted.Format("January 2, 2006") + "_"
+	if body == "" {
+		return note, nil
+	}
+	return note + "\n\n" + body, nil
+}
+
+// annotateSimulatedDates adds the simulated date line to the body of every item that sets one.
+// Items keep their configured order, so dependencies and the order of a rerun are unchanged.
+func annotateSimulatedDates(issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest) error {
+	annotate := func(itemType, title string, body *string, date string) error {
+		annotated, err := withSimulatedDate(*body, date)
+		if err != nil {
+			return errors.ValidationError("simulate_date", fmt.Sprintf("%s '%s' has invalid simulated date '%s' (must be YYYY-MM-DD)", itemType, title, date))
+		}
+		*body = annotated
+		return nil
+	}
+
+	for i := range issues {
+		if err := annotate("issue", issues[i].Title, &issues[i].Body, issues[i].SimulatedDate); err != nil {
+			return err
+		}
+	}
+	for i := range discussions {
+		if err := annotate("discussion", discussions[i].Title, &discussions[i].Body, discussions[i].SimulatedDate); err != nil {
+			return err
+		}
+	}
+	for i := range pullRequests {
+		if err := annotate("pull request", pullRequests[i].Title, &pullRequests[i].Body, pullRequests[i].SimulatedDate); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestWithSimulatedDate tests that the date line starts the body and that an empty date changes nothing
+func TestWithSimulatedDate(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		date        string
+		expected    string
+		expectError bool
+	}{
+		{
+			name:     "date line starts the body",
+			body:     "Steps to reproduce",
+			date:     "2024-03-01",
+			expected: "_Originally posted on March 1, 2024_\n\nSteps to reproduce",
+		},
+		{
+			name:     "empty body",
+			date:     "2023-12-25",
+			expected: "_Originally posted on December 25, 2023_",
+		},
+		{
+			name:     "empty date is a no-op",
+			body:     "Body",
+			expected: "Body",
+		},
+		{
+			name:        "invalid date",
+			body:        "Body",
+			date:        "March 2024",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withSimulatedDate(tt.body, tt.date)
+			if tt.expectError {
+				if err == nil {
+					t.Fatal("Expected error but got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestHydrateWithOptions_SimulatedDates tests that dated items are annotated, created in their configured order, and that an invalid date stops hydration
+func TestHydrateWithOptions_SimulatedDates(t *testing.T) {
+	tests := []struct {
+		name           string
+		issuesJSON     string
+		expectError    string
+		expectedTitles []string
+		expectedBodies []string
+	}{
+		{
+			name: "dated items are annotated in configured order",
+			issuesJSON: `[
+				{"title": "Later", "body": "Second", "simulated_date": "2024-05-10", "author": "octocat"},
+				{"title": "Undated", "body": "No date"},
+				{"title": "Earlier", "body": "First", "simulated_date": "2024-01-02"}
+			]`,
+			expectedTitles: []string{"Later", "Undated", "Earlier"},
+			expectedBodies: []string{
+				"_Reported by @octocat_\n\n_Originally posted on May 10, 2024_\n\nSecond",
+				"No date",
+				"_Originally posted on January 2, 2024_\n\nFirst",
+			},
+		},
+		{
+			name:        "invalid date stops hydration",
+			issuesJSON:  `[{"title": "Broken", "body": "Body", "simulated_date": "2024-13-01"}]`,
+			expectError: "issue 'Broken' has invalid simulated date '2024-13-01'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(tt.issuesJSON), 0644); err != nil {
+				t.Fatalf("Failed to create issues.json: %v", err)
+			}
+			cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+				filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+			client := NewSuccessfulMockGitHubClient()
+
+			err := HydrateWithOptions(context.Background(), client, cfg, HydrateOptions{IncludeIssues: true}, &testutil.MockLogger{})
+
+			if tt.expectError != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+					t.Fatalf("Expected error containing %q, got: %v", tt.expectError, err)
+				}
+				if len(client.CreatedIssues) != 0 {
+					t.Errorf("Expected no issues to be created, got %d", len(client.CreatedIssues))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(client.CreatedIssues) != len(tt.expectedTitles) {
+				t.Fatalf("Expected %d created issues, got %d", len(tt.expectedTitles), len(client.CreatedIssues))
+			}
+			for i, issue := range client.CreatedIssues {
+				if issue.Title != tt.expectedTitles[i] || issue.Body != tt.expectedBodies[i] {
+					t.Errorf("Issue %d: expected %q with body %q, got %q with body %q", i, tt.expectedTitles[i], tt.expectedBodies[i], issue.Title, issue.Body)
+				}
+			}
+		})
+	}
+}
+
+// TestAnnotateSimulatedDates tests that discussions and pull requests are annotated too
+func TestAnnotateSimulatedDates(t *testing.T) {
+	discussions := []types.Discussion{{Title: "Idea", Body: "Dark mode", SimulatedDate: "2024-02-29"}}
+	pullRequests := []types.PullRequest{{Title: "Fix", Body: "Fixes the crash", SimulatedDate: "2024-03-15"}}
+
+	if err := annotateSimulatedDates(nil, discussions, pullRequests); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if discussions[0].Body != "_Originally posted on February 29, 2024_\n\nDark mode" {
+		t.Errorf("Unexpected discussion body: %q", discussions[0].Body)
+	}
+	if pullRequests[0].Body != "_Originally posted on March 15, 2024_\n\nFixes the crash" {
+		t.Errorf("Unexpected pull request body: %q", pullRequests[0].Body)
+	}
+}
@@ -0,0 +1,74 @@
+package hydrate
+
+import (
+	"context"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// withUpsert wraps an issue create function so that an issue whose title matches an open issue
+// replaces the body, labels and assignees of that issue instead of creating another one. Open
+// issues are listed once, when the first issue is created.
+func withUpsert(create createFunc[types.Issue], client githubapi.GitHubClient, logger common.Logger) createFunc[types.Issue] {
+	var existing map[string]types.Issue
+
+	return func(ctx context.Context, issue types.Issue) (*types.CreatedItemInfo, error) {
+		if existing == nil {
+			issues, err := client.ListIssues(ctx)
+			if err != nil {
+				return nil, err
+			}
+			existing = issuesByTitle(issues)
+		}
+
+		current, found := existing[issue.Title]
+		if !found {
+			return create(ctx, issue)
+		}
+
+		logger.Debug("Issue '%s' already exists as #%d, updating it", issue.Title, current.Number)
+		info, err := client.UpdateIssue(ctx, current.NodeID, issue)
+		if err != nil {
+			return nil, err
+		}
+		info.Updated = true
+		return info, nil
+	}
+}
+
+// previewIssueUpserts lists the open issues for a dry run and logs the issues that would update one
+// of them. The issues that would still be created are returned along with the number that would update.
+func previewIssueUpserts(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, logger common.Logger) ([]types.Issue, int, error) {
+	if len(issues) == 0 {
+		return issues, 0, nil
+	}
+
+	existingIssues, err := client.ListIssues(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	existing := issuesByTitle(existingIssues)
+
+	remaining := make([]types.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if current, found := existing[issue.Title]; found {
+			logger.Info("Would update issue #%d: %s", current.Number, issue.Title)
+			continue
+		}
+		remaining = append(remaining, issue)
+	}
+	return remaining, len(issues) - len(remaining), nil
+}
+
+// issuesByTitle indexes issues by title, keeping the first issue listed for each title
+func issuesByTitle(issues []types.Issue) map[string]types.Issue {
+	byTitle := make(map[string]types.Issue, len(issues))
+	for _, issue := range issues {
+		if _, found := byTitle[issue.Title]; !found {
+			byTitle[issue.Title] = issue
+		}
+	}
+	return byTitle
+}
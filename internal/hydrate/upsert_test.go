@@ -0,0 +1,101 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestHydrateWithOptions_Upsert tests that issues are created when missing and updated when an open issue has the same title
+func TestHydrateWithOptions_Upsert(t *testing.T) {
+	tempDir := t.TempDir()
+	issuesJSON := `[
+		{"title": "Existing bug", "body": "New body", "labels": ["bug"], "assignees": ["octocat"]},
+		{"title": "New feature", "body": "Body"}
+	]`
+	if err := os.WriteFile(filepath.Join(tempDir, "issues.json"), []byte(issuesJSON), 0644); err != nil {
+		t.Fatalf("Failed to create issues.json: %v", err)
+	}
+	cfg := createConfigurationFromPaths(context.Background(), filepath.Join(tempDir, "issues.json"),
+		filepath.Join(tempDir, "discussions.json"), filepath.Join(tempDir, "prs.json"))
+
+	tests := []struct {
+		name            string
+		upsert          bool
+		dryRun          bool
+		expectedCreated []string
+		expectedUpdated []string
+		expectedCounts  DryRunCounts
+	}{
+		{
+			name:            "missing issue is created and existing issue is updated",
+			upsert:          true,
+			expectedCreated: []string{"New feature"},
+			expectedUpdated: []string{"existing-1"},
+		},
+		{
+			name:           "dry run counts the updated issue",
+			upsert:         true,
+			dryRun:         true,
+			expectedCounts: DryRunCounts{Type: "issues", Create: 1, Update: 1},
+		},
+		{
+			name:            "existing titles are duplicated without upsert",
+			expectedCreated: []string{"Existing bug", "New feature"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewSuccessfulMockGitHubClient()
+			existing := []types.Issue{{Title: "Existing bug", Body: "Old body", Labels: []string{"stale"}, NodeID: "existing-1", Number: 7}}
+			client.CreatedIssues = append(client.CreatedIssues, existing...)
+			logger := &testutil.MockLogger{}
+			options := HydrateOptions{IncludeIssues: true, Upsert: tt.upsert, DryRun: tt.dryRun}
+			summary := NewDryRunSummary()
+			if tt.dryRun {
+				options.DryRunSummary = summary
+			}
+
+			if err := HydrateWithOptions(context.Background(), client, cfg, options, logger); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			if tt.dryRun {
+				if len(summary.Counts) == 0 || summary.Counts[0] != tt.expectedCounts {
+					t.Errorf("Expected counts %+v, got %+v", tt.expectedCounts, summary.Counts)
+				}
+				if len(client.UpdatedIssues) != 0 {
+					t.Errorf("Expected no updates in a dry run, got %v", client.UpdatedIssues)
+				}
+				return
+			}
+			var created []string
+			for _, issue := range client.CreatedIssues[len(existing):] {
+				created = append(created, issue.Title)
+			}
+			if !reflect.DeepEqual(created, tt.expectedCreated) {
+				t.Errorf("Expected created issues %v, got %v", tt.expectedCreated, created)
+			}
+			if !reflect.DeepEqual(client.UpdatedIssues, tt.expectedUpdated) {
+				t.Errorf("Expected updated issues %v, got %v", tt.expectedUpdated, client.UpdatedIssues)
+			}
+			if !tt.upsert {
+				return
+			}
+			updated := client.CreatedIssues[0]
+			if updated.Body != "New body" || !reflect.DeepEqual(updated.Labels, []string{"bug"}) || !reflect.DeepEqual(updated.Assignees, []string{"octocat"}) {
+				t.Errorf("Expected existing issue to be updated, got %+v", updated)
+			}
+			if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Updated issue #7") {
+				t.Errorf("Expected update to be logged, got: %v", logger.InfoCalls)
+			}
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// ValidateContent runs the Validate method of every item and label and reports all problems at
+// once, naming the file and position of each invalid entry. labelsPath names the file the labels
+// were read from. It returns nil when everything is valid.
+func ValidateContent(issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, labels []types.Label, labelsPath string) error {
+	var problems []string
+	for i, issue := range issues {
+		if err := issue.Validate(); err != nil {
+			problems = append(problems, validationProblem("issue", issue.Title, i, issue, err))
+		}
+	}
+	for i, discussion := range discussions {
+		if err := discussion.Validate(); err != nil {
+			problems = append(problems, validationProblem("discussion", discussion.Title, i, discussion, err))
+		}
+	}
+	for i, pullRequest := range pullRequests {
+		if err := pullRequest.Validate(); err != nil {
+			problems = append(problems, validationProblem("pull request", pullRequest.Title, i, pullRequest, err))
+		}
+	}
+	for i, label := range labels {
+		if err := label.Validate(); err != nil {
+			problems = append(problems, common.FormatCreationError("label", label.Name, i, labelsPath, err))
+		}
+	}
+	if _, err := orderIssuesByDependencies(issues); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.ValidationError("validate_content", fmt.Sprintf("%d invalid entries:\n  - %s", len(problems), strings.Join(problems, "\n  - ")))
+}
+
+// ValidateWithOptions runs the offline part of HydrateWithOptions without creating anything and
+// without contacting GitHub: it loads the included content, labels and cross-references, validates
+// every entry and then runs the preflight checks on label colors, item counts and body lengths with
+// the title prefix, dates and metadata footer applied as a real run would apply them. A labels
+// file given as a URL is still fetched, since that is not a GitHub API call.
+func ValidateWithOptions(ctx context.Context, cfg *config.Configuration, options HydrateOptions, logger common.Logger) error {
+	issues, discussions, pullRequests, err := HydrateFromConfiguration(ctx, cfg, options.IncludeIssues, options.IncludeDiscussions, options.IncludePullRequests)
+	if err != nil {
+		return errors.ConfigError("load_config_files", "failed to load configuration files", err)
+	}
+	labels, err := readLabels(ctx, cfg.LabelsPath, options.APITimeout)
+	if err != nil {
+		err = errors.WrapWithOperation(err, "config", "read_labels_config", "failed to read labels configuration")
+		return errors.WithContextSafe(err, "path", cfg.LabelsPath)
+	}
+	crossReferences, err := config.LoadCrossReferences(ctx, cfg.CrossReferencesPath)
+	if err != nil {
+		return errors.WithContextSafe(err, "path", cfg.CrossReferencesPath)
+	}
+	if options.SeedFiles {
+		if _, err := config.LoadSeedFiles(ctx, cfg.FilesPath); err != nil {
+			return errors.WithContextSafe(err, "path", cfg.FilesPath)
+		}
+	}
+
+	if err := ValidateContent(issues, discussions, pullRequests, labels, cfg.LabelsPath); err != nil {
+		return err
+	}
+	if err := checkLabelColors(labels, options.Strict, logger); err != nil {
+		return err
+	}
+
+	applyTitlePrefix(options.TitlePrefix, issues, discussions, pullRequests)
+	if err := annotateSimulatedDates(issues, discussions, pullRequests); err != nil {
+		return err
+	}
+	annotateAuthors(issues, discussions, pullRequests)
+	if err := checkItemCounts(issues, discussions, pullRequests, options.MaxItemsPerType, options.Force, logger); err != nil {
+		return err
+	}
+	appendMetadata(options.Metadata, issues, discussions, pullRequests)
+	if err := checkBodyLengths(ctx, issues, discussions, pullRequests, options.TruncateBodies, logger); err != nil {
+		return err
+	}
+
+	logger.Info("Validation passed: %d issues, %d discussions, %d pull requests, %d labels, %d cross-references; nothing was created",
+		len(issues), len(discussions), len(pullRequests), len(labels), len(crossReferences))
+	return nil
+}
+
+// validationProblem describes an invalid content item, preferring its position in the file it was loaded from
+func validationProblem(itemType, title string, index int, item any, err error) string {
+	source := ""
+	if file, sourceIndex, ok := itemSource(item); ok {
+		source, index = file, sourceIndex
+	}
+	return common.FormatCreationError(itemType, title, index, source, err)
+}
+
+// ResolveReferences checks against the repository that the labels, discussion categories and
+// assignees referenced by the content exist, and reports every unresolved reference at once. Labels
+// defined in labels are not reported, since hydration creates them. It only reads from the repository.
+func ResolveReferences(ctx context.Context, client githubapi.GitHubClient, issues []types.Issue, discussions []types.Discussion, pullRequests []types.PullRequest, labels []types.Label) error {
+	labelRefs, categoryRefs, userRefs := newReferences(), newReferences(), newReferences()
+	for _, issue := range issues {
+		referrer := fmt.Sprintf("issue '%s'", issue.Title)
+		labelRefs.add(issue.Labels, referrer)
+		userRefs.add(issue.Assignees, referrer)
+	}
+	for _, discussion := range discussions {
+		referrer := fmt.Sprintf("discussion '%s'", discussion.Title)
+		labelRefs.add(discussion.Labels, referrer)
+		if discussion.Category != "" {
+			categoryRefs.add([]string{discussion.Category}, referrer)
+		}
+	}
+	for _, pullRequest := range pullRequests {
+		referrer := fmt.Sprintf("pull request '%s'", pullRequest.Title)
+		labelRefs.add(pullRequest.Labels, referrer)
+		userRefs.add(pullRequest.Assignees, referrer)
+	}
+
+	var problems []string
+
+	if len(labelRefs.names) > 0 {
+		existing, err := client.ListLabels(ctx)
+		if err != nil {
+			return err
+		}
+		known := make(map[string]bool, len(existing)+len(labels))
+		for _, name := range existing {
+			known[name] = true
+		}
+		for _, label := range labels {
+			known[label.Name] = true
+		}
+		for _, name := range labelRefs.names {
+			if !known[name] {
+				problems = append(problems, labelRefs.problem("label", name, "does not exist in the repository or labels.json"))
+			}
+		}
+	}
+
+	if len(categoryRefs.names) > 0 {
+		categories, err := client.ListDiscussionCategories(ctx)
+		if err != nil {
+			return err
+		}
+		for _, name := range categoryRefs.names {
+			found := false
+			for _, category := range categories {
+				if strings.EqualFold(category, name) {
+					found = true
+					break
+				}
+			}
+			if !found {
+				reason := fmt.Sprintf("does not exist (available: %s)", strings.Join(categories, ", "))
+				if len(categories) == 0 {
+					reason = "does not exist because the repository has no discussion categories"
+				}
+				problems = append(problems, categoryRefs.problem("discussion category", name, reason))
+			}
+		}
+	}
+
+	for _, login := range userRefs.names {
+		// The authenticated user always exists
+		if login == config.ViewerLogin {
+			continue
+		}
+		exists, err := client.UserExists(ctx, login)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			problems = append(problems, userRefs.problem("assignee", login, "is not a GitHub user"))
+		}
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return errors.ValidationError("resolve_references", fmt.Sprintf("%d unresolved references:\n  - %s", len(problems), strings.Join(problems, "\n  - ")))
+}
+
+// references records the items referring to each name, keeping names in the order first seen
+type references struct {
+	names     []string
+	referrers map[string][]string
+}
+
+// newReferences returns an empty set of references
+func newReferences() *references {
+	return &references{referrers: make(map[string][]string)}
+}
+
+// add records referrer as referring to each of names
+func (r *references) add(names []string, referrer string) {
+	for _, name := range names {
+		if _, seen := r.referrers[name]; !seen {
+			r.names = append(r.names, name)
+		}
+		r.referrers[name] = append(r.referrers[name], referrer)
+	}
+}
+
+// problem describes an unresolved name and the items that refer to it
+func (r *references) problem(kind, name, reason string) string {
+	return fmt.Sprintf("%s '%s' %s (used by %s)", kind, name, reason, strings.Join(r.referrers[name], ", "))
+}
@@ -0,0 +1,203 @@
+package hydrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/config"
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestValidateContent tests that every invalid entry is reported with its file and position
+func TestValidateContent(t *testing.T) {
+	tests := []struct {
+		name             string
+		issues           []types.Issue
+		discussions      []types.Discussion
+		pullRequests     []types.PullRequest
+		labels           []types.Label
+		expectError      bool
+		expectedProblems []string
+	}{
+		{
+			name:         "valid content",
+			issues:       []types.Issue{{Title: "Bug"}},
+			discussions:  []types.Discussion{{Title: "Idea"}},
+			pullRequests: []types.PullRequest{{Title: "Feature", Head: "feature", Base: "main"}},
+			labels:       []types.Label{{Name: "bug", Color: "d73a4a"}},
+		},
+		{
+			name:             "dependency cycle",
+			issues:           []types.Issue{{Title: "A", DependsOnTitles: []string{"B"}}, {Title: "B", DependsOnTitles: []string{"A"}}},
+			expectError:      true,
+			expectedProblems: []string{"1 invalid entries", "issue dependencies form a cycle: A -> B -> A"},
+		},
+		{
+			name:             "invalid entries reported together",
+			issues:           []types.Issue{{Title: "Bug"}, {Title: "", SourceFile: "issues.json", SourceIndex: 1}},
+			pullRequests:     []types.PullRequest{{Title: "Feature", SourceFile: "prs.json"}},
+			labels:           []types.Label{{Name: "bug", Color: "#d73a4a"}},
+			expectError:      true,
+			expectedProblems: []string{"3 invalid entries", "issue 2 () from issues.json", "pull request 1 (Feature) from prs.json", "label 1 (bug) from labels.json"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateContent(tt.issues, tt.discussions, tt.pullRequests, tt.labels, "labels.json")
+
+			if !tt.expectError {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			for _, expected := range tt.expectedProblems {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+// TestResolveReferences tests that labels, categories and assignees missing from the repository are reported
+func TestResolveReferences(t *testing.T) {
+	tests := []struct {
+		name             string
+		config           MockConfig
+		issues           []types.Issue
+		discussions      []types.Discussion
+		pullRequests     []types.PullRequest
+		labels           []types.Label
+		expectError      bool
+		expectedProblems []string
+	}{
+		{
+			name:         "all references resolve",
+			config:       MockConfig{ExistingLabels: map[string]bool{"bug": true}},
+			issues:       []types.Issue{{Title: "Bug", Labels: []string{"bug", "defined"}, Assignees: []string{"octocat"}}},
+			discussions:  []types.Discussion{{Title: "Idea", Category: "general"}, {Title: "Uncategorized"}},
+			pullRequests: []types.PullRequest{{Title: "Fix", Labels: []string{"bug"}}},
+			labels:       []types.Label{{Name: "defined", Color: "ededed"}},
+		},
+		{
+			name: "unresolved references reported together",
+			config: MockConfig{
+				ExistingLabels:       map[string]bool{"bug": true},
+				DiscussionCategories: []string{"General", "Q&A"},
+				MissingUsers:         map[string]bool{"ghost": true},
+			},
+			issues:       []types.Issue{{Title: "Bug", Labels: []string{"bug", "missing"}, Assignees: []string{"ghost"}}},
+			discussions:  []types.Discussion{{Title: "Idea", Category: "Ideas"}},
+			pullRequests: []types.PullRequest{{Title: "Fix", Labels: []string{"missing"}, Assignees: []string{"octocat", "ghost"}}},
+			expectError:  true,
+			expectedProblems: []string{
+				"3 unresolved references",
+				"label 'missing' does not exist in the repository or labels.json (used by issue 'Bug', pull request 'Fix')",
+				"discussion category 'Ideas' does not exist (available: General, Q&A) (used by discussion 'Idea')",
+				"assignee 'ghost' is not a GitHub user (used by issue 'Bug', pull request 'Fix')",
+			},
+		},
+		{
+			name:   "@me is not looked up",
+			config: MockConfig{MissingUsers: map[string]bool{"@me": true}},
+			issues: []types.Issue{{Title: "Bug", Assignees: []string{"@me"}}},
+		},
+		{
+			name:             "repository without categories",
+			config:           MockConfig{DiscussionCategories: []string{}},
+			discussions:      []types.Discussion{{Title: "Idea", Category: "General"}},
+			expectError:      true,
+			expectedProblems: []string{"discussion category 'General' does not exist because the repository has no discussion categories"},
+		},
+		{
+			name:             "lookup failure is returned",
+			config:           MockConfig{UserLookup: testutil.ErrorConfig{ShouldError: true}},
+			issues:           []types.Issue{{Title: "Bug", Assignees: []string{"octocat"}}},
+			expectError:      true,
+			expectedProblems: []string{"simulated user lookup failure for: octocat"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.config)
+
+			err := ResolveReferences(context.Background(), client, tt.issues, tt.discussions, tt.pullRequests, tt.labels)
+
+			if !tt.expectError {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			for _, expected := range tt.expectedProblems {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+// TestValidateWithOptions tests that loading, validation and the preflight checks run without creating anything
+func TestValidateWithOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		issues      string
+		options     HydrateOptions
+		expectError string
+	}{
+		{
+			name:    "valid configuration",
+			issues:  `[{"title": "Bug"}, {"title": "Feature"}]`,
+			options: HydrateOptions{IncludeIssues: true},
+		},
+		{
+			name:        "invalid entry",
+			issues:      `[{"title": ""}]`,
+			options:     HydrateOptions{IncludeIssues: true},
+			expectError: "title cannot be empty",
+		},
+		{
+			name:        "preflight item limit",
+			issues:      `[{"title": "Bug"}, {"title": "Feature"}]`,
+			options:     HydrateOptions{IncludeIssues: true, MaxItemsPerType: 1},
+			expectError: "configuration would create 2 issues, more than the limit of 1 per type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			issuesPath := filepath.Join(tempDir, config.IssuesFilename)
+			if err := os.WriteFile(issuesPath, []byte(tt.issues), 0644); err != nil {
+				t.Fatalf("Failed to create issues.json: %v", err)
+			}
+			cfg := config.NewConfiguration(context.Background(), tempDir)
+
+			err := ValidateWithOptions(context.Background(), cfg, tt.options, &testutil.MockLogger{})
+
+			if tt.expectError == "" {
+				if err != nil {
+					t.Errorf("Unexpected error: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.expectError) {
+				t.Errorf("Expected error containing %q, got: %v", tt.expectError, err)
+			}
+		})
+	}
+}
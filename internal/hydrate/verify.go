@@ -0,0 +1,115 @@
+package hydrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chrisreddington/gh-demo/internal/common"
+	"github.com/chrisreddington/gh-demo/internal/errors"
+	"github.com/chrisreddington/gh-demo/internal/githubapi"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// VerifyCreated re-fetches every item report records as created, and the project created for the
+// run, and confirms that each still exists with the title it was created with. Issues and pull
+// requests are fetched by number, discussions are matched by number in one listing, and the project
+// by node ID. It returns a validation error listing every discrepancy, so that an API response that
+// claimed success for an item GitHub does not show is not mistaken for a complete run.
+func VerifyCreated(ctx context.Context, client githubapi.GitHubClient, report *RunReport, logger common.Logger) error {
+	if report == nil {
+		return nil
+	}
+
+	report.mu.Lock()
+	var items []CreatedItem
+	for _, section := range report.Sections {
+		items = append(items, section.Created...)
+	}
+	projectID := report.ProjectID
+	report.mu.Unlock()
+
+	var discussions map[int]types.Discussion
+	var problems []string
+	verified := 0
+	for _, item := range items {
+		if err := ctx.Err(); err != nil {
+			return errors.ContextError("verify_created", err)
+		}
+		if item.Number <= 0 {
+			problems = append(problems, fmt.Sprintf("%s '%s' was created without a number and cannot be fetched", describeManifestType(item.Type), item.Title))
+			continue
+		}
+
+		var title string
+		var err error
+		switch item.Type {
+		case "issue":
+			var issue *types.Issue
+			if issue, err = client.GetIssue(ctx, item.Number); err == nil {
+				title = issue.Title
+			}
+		case "pull_request":
+			var pullRequest *types.PullRequest
+			if pullRequest, err = client.GetPullRequest(ctx, item.Number); err == nil {
+				title = pullRequest.Title
+			}
+		case "discussion":
+			if discussions == nil {
+				if discussions, err = listDiscussionsByNumber(ctx, client); err != nil {
+					break
+				}
+			}
+			discussion, found := discussions[item.Number]
+			if !found {
+				err = fmt.Errorf("discussion #%d not found", item.Number)
+			}
+			title = discussion.Title
+		default:
+			continue
+		}
+
+		if err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			problems = append(problems, fmt.Sprintf("%s #%d '%s' could not be fetched: %v", describeManifestType(item.Type), item.Number, item.Title, err))
+			continue
+		}
+		if title != item.Title {
+			problems = append(problems, fmt.Sprintf("%s #%d is titled '%s', expected '%s'", describeManifestType(item.Type), item.Number, title, item.Title))
+			continue
+		}
+		verified++
+	}
+
+	if projectID != "" {
+		if _, err := client.GetProjectV2(ctx, projectID); err != nil {
+			if errors.IsContextError(err) {
+				return err
+			}
+			problems = append(problems, fmt.Sprintf("project %s could not be fetched: %v", projectID, err))
+		} else {
+			verified++
+		}
+	}
+
+	if len(problems) > 0 {
+		return errors.ValidationError("verify_created", fmt.Sprintf("%d created items failed verification:\n  - %s", len(problems), strings.Join(problems, "\n  - ")))
+	}
+	logger.Info("Verified %d created items", verified)
+	return nil
+}
+
+// listDiscussionsByNumber maps the numbers of the repository's open discussions to the discussions
+func listDiscussionsByNumber(ctx context.Context, client githubapi.GitHubClient) (map[int]types.Discussion, error) {
+	discussions, err := client.ListDiscussions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byNumber := make(map[int]types.Discussion, len(discussions))
+	for _, discussion := range discussions {
+		byNumber[discussion.Number] = discussion
+	}
+	return byNumber, nil
+}
@@ -0,0 +1,90 @@
+package hydrate
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/chrisreddington/gh-demo/internal/testutil"
+	"github.com/chrisreddington/gh-demo/internal/types"
+)
+
+// TestVerifyCreated tests that created items are fetched again and discrepancies are reported together
+func TestVerifyCreated(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockConfig       MockConfig
+		tamper           func(client *ConfigurableMockGitHubClient)
+		projectID        string
+		expectedProblems []string
+	}{
+		{
+			name:      "every item matches",
+			projectID: "project-1",
+		},
+		{
+			name: "missing item",
+			tamper: func(client *ConfigurableMockGitHubClient) {
+				client.CreatedIssues = client.CreatedIssues[:0]
+			},
+			expectedProblems: []string{"1 created items failed verification", "issue #1 'Bug' could not be fetched: issue #1 not found"},
+		},
+		{
+			name: "changed titles",
+			tamper: func(client *ConfigurableMockGitHubClient) {
+				client.CreatedDiscussions[0].Title = "Renamed"
+				client.CreatedPRs[0].Title = "Other"
+			},
+			expectedProblems: []string{"2 created items failed verification", "discussion #1 is titled 'Renamed', expected 'Idea'", "pull request #1 is titled 'Other', expected 'Feature'"},
+		},
+		{
+			name:             "missing project",
+			mockConfig:       MockConfig{FailProjectRetrieval: true},
+			projectID:        "project-1",
+			expectedProblems: []string{"project project-1 could not be fetched"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewFailingMockGitHubClient(tt.mockConfig)
+			report := NewRunReport()
+			options := HydrateOptions{IncludeIssues: true, IncludeDiscussions: true, IncludePullRequests: true, Report: report}
+			_, err := createRepositoryContent(context.Background(), client,
+				[]types.Issue{{Title: "Bug"}},
+				[]types.Discussion{{Title: "Idea", Category: "General"}},
+				[]types.PullRequest{{Title: "Feature", Head: "feature", Base: "main"}},
+				options, &testutil.MockLogger{})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if tt.projectID != "" {
+				report.RecordProject(&types.ProjectV2{ID: tt.projectID})
+			}
+			if tt.tamper != nil {
+				tt.tamper(client)
+			}
+
+			logger := &testutil.MockLogger{}
+			err = VerifyCreated(context.Background(), client, report, logger)
+
+			if len(tt.expectedProblems) == 0 {
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+				if !strings.Contains(strings.Join(logger.InfoCalls, "\n"), "Verified") {
+					t.Errorf("Expected a verification message, got: %v", logger.InfoCalls)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			for _, expected := range tt.expectedProblems {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("Expected error to contain %q, got: %v", expected, err)
+				}
+			}
+		})
+	}
+}
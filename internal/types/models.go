@@ -2,6 +2,8 @@
 // This package centralizes all data structures to avoid duplication and ensure consistency.
 package types
 
+import "time"
+
 // Issue represents an issue that can be created in a GitHub repository.
 // It contains all the fields that can be specified when creating an issue via the GitHub API.
 type Issue struct {
@@ -11,30 +13,79 @@ type Issue struct {
 	Body      string   `json:"body"`
 	Labels    []string `json:"labels"`
 	Assignees []string `json:"assignees"`
+	Projects  []int    `json:"projects,omitempty"` // ProjectV2 numbers to add the item to after creation
+	State     string   `json:"state,omitempty"`    // OPEN or CLOSED; only set when read from GitHub
+
+	DuplicateOfTitle string `json:"duplicate_of,omitempty"`   // Title of an open issue this one is closed as a duplicate of
+	Template         string `json:"template,omitempty"`       // Issue template whose title prefix and labels apply, by name or file name
+	Author           string `json:"author,omitempty"`         // Login credited at the start of the body, since GitHub attributes the item to the token's user
+	SimulatedDate    string `json:"simulated_date,omitempty"` // Date (YYYY-MM-DD) noted at the start of the body, since the creation date cannot be set
+	SourceFile       string `json:"-"`                        // Content file the item was loaded from, used in error messages
+	SourceIndex      int    `json:"-"`                        // Zero-based position of the item in SourceFile
+
+	DependsOnTitles    []string `json:"depends_on,omitempty"`    // Titles of issues created before this one, optionally listed as "Blocked by #N"
+	EpicChildrenTitles []string `json:"epic_children,omitempty"` // Titles of issues listed in this one's body as a "- [ ] #N" task list once all issues exist
 }
 
 // Discussion represents a discussion that can be created in a GitHub repository.
 // It contains all the fields that can be specified when creating a discussion via the GitHub API.
 type Discussion struct {
-	NodeID   string   `json:"node_id,omitempty"` // GitHub node ID for deletion operations
-	Number   int      `json:"number,omitempty"`  // Discussion number for identification
-	Title    string   `json:"title"`
-	Body     string   `json:"body"`
-	Category string   `json:"category"`
-	Labels   []string `json:"labels"`
+	NodeID        string   `json:"node_id,omitempty"` // GitHub node ID for deletion operations
+	Number        int      `json:"number,omitempty"`  // Discussion number for identification
+	Title         string   `json:"title"`
+	Body          string   `json:"body"`
+	Category      string   `json:"category"`
+	Labels        []string `json:"labels"`
+	State         string   `json:"state,omitempty"`          // OPEN or CLOSED; only set when read from GitHub
+	Author        string   `json:"author,omitempty"`         // Login credited at the start of the body, since GitHub attributes the item to the token's user
+	SimulatedDate string   `json:"simulated_date,omitempty"` // Date (YYYY-MM-DD) noted at the start of the body, since the creation date cannot be set
+	SourceFile    string   `json:"-"`                        // Content file the item was loaded from, used in error messages
+	SourceIndex   int      `json:"-"`                        // Zero-based position of the item in SourceFile
 }
 
 // PullRequest represents a pull request that can be created in a GitHub repository.
 // It contains all the fields that can be specified when creating a pull request via the GitHub API.
 type PullRequest struct {
-	NodeID    string   `json:"node_id,omitempty"` // GitHub node ID for deletion operations
-	Number    int      `json:"number,omitempty"`  // Pull request number for identification
-	Title     string   `json:"title"`
-	Body      string   `json:"body"`
-	Head      string   `json:"head"`
-	Base      string   `json:"base"`
-	Labels    []string `json:"labels"`
-	Assignees []string `json:"assignees"`
+	NodeID          string     `json:"node_id,omitempty"` // GitHub node ID for deletion operations
+	Number          int        `json:"number,omitempty"`  // Pull request number for identification
+	Title           string     `json:"title"`
+	Body            string     `json:"body"`
+	Head            string     `json:"head"`
+	Base            string     `json:"base"`
+	Labels          []string   `json:"labels"`
+	Assignees       []string   `json:"assignees"`
+	Projects        []int      `json:"projects,omitempty"`          // ProjectV2 numbers to add the item to after creation
+	AutoMerge       bool       `json:"auto_merge,omitempty"`        // Enable auto-merge after creation
+	AutoMergeMethod string     `json:"auto_merge_method,omitempty"` // MERGE, SQUASH or REBASE (default: repository default)
+	Reviews         []PRReview `json:"reviews,omitempty"`           // Reviews submitted after creation, in order
+	Author          string     `json:"author,omitempty"`            // Login credited at the start of the body, since GitHub attributes the item to the token's user
+	SimulatedDate   string     `json:"simulated_date,omitempty"`    // Date (YYYY-MM-DD) noted at the start of the body, since the creation date cannot be set
+	State           string     `json:"state,omitempty"`             // OPEN, CLOSED or MERGED; only set when read from GitHub
+	SourceFile      string     `json:"-"`                           // Content file the item was loaded from, used in error messages
+	SourceIndex     int        `json:"-"`                           // Zero-based position of the item in SourceFile
+}
+
+// PRReview is a review submitted on a pull request after it is created
+type PRReview struct {
+	Body  string `json:"body"`
+	Event string `json:"event"` // COMMENT, APPROVE or REQUEST_CHANGES (default: COMMENT)
+}
+
+// CrossReference is a comment posted on an issue or pull request after all content is created,
+// linking it to another issue or pull request by number. Both are named by title.
+type CrossReference struct {
+	From string `json:"from"`           // Title of the issue or pull request the comment is posted on
+	To   string `json:"to"`             // Title of the issue or pull request the comment references
+	Body string `json:"body,omitempty"` // Comment text, where {ref} is replaced by "#N" (default: "Related to {ref}")
+}
+
+// SeedFile is a file written to the repository's default branch before content is created,
+// such as a README that introduces the demo. Its content is given inline or read from a source file.
+type SeedFile struct {
+	Path    string `json:"path"`              // Path of the file in the repository
+	Content string `json:"content,omitempty"` // Content of the file
+	Source  string `json:"source,omitempty"`  // Local file the content is read from, relative to files.json
+	Message string `json:"message,omitempty"` // Commit message (default: "Add <path>" or "Update <path>")
 }
 
 // Label represents a label that can be created in a GitHub repository.
@@ -60,6 +111,13 @@ type ProjectV2 struct {
 	URL         string `json:"url,omitempty"`         // Project URL
 }
 
+// ProjectV2Item represents an item on a ProjectV2 board, identified by its project item ID
+// rather than the node ID of the issue, pull request or draft it wraps.
+type ProjectV2Item struct {
+	ID    string `json:"id"`              // Project item ID used to remove the item from the board
+	Title string `json:"title,omitempty"` // Title of the underlying content, when available
+}
+
 // ProjectV2Configuration defines the configuration for creating a ProjectV2.
 // It provides options for customizing project creation with sensible defaults.
 type ProjectV2Configuration struct {
@@ -73,6 +131,7 @@ type ProjectV2Configuration struct {
 
 // ProjectV2Field represents a custom field that can be added to a project.
 type ProjectV2Field struct {
+	ID          string                 `json:"id,omitempty"`          // GitHub node ID, set for fields read from an existing project
 	Name        string                 `json:"name"`                  // Field name
 	Type        string                 `json:"type"`                  // Field type (text, number, date, single_select, etc.)
 	Description string                 `json:"description,omitempty"` // Field description
@@ -81,6 +140,7 @@ type ProjectV2Field struct {
 
 // ProjectV2FieldOption represents an option for select-type project fields.
 type ProjectV2FieldOption struct {
+	ID          string `json:"id,omitempty"`          // GitHub option ID, set for options read from an existing project
 	Name        string `json:"name"`                  // Option name
 	Description string `json:"description,omitempty"` // Option description
 	Color       string `json:"color,omitempty"`       // Option color
@@ -119,9 +179,57 @@ type ProjectV2ItemTemplate struct {
 
 // CreatedItemInfo represents information about a successfully created GitHub item.
 type CreatedItemInfo struct {
-	NodeID string // The GitHub node ID of the created item
-	Title  string // The title of the created item
-	Type   string // The type of item (issue, discussion, pull_request)
-	Number int    // The GitHub number of the created item
-	URL    string // The URL to the created item
+	NodeID  string // The GitHub node ID of the created item
+	Title   string // The title of the created item
+	Type    string // The type of item (issue, discussion, pull_request)
+	Number  int    // The GitHub number of the created item
+	URL     string // The URL to the created item
+	Updated bool   // True when an existing item was updated instead of created
+}
+
+// ViewerInfo describes the authenticated user and their access to the target repository.
+type ViewerInfo struct {
+	Login                string // The login of the authenticated user
+	RepositoryPermission string // The viewer's permission on the repository (ADMIN, MAINTAIN, WRITE, TRIAGE, READ)
+}
+
+// IssueTemplate is the front matter of a Markdown issue template in the repository's
+// .github/ISSUE_TEMPLATE directory.
+type IssueTemplate struct {
+	File   string   // File name of the template, e.g. "bug_report.md"
+	Name   string   // Name shown in the template chooser
+	Title  string   // Default title, used as a prefix for issue titles
+	Labels []string // Labels applied to issues created from the template
+}
+
+// CodeownersRule is one line of the repository's CODEOWNERS file: a path pattern and the users,
+// teams ("org/team") and email addresses that own the matching paths, without their "@" prefix.
+type CodeownersRule struct {
+	Pattern string
+	Owners  []string
+}
+
+// Branch is a branch of the repository found when pruning demo branches.
+type Branch struct {
+	NodeID    string // Node ID of the branch's ref, used to delete it
+	Name      string // Branch name without the refs/heads/ prefix
+	Protected bool   // A branch protection rule applies to the branch
+}
+
+// RepositoryFeatures reports which repository features used by hydration are enabled, and whether
+// the repository accepts writes at all.
+type RepositoryFeatures struct {
+	HasIssuesEnabled      bool // Issues are enabled on the repository
+	HasDiscussionsEnabled bool // Discussions are enabled on the repository
+	HasProjectsEnabled    bool // Projects are enabled on the repository
+	IsArchived            bool // The repository is archived and read-only
+	IsLocked              bool // The repository is locked, for example during a migration
+}
+
+// RateLimit describes the GraphQL rate limit budget of the authenticated user.
+type RateLimit struct {
+	Limit     int       `json:"limit"`     // Maximum points available per window
+	Remaining int       `json:"remaining"` // Points remaining in the current window
+	Cost      int       `json:"cost"`      // Points consumed by the rate limit query itself
+	ResetAt   time.Time `json:"reset_at"`  // When the current window resets
 }
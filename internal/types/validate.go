@@ -0,0 +1,153 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chrisreddington/gh-demo/internal/errors"
+)
+
+// labelColorPattern matches a six digit hexadecimal color without the # prefix, as GitHub expects
+var labelColorPattern = regexp.MustCompile(`^[0-9a-fA-F]{6}$`)
+
+// autoMergeMethods are the merge methods GitHub accepts when enabling auto-merge
+var autoMergeMethods = map[string]bool{
+	"MERGE":  true,
+	"SQUASH": true,
+	"REBASE": true,
+}
+
+// ReviewEvents are the events GitHub accepts when submitting a pull request review
+var ReviewEvents = map[string]bool{
+	"COMMENT":         true,
+	"APPROVE":         true,
+	"REQUEST_CHANGES": true,
+}
+
+// SimulatedDateLayout is the layout of the simulated_date field of issues, discussions and pull requests
+const SimulatedDateLayout = "2006-01-02"
+
+// Validate checks that the issue has a title, that its labels and assignees are not blank, and that
+// any simulated date is a valid date.
+func (i Issue) Validate() error {
+	if strings.TrimSpace(i.Title) == "" {
+		return errors.ValidationError("validate_issue", "title cannot be empty")
+	}
+	if err := validateSimulatedDate("validate_issue", i.SimulatedDate); err != nil {
+		return err
+	}
+	if err := validateNames("validate_issue", "label", i.Labels); err != nil {
+		return err
+	}
+	return validateNames("validate_issue", "assignee", i.Assignees)
+}
+
+// Validate checks that the discussion has a title, that its labels are not blank, and that any
+// simulated date is a valid date. The category may be empty, since a default category can be
+// configured for the run.
+func (d Discussion) Validate() error {
+	if strings.TrimSpace(d.Title) == "" {
+		return errors.ValidationError("validate_discussion", "title cannot be empty")
+	}
+	if err := validateSimulatedDate("validate_discussion", d.SimulatedDate); err != nil {
+		return err
+	}
+	return validateNames("validate_discussion", "label", d.Labels)
+}
+
+// Validate checks that the pull request has a title and a head branch that differs from its base,
+// that its labels and assignees are not blank, that any auto-merge method and review event is one GitHub accepts,
+// and that any simulated date is a valid date.
+// The base may be empty, since a default base branch can be configured for the run.
+func (p PullRequest) Validate() error {
+	if strings.TrimSpace(p.Title) == "" {
+		return errors.ValidationError("validate_pr", "title cannot be empty")
+	}
+	if strings.TrimSpace(p.Head) == "" {
+		return errors.ValidationError("validate_pr", "head branch cannot be empty")
+	}
+	if p.Head == p.Base {
+		return errors.ValidationError("validate_pr", fmt.Sprintf("head and base branches cannot be the same (%s)", p.Head))
+	}
+	if p.AutoMergeMethod != "" && !autoMergeMethods[strings.ToUpper(p.AutoMergeMethod)] {
+		return errors.ValidationError("validate_pr", fmt.Sprintf("invalid auto-merge method '%s' (must be MERGE, SQUASH or REBASE)", p.AutoMergeMethod))
+	}
+	if err := validateSimulatedDate("validate_pr", p.SimulatedDate); err != nil {
+		return err
+	}
+	for _, review := range p.Reviews {
+		if review.Event != "" && !ReviewEvents[strings.ToUpper(review.Event)] {
+			return errors.ValidationError("validate_pr", fmt.Sprintf("invalid review event '%s' (must be COMMENT, APPROVE or REQUEST_CHANGES)", review.Event))
+		}
+	}
+	if err := validateNames("validate_pr", "label", p.Labels); err != nil {
+		return err
+	}
+	return validateNames("validate_pr", "assignee", p.Assignees)
+}
+
+// CrossReferencePlaceholder is replaced by the referenced item's "#N" in the body of a cross-reference
+const CrossReferencePlaceholder = "{ref}"
+
+// Validate checks that the cross-reference names both items, that they differ, and that a custom
+// body says where the reference goes.
+func (c CrossReference) Validate() error {
+	if strings.TrimSpace(c.From) == "" || strings.TrimSpace(c.To) == "" {
+		return errors.ValidationError("validate_cross_reference", "from and to titles cannot be empty")
+	}
+	if c.From == c.To {
+		return errors.ValidationError("validate_cross_reference", fmt.Sprintf("'%s' cannot reference itself", c.From))
+	}
+	if c.Body != "" && !strings.Contains(c.Body, CrossReferencePlaceholder) {
+		return errors.ValidationError("validate_cross_reference", fmt.Sprintf("body of the reference from '%s' to '%s' must contain %s", c.From, c.To, CrossReferencePlaceholder))
+	}
+	return nil
+}
+
+// Validate checks that the file has a relative path and exactly one of content and source.
+func (f SeedFile) Validate() error {
+	if strings.TrimSpace(f.Path) == "" {
+		return errors.ValidationError("validate_seed_file", "path cannot be empty")
+	}
+	if strings.HasPrefix(f.Path, "/") {
+		return errors.ValidationError("validate_seed_file", fmt.Sprintf("path '%s' must be relative to the repository root", f.Path))
+	}
+	if (f.Content == "") == (f.Source == "") {
+		return errors.ValidationError("validate_seed_file", fmt.Sprintf("file '%s' must set exactly one of content and source", f.Path))
+	}
+	return nil
+}
+
+// Validate checks that the label has a name and a six digit hexadecimal color without the # prefix.
+func (l Label) Validate() error {
+	if strings.TrimSpace(l.Name) == "" {
+		return errors.ValidationError("validate_label", "name cannot be empty")
+	}
+	if !labelColorPattern.MatchString(l.Color) {
+		return errors.ValidationError("validate_label", fmt.Sprintf("label '%s' has invalid color '%s' (must be six hex digits without '#')", l.Name, l.Color))
+	}
+	return nil
+}
+
+// validateNames rejects blank entries in a list of label or assignee names
+func validateNames(operation, kind string, names []string) error {
+	for _, name := range names {
+		if strings.TrimSpace(name) == "" {
+			return errors.ValidationError(operation, kind+" names cannot be empty")
+		}
+	}
+	return nil
+}
+
+// validateSimulatedDate rejects a simulated date that is set but not a valid YYYY-MM-DD date
+func validateSimulatedDate(operation, date string) error {
+	if date == "" {
+		return nil
+	}
+	if _, err := time.Parse(SimulatedDateLayout, date); err != nil {
+		return errors.ValidationError(operation, fmt.Sprintf("invalid simulated date '%s' (must be YYYY-MM-DD)", date))
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"strings"
+	"testing"
+)
+
+// checkValidation asserts that err is nil when errorText is empty, or contains errorText otherwise
+func checkValidation(t *testing.T, err error, errorText string) {
+	t.Helper()
+	if errorText == "" {
+		if err != nil {
+			t.Errorf("Expected no error, got: %v", err)
+		}
+		return
+	}
+	if err == nil {
+		t.Fatalf("Expected error containing %q but got none", errorText)
+	}
+	if !strings.Contains(err.Error(), errorText) {
+		t.Errorf("Expected error containing %q, got: %v", errorText, err)
+	}
+}
+
+// TestIssueValidate tests the required fields of an issue
+func TestIssueValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		issue     Issue
+		errorText string
+	}{
+		{name: "valid", issue: Issue{Title: "Bug", Labels: []string{"bug"}, Assignees: []string{"octocat"}}},
+		{name: "empty title", issue: Issue{Title: "  "}, errorText: "title cannot be empty"},
+		{name: "blank label", issue: Issue{Title: "Bug", Labels: []string{"bug", ""}}, errorText: "label names cannot be empty"},
+		{name: "blank assignee", issue: Issue{Title: "Bug", Assignees: []string{" "}}, errorText: "assignee names cannot be empty"},
+		{name: "simulated date", issue: Issue{Title: "Bug", SimulatedDate: "2024-03-01"}},
+		{name: "invalid simulated date", issue: Issue{Title: "Bug", SimulatedDate: "01/03/2024"}, errorText: "invalid simulated date '01/03/2024'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.issue.Validate(), tt.errorText)
+		})
+	}
+}
+
+// TestDiscussionValidate tests the required fields of a discussion
+func TestDiscussionValidate(t *testing.T) {
+	tests := []struct {
+		name       string
+		discussion Discussion
+		errorText  string
+	}{
+		{name: "valid", discussion: Discussion{Title: "Ideas", Category: "General", Labels: []string{"idea"}}},
+		{name: "category may be left to the default", discussion: Discussion{Title: "Ideas"}},
+		{name: "empty title", discussion: Discussion{Title: ""}, errorText: "title cannot be empty"},
+		{name: "blank label", discussion: Discussion{Title: "Ideas", Labels: []string{""}}, errorText: "label names cannot be empty"},
+		{name: "invalid simulated date", discussion: Discussion{Title: "Ideas", SimulatedDate: "2024-02-30"}, errorText: "invalid simulated date"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.discussion.Validate(), tt.errorText)
+		})
+	}
+}
+
+// TestPullRequestValidate tests the required fields and formats of a pull request
+func TestPullRequestValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		pullRequest PullRequest
+		errorText   string
+	}{
+		{name: "valid", pullRequest: PullRequest{Title: "Feature", Head: "feature", Base: "main", AutoMergeMethod: "squash"}},
+		{name: "base may be left to the default", pullRequest: PullRequest{Title: "Feature", Head: "feature"}},
+		{name: "empty title", pullRequest: PullRequest{Head: "feature", Base: "main"}, errorText: "title cannot be empty"},
+		{name: "empty head", pullRequest: PullRequest{Title: "Feature", Base: "main"}, errorText: "head branch cannot be empty"},
+		{name: "head equals base", pullRequest: PullRequest{Title: "Feature", Head: "main", Base: "main"}, errorText: "cannot be the same (main)"},
+		{name: "invalid auto-merge method", pullRequest: PullRequest{Title: "Feature", Head: "feature", AutoMergeMethod: "fast-forward"}, errorText: "invalid auto-merge method 'fast-forward'"},
+		{name: "valid reviews", pullRequest: PullRequest{Title: "Feature", Head: "feature", Reviews: []PRReview{{Body: "Nice"}, {Event: "approve"}}}},
+		{name: "invalid review event", pullRequest: PullRequest{Title: "Feature", Head: "feature", Reviews: []PRReview{{Body: "Nice", Event: "LGTM"}}}, errorText: "invalid review event 'LGTM'"},
+		{name: "blank label", pullRequest: PullRequest{Title: "Feature", Head: "feature", Labels: []string{""}}, errorText: "label names cannot be empty"},
+		{name: "blank assignee", pullRequest: PullRequest{Title: "Feature", Head: "feature", Assignees: []string{""}}, errorText: "assignee names cannot be empty"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.pullRequest.Validate(), tt.errorText)
+		})
+	}
+}
+
+// TestLabelValidate tests the required name and color format of a label
+func TestLabelValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		label     Label
+		errorText string
+	}{
+		{name: "valid", label: Label{Name: "bug", Color: "d73a4a"}},
+		{name: "uppercase hex", label: Label{Name: "bug", Color: "D73A4A"}},
+		{name: "empty name", label: Label{Name: " ", Color: "d73a4a"}, errorText: "name cannot be empty"},
+		{name: "missing color", label: Label{Name: "bug"}, errorText: "invalid color ''"},
+		{name: "hash prefix", label: Label{Name: "bug", Color: "#d73a4a"}, errorText: "invalid color '#d73a4a'"},
+		{name: "short color", label: Label{Name: "bug", Color: "fff"}, errorText: "invalid color 'fff'"},
+		{name: "non-hex color", label: Label{Name: "bug", Color: "zzzzzz"}, errorText: "invalid color 'zzzzzz'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.label.Validate(), tt.errorText)
+		})
+	}
+}
+
+// TestCrossReferenceValidate tests the required titles and body placeholder of a cross-reference
+func TestCrossReferenceValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		reference CrossReference
+		errorText string
+	}{
+		{name: "valid", reference: CrossReference{From: "Bug", To: "Fix bug"}},
+		{name: "custom body", reference: CrossReference{From: "Bug", To: "Fix bug", Body: "Fixed by {ref}"}},
+		{name: "missing target", reference: CrossReference{From: "Bug", To: " "}, errorText: "from and to titles cannot be empty"},
+		{name: "self reference", reference: CrossReference{From: "Bug", To: "Bug"}, errorText: "'Bug' cannot reference itself"},
+		{name: "body without placeholder", reference: CrossReference{From: "Bug", To: "Fix bug", Body: "Fixed"}, errorText: "must contain {ref}"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.reference.Validate(), tt.errorText)
+		})
+	}
+}
+
+// TestSeedFileValidate tests the path and content source of a seeded file
+func TestSeedFileValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		file      SeedFile
+		errorText string
+	}{
+		{name: "inline content", file: SeedFile{Path: "README.md", Content: "# Demo"}},
+		{name: "source file", file: SeedFile{Path: "docs/guide.md", Source: "guide.md"}},
+		{name: "missing path", file: SeedFile{Content: "# Demo"}, errorText: "path cannot be empty"},
+		{name: "absolute path", file: SeedFile{Path: "/README.md", Content: "# Demo"}, errorText: "must be relative to the repository root"},
+		{name: "content and source", file: SeedFile{Path: "README.md", Content: "# Demo", Source: "README.md"}, errorText: "must set exactly one of content and source"},
+		{name: "neither content nor source", file: SeedFile{Path: "README.md"}, errorText: "must set exactly one of content and source"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			checkValidation(t, tt.file.Validate(), tt.errorText)
+		})
+	}
+}